@@ -5,6 +5,10 @@
 
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type ProxyAccessLog struct {
 	// Disable disables access logging for managed proxies if set to true.
 	Disable bool `json:"disable,omitempty"`
@@ -17,11 +21,66 @@ type ProxyAccessLog struct {
 type ProxyAccessLogSetting struct {
 	// Format defines the format of accesslog.
 	Format ProxyAccessLogFormat `json:"format"`
+	// Filter defines the conditions a request must match to be logged.
+	// If unspecified, all the requests are logged.
+	// +optional
+	Filter *ProxyAccessLogFilter `json:"filter,omitempty"`
 	// Sinks defines the sinks of accesslog.
 	// +kubebuilder:validation:MinItems=1
 	Sinks []ProxyAccessLogSink `json:"sinks"`
 }
 
+// ProxyAccessLogFilter defines the conditions a request must match to be logged.
+// A request is logged when it matches all the conditions that are set.
+type ProxyAccessLogFilter struct {
+	// StatusCodes defines the ranges of response status codes of the requests
+	// to log. A request is logged when its status code is in any of the ranges.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	StatusCodes []StatusCodeRange `json:"statusCodes,omitempty"`
+	// MinDuration defines the minimum duration of the requests to log.
+	// +optional
+	MinDuration *metav1.Duration `json:"minDuration,omitempty"`
+	// ResponseFlags defines the Envoy [response flags](https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#config-access-log-format-response-flags)
+	// of the requests to log, e.g. "UH" or "UF". A request is logged when it has any of the flags.
+	// +optional
+	ResponseFlags []string `json:"responseFlags,omitempty"`
+	// RequestHeaders defines the names of the headers that must be present in
+	// the requests to log.
+	// +optional
+	RequestHeaders []string `json:"requestHeaders,omitempty"`
+	// Sampling defines the percentage of the requests to log.
+	// +optional
+	Sampling *ProxyAccessLogSampling `json:"sampling,omitempty"`
+	// NotHealthCheck excludes the health check requests from the log.
+	// +optional
+	NotHealthCheck bool `json:"notHealthCheck,omitempty"`
+}
+
+// StatusCodeRange defines an inclusive range of response status codes.
+type StatusCodeRange struct {
+	// Start defines the first status code of the range.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	Start int32 `json:"start"`
+	// End defines the last status code of the range.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	End int32 `json:"end"`
+}
+
+// ProxyAccessLogSampling defines the percentage of the requests to log.
+type ProxyAccessLogSampling struct {
+	// Percent defines the percentage of the requests to log.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent uint32 `json:"percent"`
+	// RuntimeKey defines the Envoy runtime key that overrides the percentage
+	// when it is set in the runtime. Defaults to "access_log.sampling".
+	// +optional
+	RuntimeKey *string `json:"runtimeKey,omitempty"`
+}
+
 type ProxyAccessLogFormatType string
 
 const (
@@ -63,11 +122,14 @@ const (
 	// When the provider is Kubernetes, EnvoyGateway always sends `k8s.namespace.name`
 	// and `k8s.pod.name` as additional attributes.
 	ProxyAccessLogSinkTypeOpenTelemetry ProxyAccessLogSinkType = "OpenTelemetry"
+	// ProxyAccessLogSinkTypeALS defines the Envoy gRPC Access Log Service sink.
+	// The format of the accesslog is ignored, the sink receives structured entries.
+	ProxyAccessLogSinkTypeALS ProxyAccessLogSinkType = "ALS"
 )
 
 type ProxyAccessLogSink struct {
 	// Type defines the type of accesslog sink.
-	// +kubebuilder:validation:Enum=File;OpenTelemetry
+	// +kubebuilder:validation:Enum=File;OpenTelemetry;ALS
 	Type ProxyAccessLogSinkType `json:"type,omitempty"`
 	// File defines the file accesslog sink.
 	// +optional
@@ -75,6 +137,9 @@ type ProxyAccessLogSink struct {
 	// OpenTelemetry defines the OpenTelemetry accesslog sink.
 	// +optional
 	OpenTelemetry *OpenTelemetryEnvoyProxyAccessLog `json:"openTelemetry,omitempty"`
+	// ALS defines the gRPC Access Log Service sink.
+	// +optional
+	ALS *ALSEnvoyProxyAccessLog `json:"als,omitempty"`
 }
 
 type FileEnvoyProxyAccessLog struct {
@@ -100,3 +165,50 @@ type OpenTelemetryEnvoyProxyAccessLog struct {
 
 	// TODO: support more OpenTelemetry accesslog options(e.g. TLS, auth etc.) in the future.
 }
+
+// ALSEnvoyProxyAccessLogType defines the type of the entries sent to a gRPC Access Log Service.
+// +kubebuilder:validation:Enum=HTTP;TCP
+type ALSEnvoyProxyAccessLogType string
+
+const (
+	// ALSEnvoyProxyAccessLogTypeHTTP sends HTTP access log entries.
+	ALSEnvoyProxyAccessLogTypeHTTP ALSEnvoyProxyAccessLogType = "HTTP"
+	// ALSEnvoyProxyAccessLogTypeTCP sends TCP access log entries.
+	ALSEnvoyProxyAccessLogTypeTCP ALSEnvoyProxyAccessLogType = "TCP"
+)
+
+// ALSEnvoyProxyAccessLog defines the gRPC Access Log Service sink.
+type ALSEnvoyProxyAccessLog struct {
+	// Host define the access log service hostname.
+	Host string `json:"host"`
+	// Port defines the port the access log service is exposed on.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// LogName defines the name of the log sent to the access log service,
+	// used to distinguish the logs of different sinks.
+	// Defaults to "envoy-gateway".
+	// +optional
+	LogName *string `json:"logName,omitempty"`
+	// Type defines the type of the access log entries.
+	// Defaults to "HTTP".
+	// +optional
+	Type *ALSEnvoyProxyAccessLogType `json:"type,omitempty"`
+	// HTTP defines the additional settings of the HTTP access log entries.
+	// It can only be set when the type is "HTTP".
+	// +optional
+	HTTP *ALSEnvoyProxyHTTPAccessLogConfig `json:"http,omitempty"`
+}
+
+// ALSEnvoyProxyHTTPAccessLogConfig defines the additional headers added to the HTTP access log entries.
+type ALSEnvoyProxyHTTPAccessLogConfig struct {
+	// RequestHeaders defines the request headers added to the access log entries.
+	// +optional
+	RequestHeaders []string `json:"requestHeaders,omitempty"`
+	// ResponseHeaders defines the response headers added to the access log entries.
+	// +optional
+	ResponseHeaders []string `json:"responseHeaders,omitempty"`
+	// ResponseTrailers defines the response trailers added to the access log entries.
+	// +optional
+	ResponseTrailers []string `json:"responseTrailers,omitempty"`
+}
@@ -9,6 +9,20 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// DefaultShutdownDrainTimeout is the default maximum time to drain the connections of Envoy.
+	DefaultShutdownDrainTimeout = 60 * time.Second
+	// DefaultShutdownMinDrainDuration is the default minimum time to drain the listeners of Envoy.
+	DefaultShutdownMinDrainDuration = 5 * time.Second
+	// DefaultMaxHeapSizePercent is the default maximum heap size of Envoy, in percent of the
+	// memory limit of the Envoy container, leaving room for the memory used outside the heap.
+	DefaultMaxHeapSizePercent = 80
 )
 
 // DefaultEnvoyProxyProvider returns a new EnvoyProxyProvider with default settings.
@@ -34,6 +48,14 @@ func DefaultEnvoyProxyKubeProvider() *EnvoyProxyKubernetesProvider {
 	return &EnvoyProxyKubernetesProvider{
 		EnvoyDeployment: DefaultKubernetesDeployment(DefaultEnvoyProxyImage),
 		EnvoyService:    DefaultKubernetesService(),
+		ShutdownManager: DefaultShutdownManager(),
+	}
+}
+
+// DefaultShutdownManager returns a new ShutdownManager with default settings.
+func DefaultShutdownManager() *ShutdownManager {
+	return &ShutdownManager{
+		Image: DefaultKubernetesContainerImage(DefaultShutdownManagerImage),
 	}
 }
 
@@ -64,6 +86,22 @@ func (r *EnvoyProxyProvider) GetEnvoyProxyKubeProvider() *EnvoyProxyKubernetesPr
 		r.Kubernetes.EnvoyService.Type = GetKubernetesServiceType(ServiceTypeLoadBalancer)
 	}
 
+	if r.Kubernetes.EnvoyDaemonSet != nil {
+		r.Kubernetes.EnvoyDaemonSet.defaultKubernetesDaemonSetSpec(DefaultEnvoyProxyImage)
+	}
+
+	if r.Kubernetes.EnvoyHpa != nil {
+		r.Kubernetes.EnvoyHpa.defaultKubernetesHpaSpec()
+	}
+
+	if r.Kubernetes.ShutdownManager == nil {
+		r.Kubernetes.ShutdownManager = DefaultShutdownManager()
+	}
+
+	if r.Kubernetes.ShutdownManager.Image == nil {
+		r.Kubernetes.ShutdownManager.Image = DefaultKubernetesContainerImage(DefaultShutdownManagerImage)
+	}
+
 	return r.Kubernetes
 }
 
@@ -97,3 +135,72 @@ func (logging *ProxyLogging) GetEnvoyProxyComponentLevel() string {
 
 	return strings.Join(args, ",")
 }
+
+// GetDrainTimeout returns the maximum time to drain the connections of Envoy,
+// or DefaultShutdownDrainTimeout if unspecified.
+func (s *ShutdownConfig) GetDrainTimeout() time.Duration {
+	if s != nil && s.DrainTimeout != nil {
+		return s.DrainTimeout.Duration
+	}
+
+	return DefaultShutdownDrainTimeout
+}
+
+// GetMinDrainDuration returns the minimum time to drain the listeners of Envoy,
+// or DefaultShutdownMinDrainDuration if unspecified.
+func (s *ShutdownConfig) GetMinDrainDuration() time.Duration {
+	if s != nil && s.MinDrainDuration != nil {
+		return s.MinDrainDuration.Duration
+	}
+
+	return DefaultShutdownMinDrainDuration
+}
+
+// GetEnvoyContainer returns the spec of the Envoy container of the DaemonSet, if set,
+// or of the Deployment.
+func (r *EnvoyProxyKubernetesProvider) GetEnvoyContainer() *KubernetesContainerSpec {
+	if r == nil {
+		return nil
+	}
+	if r.EnvoyDaemonSet != nil {
+		return r.EnvoyDaemonSet.Container
+	}
+	if r.EnvoyDeployment != nil {
+		return r.EnvoyDeployment.Container
+	}
+
+	return nil
+}
+
+// ForContainer returns the overload manager of the Envoy container. When unset, the maximum
+// heap size is DefaultMaxHeapSizePercent of the memory limit of the container, if any.
+func (o *ProxyOverloadManager) ForContainer(containerSpec *KubernetesContainerSpec) *ProxyOverloadManager {
+	if o == nil || o.MaxHeapSize != nil {
+		return o
+	}
+	if containerSpec == nil || containerSpec.Resources == nil {
+		return o
+	}
+	memoryLimit, ok := containerSpec.Resources.Limits[corev1.ResourceMemory]
+	if !ok || memoryLimit.IsZero() {
+		return o
+	}
+
+	derived := o.DeepCopy()
+	derived.MaxHeapSize = resource.NewQuantity(memoryLimit.Value()*DefaultMaxHeapSizePercent/100, resource.BinarySI)
+	return derived
+}
+
+// DefaultOverloadActions returns the default heap overload actions of Envoy.
+func DefaultOverloadActions() []OverloadAction {
+	return []OverloadAction{
+		{
+			Type:                 OverloadActionTypeShrinkHeap,
+			HeapThresholdPercent: 95,
+		},
+		{
+			Type:                 OverloadActionTypeStopAcceptingRequests,
+			HeapThresholdPercent: 98,
+		},
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestProxyOverloadManagerForContainer(t *testing.T) {
+	withMemoryLimit := func(limit string) *KubernetesContainerSpec {
+		return &KubernetesContainerSpec{
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse(limit),
+				},
+			},
+		}
+	}
+	maxHeapSize := resource.MustParse("512Mi")
+
+	testCases := []struct {
+		name            string
+		overloadManager *ProxyOverloadManager
+		container       *KubernetesContainerSpec
+		expected        *resource.Quantity
+	}{
+		{
+			name:      "overload manager disabled",
+			container: withMemoryLimit("1Gi"),
+		},
+		{
+			name:            "max heap size derived from the memory limit",
+			overloadManager: &ProxyOverloadManager{},
+			container:       withMemoryLimit("1Gi"),
+			expected:        resource.NewQuantity(858993459, resource.BinarySI),
+		},
+		{
+			name:            "max heap size kept when set",
+			overloadManager: &ProxyOverloadManager{MaxHeapSize: &maxHeapSize},
+			container:       withMemoryLimit("1Gi"),
+			expected:        &maxHeapSize,
+		},
+		{
+			name:            "no memory limit",
+			overloadManager: &ProxyOverloadManager{},
+			container:       DefaultKubernetesContainer(DefaultEnvoyProxyImage),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.overloadManager.ForContainer(tc.container)
+			if tc.overloadManager == nil {
+				require.Nil(t, actual)
+				return
+			}
+			if tc.expected == nil {
+				require.Nil(t, actual.MaxHeapSize)
+				return
+			}
+			require.Equal(t, tc.expected.Value(), actual.MaxHeapSize.Value())
+		})
+	}
+}
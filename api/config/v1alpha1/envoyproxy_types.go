@@ -6,6 +6,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -63,8 +64,117 @@ type EnvoyProxySpec struct {
 	//
 	// +optional
 	Concurrency *int32 `json:"concurrency,omitempty"`
+
+	// IPFamily specifies the IP family for the managed Envoy Proxy fleet.
+	// It configures the addresses the listeners bind to, the DNS lookup family
+	// of the clusters, the endpoints of the backends and the IP families of the
+	// Envoy Proxy Service.
+	// If unspecified, IPv4 is used.
+	//
+	// +optional
+	IPFamily *IPFamily `json:"ipFamily,omitempty"`
+
+	// Shutdown defines the graceful shutdown of the managed Envoy Proxy fleet,
+	// which drains the connections of the listeners before Envoy terminates,
+	// e.g. during a rollout. If unspecified, default settings are applied.
+	//
+	// +optional
+	Shutdown *ShutdownConfig `json:"shutdown,omitempty"`
+
+	// OverloadManager defines the overload manager of the managed Envoy Proxy
+	// fleet, which protects Envoy from exhausting its memory and connections.
+	// If unspecified, the overload manager is disabled.
+	//
+	// +optional
+	OverloadManager *ProxyOverloadManager `json:"overloadManager,omitempty"`
 }
 
+// ProxyOverloadManager defines the overload manager of Envoy.
+type ProxyOverloadManager struct {
+	// MaxHeapSize is the maximum heap size of Envoy, which the heap thresholds of
+	// the actions are relative to. If unspecified, it defaults to 80% of the memory
+	// limit of the Envoy container, and the heap is not monitored when neither is set.
+	//
+	// +optional
+	MaxHeapSize *resource.Quantity `json:"maxHeapSize,omitempty"`
+
+	// Actions are the actions Envoy takes once its heap reaches their thresholds.
+	// If unspecified, Envoy shrinks its heap at 95% and stops accepting requests
+	// at 98% of the maximum heap size.
+	//
+	// +optional
+	Actions []OverloadAction `json:"actions,omitempty"`
+
+	// MaxActiveDownstreamConnections is the maximum number of active downstream
+	// connections of all the listeners of Envoy. If unspecified, the connections
+	// are not limited.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxActiveDownstreamConnections *int64 `json:"maxActiveDownstreamConnections,omitempty"`
+}
+
+// OverloadAction defines an action Envoy takes once its heap reaches a threshold.
+type OverloadAction struct {
+	// Type is the type of the action.
+	Type OverloadActionType `json:"type"`
+
+	// HeapThresholdPercent is the percentage of the maximum heap size that
+	// triggers the action.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	HeapThresholdPercent int32 `json:"heapThresholdPercent"`
+}
+
+// OverloadActionType defines the types of overload actions supported by Envoy Gateway.
+// +kubebuilder:validation:Enum=ShrinkHeap;StopAcceptingRequests;StopAcceptingConnections;DisableHTTPKeepAlive
+type OverloadActionType string
+
+const (
+	// OverloadActionTypeShrinkHeap releases the free memory of the heap to the system.
+	OverloadActionTypeShrinkHeap OverloadActionType = "ShrinkHeap"
+
+	// OverloadActionTypeStopAcceptingRequests rejects the new requests with a 503 status code.
+	OverloadActionTypeStopAcceptingRequests OverloadActionType = "StopAcceptingRequests"
+
+	// OverloadActionTypeStopAcceptingConnections stops accepting new connections on the listeners.
+	OverloadActionTypeStopAcceptingConnections OverloadActionType = "StopAcceptingConnections"
+
+	// OverloadActionTypeDisableHTTPKeepAlive closes the HTTP connections once their current
+	// requests complete.
+	OverloadActionTypeDisableHTTPKeepAlive OverloadActionType = "DisableHTTPKeepAlive"
+)
+
+// ShutdownConfig defines the graceful shutdown of Envoy.
+type ShutdownConfig struct {
+	// DrainTimeout is the maximum time to wait for the active connections of
+	// Envoy to drain before Envoy terminates. Defaults to 60 seconds.
+	//
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+
+	// MinDrainDuration is the minimum time to drain the listeners of Envoy,
+	// even when no connections are active, e.g. to let the load balancers
+	// stop sending new connections. Defaults to 5 seconds.
+	//
+	// +optional
+	MinDrainDuration *metav1.Duration `json:"minDrainDuration,omitempty"`
+}
+
+// IPFamily defines the IP family to use for the managed Envoy Proxy fleet.
+// +kubebuilder:validation:Enum=IPv4;IPv6;DualStack
+type IPFamily string
+
+const (
+	// IPv4 defines the IPv4 family.
+	IPv4 IPFamily = "IPv4"
+	// IPv6 defines the IPv6 family.
+	IPv6 IPFamily = "IPv6"
+	// DualStack defines both the IPv4 and IPv6 families.
+	DualStack IPFamily = "DualStack"
+)
+
 type ProxyTelemetry struct {
 	// AccessLogs defines accesslog parameters for managed proxies.
 	// If unspecified, will send default format to stdout.
@@ -113,6 +223,42 @@ type EnvoyProxyKubernetesProvider struct {
 	//
 	// +optional
 	EnvoyService *KubernetesServiceSpec `json:"envoyService,omitempty"`
+
+	// EnvoyDaemonSet defines the desired state of the Envoy daemonset resource.
+	// When set, the Envoy proxies run as a daemonset, one pod per node, instead
+	// of a deployment, and EnvoyDeployment and EnvoyHpa must not be set.
+	//
+	// +optional
+	EnvoyDaemonSet *KubernetesDaemonSetSpec `json:"envoyDaemonSet,omitempty"`
+
+	// EnvoyHpa defines the horizontal pod autoscaler of the Envoy deployment.
+	// When set, the autoscaler manages the replicas of the deployment, and the
+	// replicas of EnvoyDeployment are ignored.
+	//
+	// +optional
+	EnvoyHpa *KubernetesHorizontalPodAutoscalerSpec `json:"envoyHpa,omitempty"`
+
+	// EnvoyPDB defines the pod disruption budget of the Envoy deployment.
+	// If unspecified, no pod disruption budget is created.
+	//
+	// +optional
+	EnvoyPDB *KubernetesPodDisruptionBudgetSpec `json:"envoyPDB,omitempty"`
+
+	// ShutdownManager defines the desired state of the shutdown manager container,
+	// which drains the connections of Envoy before the Envoy pods terminate.
+	// If unspecified, default settings for the shutdown manager are applied.
+	//
+	// +optional
+	ShutdownManager *ShutdownManager `json:"shutdownManager,omitempty"`
+}
+
+// ShutdownManager defines the desired state of the shutdown manager container.
+type ShutdownManager struct {
+	// Image specifies the Envoy Gateway image used by the shutdown manager
+	// container. Defaults to the image of the Envoy Gateway controller.
+	//
+	// +optional
+	Image *string `json:"image,omitempty"`
 }
 
 // ProxyLogging defines logging parameters for managed proxies.
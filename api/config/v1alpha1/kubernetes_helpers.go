@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	appv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/pointer"
@@ -25,6 +26,13 @@ func DefaultKubernetesDeploymentStrategy() *appv1.DeploymentStrategy {
 	}
 }
 
+// DefaultKubernetesDaemonSetStrategy returns the default daemonset update strategy settings.
+func DefaultKubernetesDaemonSetStrategy() *appv1.DaemonSetUpdateStrategy {
+	return &appv1.DaemonSetUpdateStrategy{
+		Type: appv1.RollingUpdateDaemonSetStrategyType,
+	}
+}
+
 // DefaultKubernetesContainerImage returns the default envoyproxy image.
 func DefaultKubernetesContainerImage(image string) *string {
 	return pointer.String(image)
@@ -40,6 +48,15 @@ func DefaultKubernetesDeployment(image string) *KubernetesDeploymentSpec {
 	}
 }
 
+// DefaultKubernetesDaemonSet returns a new KubernetesDaemonSetSpec with default settings.
+func DefaultKubernetesDaemonSet(image string) *KubernetesDaemonSetSpec {
+	return &KubernetesDaemonSetSpec{
+		Strategy:  DefaultKubernetesDaemonSetStrategy(),
+		Pod:       DefaultKubernetesPod(),
+		Container: DefaultKubernetesContainer(image),
+	}
+}
+
 // DefaultKubernetesPod returns a new KubernetesPodSpec with default settings.
 func DefaultKubernetesPod() *KubernetesPodSpec {
 	return &KubernetesPodSpec{}
@@ -106,3 +123,54 @@ func (deployment *KubernetesDeploymentSpec) defaultKubernetesDeploymentSpec(imag
 		deployment.Container.Image = DefaultKubernetesContainerImage(image)
 	}
 }
+
+// defaultKubernetesDaemonSetSpec fill a default KubernetesDaemonSetSpec if unspecified.
+func (daemonSet *KubernetesDaemonSetSpec) defaultKubernetesDaemonSetSpec(image string) {
+	if daemonSet.Strategy == nil {
+		daemonSet.Strategy = DefaultKubernetesDaemonSetStrategy()
+	}
+
+	if daemonSet.Pod == nil {
+		daemonSet.Pod = DefaultKubernetesPod()
+	}
+
+	if daemonSet.Container == nil {
+		daemonSet.Container = DefaultKubernetesContainer(image)
+	}
+
+	if daemonSet.Container.Resources == nil {
+		daemonSet.Container.Resources = DefaultResourceRequirements()
+	}
+
+	if daemonSet.Container.Image == nil {
+		daemonSet.Container.Image = DefaultKubernetesContainerImage(image)
+	}
+}
+
+// DefaultKubernetesHpaMetrics returns the default metrics of a horizontal pod autoscaler,
+// targeting the average CPU utilization of the pods.
+func DefaultKubernetesHpaMetrics() []autoscalingv2.MetricSpec {
+	return []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: pointer.Int32(DefaultHpaCPUUtilization),
+				},
+			},
+		},
+	}
+}
+
+// defaultKubernetesHpaSpec fill a default KubernetesHorizontalPodAutoscalerSpec if unspecified.
+func (hpa *KubernetesHorizontalPodAutoscalerSpec) defaultKubernetesHpaSpec() {
+	if hpa.MinReplicas == nil {
+		hpa.MinReplicas = pointer.Int32(DefaultHpaMinReplicas)
+	}
+
+	if len(hpa.Metrics) == 0 {
+		hpa.Metrics = DefaultKubernetesHpaMetrics()
+	}
+}
@@ -13,23 +13,45 @@ type ProxyMetrics struct {
 	// Matches defines configuration for selecting specific metrics instead of generating all metrics stats
 	// that are enabled by default. This helps reduce CPU and memory overhead in Envoy.
 	Matches []Match `json:"matches,omitempty"`
+	// StatsTags defines custom tags added to the metrics, in addition to the
+	// default tags extracted by Envoy.
+	//
+	// +optional
+	StatsTags []StatsTag `json:"statsTags,omitempty"`
+	// HistogramBuckets defines the buckets of the histograms matching each setting.
+	// The first matching setting is used, and the other histograms keep the
+	// default buckets of Envoy.
+	//
+	// +optional
+	HistogramBuckets []HistogramBucketSetting `json:"histogramBuckets,omitempty"`
 }
 
 type MetricSinkType string
 
 const (
 	MetricSinkTypeOpenTelemetry MetricSinkType = "OpenTelemetry"
+	MetricSinkTypeStatsD        MetricSinkType = "StatsD"
+	MetricSinkTypeDogStatsD     MetricSinkType = "DogStatsD"
 )
 
 type MetricSink struct {
 	// Type defines the metric sink type.
-	// EG currently only supports OpenTelemetry.
-	// +kubebuilder:validation:Enum=OpenTelemetry
+	// +kubebuilder:validation:Enum=OpenTelemetry;StatsD;DogStatsD
 	// +kubebuilder:default=OpenTelemetry
 	Type MetricSinkType `json:"type"`
 	// OpenTelemetry defines the configuration for OpenTelemetry sink.
 	// It's required if the sink type is OpenTelemetry.
 	OpenTelemetry *OpenTelemetrySink `json:"openTelemetry,omitempty"`
+	// StatsD defines the configuration for StatsD sink.
+	// It's required if the sink type is StatsD.
+	//
+	// +optional
+	StatsD *StatsDSink `json:"statsD,omitempty"`
+	// DogStatsD defines the configuration for DogStatsD sink.
+	// It's required if the sink type is DogStatsD.
+	//
+	// +optional
+	DogStatsD *StatsDSink `json:"dogStatsD,omitempty"`
 }
 
 // Match defines the stats match configuration.
@@ -49,6 +71,7 @@ const (
 	Suffix            MatcherType = "Suffix"
 )
 
+// OpenTelemetrySink defines an OpenTelemetry collector the metrics are sent to over gRPC.
 type OpenTelemetrySink struct {
 	// Host define the service hostname.
 	Host string `json:"host"`
@@ -59,8 +82,79 @@ type OpenTelemetrySink struct {
 	// +kubebuilder:validation:Maximum=65535
 	// +kubebuilder:default=4317
 	Port int32 `json:"port,omitempty"`
+	// ReportCountersAsDeltas reports the counters as the delta since the last flush,
+	// instead of their cumulative value.
+	//
+	// +optional
+	ReportCountersAsDeltas bool `json:"reportCountersAsDeltas,omitempty"`
+	// ReportHistogramsAsDeltas reports the histograms as the delta since the last flush,
+	// instead of their cumulative value.
+	//
+	// +optional
+	ReportHistogramsAsDeltas bool `json:"reportHistogramsAsDeltas,omitempty"`
+	// EmitTagsAsAttributes reports the tags of the metrics as attributes of the
+	// data points. Defaults to true.
+	//
+	// +optional
+	EmitTagsAsAttributes *bool `json:"emitTagsAsAttributes,omitempty"`
+	// UseTagExtractedName reports the metrics with the tags removed from their
+	// name, instead of their full name. Defaults to true.
+	//
+	// +optional
+	UseTagExtractedName *bool `json:"useTagExtractedName,omitempty"`
+	// Resources is a set of attributes that describe the source of the metrics,
+	// reported as the attributes of the OpenTelemetry resource of all the metrics.
+	// It's recommended to follow [semantic conventions](https://opentelemetry.io/docs/reference/specification/resource/semantic_conventions/).
+	//
+	// +optional
+	Resources map[string]string `json:"resources,omitempty"`
+}
 
-	// TODO: add support for customizing OpenTelemetry sink in https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/stat_sinks/open_telemetry/v3/open_telemetry.proto#envoy-v3-api-msg-extensions-stat-sinks-open-telemetry-v3-sinkconfig
+// StatsDSink defines a StatsD or DogStatsD server the metrics are sent to over UDP.
+type StatsDSink struct {
+	// Host defines the IP address of the server. Envoy does not resolve the
+	// hostnames of StatsD servers.
+	Host string `json:"host"`
+	// Port defines the UDP port the server is listening on.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=8125
+	Port int32 `json:"port,omitempty"`
+	// Prefix is the prefix of the names of the metrics. Defaults to "envoy".
+	//
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// StatsTag defines a tag added to the metrics.
+// Only one of Regex and FixedValue may be set.
+type StatsTag struct {
+	// Name is the name of the tag.
+	Name string `json:"name"`
+	// Regex extracts the value of the tag from the name of the metrics.
+	// The first capture group is removed from the name of the metrics, and its
+	// last sub-match is used as the value of the tag.
+	//
+	// +optional
+	Regex *string `json:"regex,omitempty"`
+	// FixedValue is the value of the tag, added to all the metrics.
+	//
+	// +optional
+	FixedValue *string `json:"fixedValue,omitempty"`
+}
+
+// HistogramBucketSetting defines the buckets of the histograms matching a name.
+type HistogramBucketSetting struct {
+	// Match selects the histograms by name.
+	Match Match `json:"match"`
+	// Buckets are the upper bounds of the buckets, in ascending order.
+	// The values of the histograms of durations are in milliseconds.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Buckets []string `json:"buckets"`
 }
 
 type PrometheusProvider struct {
@@ -7,7 +7,9 @@ package v1alpha1
 
 import (
 	appv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -21,8 +23,19 @@ const (
 	DefaultEnvoyProxyImage = "envoyproxy/envoy-dev:latest"
 	// DefaultRateLimitImage is the default image used by ratelimit.
 	DefaultRateLimitImage = "envoyproxy/ratelimit:master"
+	// DefaultTerminationGracePeriodSeconds is the default duration in seconds the pods need to terminate gracefully.
+	DefaultTerminationGracePeriodSeconds = 300
+	// DefaultHpaMinReplicas is the default minimum number of replicas of a horizontal pod autoscaler.
+	DefaultHpaMinReplicas = 1
+	// DefaultHpaCPUUtilization is the default average CPU utilization targeted by a horizontal pod autoscaler.
+	DefaultHpaCPUUtilization = 80
 )
 
+// DefaultShutdownManagerImage is the default image used by the shutdown manager of envoyproxy.
+// The shutdown manager runs the Envoy Gateway binary, so the image is set at build time to the
+// image the controller is built into.
+var DefaultShutdownManagerImage = "envoyproxy/gateway-dev:latest"
+
 // GroupVersionKind unambiguously identifies a Kind.
 // It can be converted to k8s.io/apimachinery/pkg/runtime/schema.GroupVersionKind
 type GroupVersionKind struct {
@@ -69,6 +82,25 @@ type KubernetesDeploymentSpec struct {
 	// TODO: Expose config as use cases are better understood, e.g. labels.
 }
 
+// KubernetesDaemonSetSpec defines the desired state of the Kubernetes daemonset resource.
+type KubernetesDaemonSetSpec struct {
+	// Strategy is the daemonset update strategy used to replace existing pods with new ones.
+	// Defaults to RollingUpdate.
+	//
+	// +optional
+	Strategy *appv1.DaemonSetUpdateStrategy `json:"strategy,omitempty"`
+
+	// Pod defines the desired annotations, securityContext and networking of the pods.
+	//
+	// +optional
+	Pod *KubernetesPodSpec `json:"pod,omitempty"`
+
+	// Container defines the resources and securityContext of container.
+	//
+	// +optional
+	Container *KubernetesContainerSpec `json:"container,omitempty"`
+}
+
 // KubernetesPodSpec defines the desired state of the Kubernetes pod resource.
 type KubernetesPodSpec struct {
 	// Annotations are the annotations that should be appended to the pods.
@@ -102,6 +134,27 @@ type KubernetesPodSpec struct {
 	//
 	// +optional
 	Volumes []corev1.Volume `json:"volumes,omitempty"`
+
+	// HostNetwork requests the host networking for the pods, so that the
+	// containers listen on the ports of the node. Defaults to false.
+	//
+	// +optional
+	HostNetwork bool `json:"hostNetwork,omitempty"`
+
+	// DNSPolicy is the DNS policy of the pods. Defaults to "ClusterFirst", or to
+	// "ClusterFirstWithHostNet" when HostNetwork is set.
+	//
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	// +optional
+	DNSPolicy *corev1.DNSPolicy `json:"dnsPolicy,omitempty"`
+
+	// TerminationGracePeriodSeconds is the duration in seconds the pods need to
+	// terminate gracefully, e.g. to drain the connections of Envoy. It must not
+	// be less than the drain timeout of Envoy. Defaults to 300.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
 }
 
 // KubernetesContainerSpec defines the desired state of the Kubernetes container resource.
@@ -180,6 +233,54 @@ type KubernetesServiceSpec struct {
 	// TODO: Expose config as use cases are better understood, e.g. labels.
 }
 
+// KubernetesHorizontalPodAutoscalerSpec defines the desired state of the Kubernetes
+// horizontal pod autoscaler resource, which scales the replicas of a deployment.
+// See k8s.io.autoscaling.v2.HorizontalPodAutoScalerSpec.
+type KubernetesHorizontalPodAutoscalerSpec struct {
+	// MinReplicas is the lower limit for the number of replicas to which the autoscaler
+	// can scale down. Defaults to 1.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas to which the autoscaler
+	// can scale up. It cannot be less than MinReplicas.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metrics contains the specifications used to calculate the desired replica count,
+	// such as the CPU or memory utilization of the pods, or custom metrics.
+	// If unset, the autoscaler targets an average CPU utilization of 80%.
+	//
+	// +optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// Behavior configures the scaling behavior of the target in both the up and down
+	// directions. If unset, the default behavior of the autoscaler is used.
+	//
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// KubernetesPodDisruptionBudgetSpec defines the desired state of the Kubernetes
+// pod disruption budget resource, which limits the pods of a deployment that are
+// evicted at the same time. Only one of MinAvailable and MaxUnavailable can be set.
+type KubernetesPodDisruptionBudgetSpec struct {
+	// MinAvailable is the number or the percentage of pods that must remain available
+	// after an eviction.
+	//
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or the percentage of pods that can be unavailable
+	// after an eviction.
+	//
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 // LogLevel defines a log level for Envoy Gateway and EnvoyProxy system logs.
 // This type is not implemented for EnvoyProxy until
 // https://github.com/envoyproxy/gateway/issues/280 is fixed.
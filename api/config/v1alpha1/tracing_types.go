@@ -18,7 +18,6 @@ type ProxyTracing struct {
 	// If provider is kubernetes, pod name and namespace are added by default.
 	CustomTags map[string]CustomTag `json:"customTags,omitempty"`
 	// Provider defines the tracing provider.
-	// Only OpenTelemetry is supported currently.
 	Provider TracingProvider `json:"provider"`
 }
 
@@ -26,24 +25,61 @@ type TracingProviderType string
 
 const (
 	TracingProviderTypeOpenTelemetry TracingProviderType = "OpenTelemetry"
+	TracingProviderTypeZipkin        TracingProviderType = "Zipkin"
+	TracingProviderTypeDatadog       TracingProviderType = "Datadog"
 )
 
 type TracingProvider struct {
 	// Type defines the tracing provider type.
-	// EG currently only supports OpenTelemetry.
-	// +kubebuilder:validation:Enum=OpenTelemetry
+	// +kubebuilder:validation:Enum=OpenTelemetry;Zipkin;Datadog
 	// +kubebuilder:default=OpenTelemetry
 	Type TracingProviderType `json:"type"`
 	// Host define the provider service hostname.
 	Host string `json:"host"`
 	// Port defines the port the provider service is exposed on.
+	// If unspecified, defaults to 4317 for OpenTelemetry, 9411 for Zipkin
+	// and 8126 for Datadog.
 	//
 	// +optional
 	// +kubebuilder:validation:Minimum=0
-	// +kubebuilder:default=4317
 	Port int32 `json:"port,omitempty"`
+	// Zipkin defines the settings of the Zipkin provider.
+	// It can only be set when the type is "Zipkin".
+	//
+	// +optional
+	Zipkin *ZipkinTracingProvider `json:"zipkin,omitempty"`
+}
+
+// ZipkinTracingProvider defines the settings of the Zipkin tracing provider.
+type ZipkinTracingProvider struct {
+	// CollectorEndpoint defines the API path of the collector the spans are sent to.
+	// If unspecified, defaults to "/api/v2/spans".
+	//
+	// +optional
+	CollectorEndpoint *string `json:"collectorEndpoint,omitempty"`
+	// Encoding defines the encoding of the spans sent to the collector.
+	// If unspecified, defaults to "JSON".
+	//
+	// +optional
+	Encoding *ZipkinEncoding `json:"encoding,omitempty"`
+	// Enable128BitTraceID defines whether 128-bit trace IDs are generated
+	// instead of 64-bit trace IDs.
+	//
+	// +optional
+	Enable128BitTraceID *bool `json:"enable128BitTraceId,omitempty"`
 }
 
+// ZipkinEncoding defines the encoding of the spans sent to a Zipkin collector.
+// +kubebuilder:validation:Enum=JSON;Proto
+type ZipkinEncoding string
+
+const (
+	// ZipkinEncodingJSON sends the spans as JSON over HTTP.
+	ZipkinEncodingJSON ZipkinEncoding = "JSON"
+	// ZipkinEncodingProto sends the spans as protobuf over HTTP.
+	ZipkinEncodingProto ZipkinEncoding = "Proto"
+)
+
 type CustomTagType string
 
 const (
@@ -8,14 +8,20 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
+	"regexp"
+	"strconv"
+	"time"
 
 	bootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/testing/protocmp"
+	corev1 "k8s.io/api/core/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/yaml"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
@@ -62,9 +68,117 @@ func validateEnvoyProxySpec(spec *egcfgv1a1.EnvoyProxySpec) error {
 		errs = append(errs, validateProxyTelemetryErrs...)
 	}
 
+	validateShutdownErrs := validateShutdown(spec)
+	if len(validateShutdownErrs) != 0 {
+		errs = append(errs, validateShutdownErrs...)
+	}
+
+	validateOverloadManagerErrs := validateOverloadManager(spec)
+	if len(validateOverloadManagerErrs) != 0 {
+		errs = append(errs, validateOverloadManagerErrs...)
+	}
+
 	return utilerrors.NewAggregate(errs)
 }
 
+func validateOverloadManager(spec *egcfgv1a1.EnvoyProxySpec) []error {
+	var errs []error
+	if spec == nil || spec.OverloadManager == nil {
+		return errs
+	}
+	overloadManager := spec.OverloadManager
+
+	if overloadManager.MaxHeapSize != nil && overloadManager.MaxHeapSize.Sign() <= 0 {
+		errs = append(errs, fmt.Errorf("overloadManager maxHeapSize %s must be greater than 0", overloadManager.MaxHeapSize.String()))
+	}
+	if overloadManager.MaxActiveDownstreamConnections != nil && *overloadManager.MaxActiveDownstreamConnections < 1 {
+		errs = append(errs, fmt.Errorf("overloadManager maxActiveDownstreamConnections %d must be greater than 0",
+			*overloadManager.MaxActiveDownstreamConnections))
+	}
+
+	actionTypes := make(map[egcfgv1a1.OverloadActionType]bool, len(overloadManager.Actions))
+	for _, action := range overloadManager.Actions {
+		if actionTypes[action.Type] {
+			errs = append(errs, fmt.Errorf("overloadManager action %s is duplicated", action.Type))
+		}
+		actionTypes[action.Type] = true
+		if action.HeapThresholdPercent < 1 || action.HeapThresholdPercent > 100 {
+			errs = append(errs, fmt.Errorf("overloadManager action %s heapThresholdPercent %d must be between 1 and 100",
+				action.Type, action.HeapThresholdPercent))
+		}
+	}
+
+	// The heap thresholds are relative to the maximum heap size, which defaults
+	// to the memory limit of the Envoy container.
+	if len(overloadManager.Actions) > 0 && overloadManager.MaxHeapSize == nil && !hasEnvoyMemoryLimit(spec) {
+		errs = append(errs, errors.New("overloadManager actions require a maxHeapSize or a memory limit of the envoy container"))
+	}
+
+	return errs
+}
+
+// hasEnvoyMemoryLimit returns true if the Envoy container has a memory limit.
+func hasEnvoyMemoryLimit(spec *egcfgv1a1.EnvoyProxySpec) bool {
+	if spec.Provider == nil || spec.Provider.Kubernetes == nil {
+		return false
+	}
+	var container *egcfgv1a1.KubernetesContainerSpec
+	switch kube := spec.Provider.Kubernetes; {
+	case kube.EnvoyDaemonSet != nil:
+		container = kube.EnvoyDaemonSet.Container
+	case kube.EnvoyDeployment != nil:
+		container = kube.EnvoyDeployment.Container
+	}
+	if container == nil || container.Resources == nil {
+		return false
+	}
+	_, ok := container.Resources.Limits[corev1.ResourceMemory]
+	return ok
+}
+
+func validateShutdown(spec *egcfgv1a1.EnvoyProxySpec) []error {
+	var errs []error
+	if spec == nil {
+		return errs
+	}
+
+	drainTimeout := spec.Shutdown.GetDrainTimeout()
+	if drainTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("drainTimeout %v must be greater than 0", drainTimeout))
+	}
+	minDrainDuration := spec.Shutdown.GetMinDrainDuration()
+	if minDrainDuration < 0 {
+		errs = append(errs, fmt.Errorf("minDrainDuration %v cannot be negative", minDrainDuration))
+	}
+	if minDrainDuration > drainTimeout {
+		errs = append(errs, fmt.Errorf("minDrainDuration %v cannot be greater than drainTimeout %v", minDrainDuration, drainTimeout))
+	}
+
+	// The pods must not be killed before the connections are drained.
+	if spec.Provider == nil || spec.Provider.Kubernetes == nil {
+		return errs
+	}
+	var pods []*egcfgv1a1.KubernetesPodSpec
+	if deployment := spec.Provider.Kubernetes.EnvoyDeployment; deployment != nil {
+		pods = append(pods, deployment.Pod)
+	}
+	if daemonSet := spec.Provider.Kubernetes.EnvoyDaemonSet; daemonSet != nil {
+		pods = append(pods, daemonSet.Pod)
+	}
+	for _, pod := range pods {
+		if pod == nil || pod.TerminationGracePeriodSeconds == nil {
+			continue
+		}
+		gracePeriod := time.Duration(*pod.TerminationGracePeriodSeconds) * time.Second
+		if gracePeriod < drainTimeout {
+			errs = append(errs, fmt.Errorf("terminationGracePeriodSeconds %d cannot be less than drainTimeout %v",
+				*pod.TerminationGracePeriodSeconds, drainTimeout))
+		}
+	}
+
+	return errs
+}
+
 func validateProvider(spec *egcfgv1a1.EnvoyProxySpec) []error {
 	var errs []error
 	if spec != nil && spec.Provider != nil {
@@ -75,6 +189,66 @@ func validateProvider(spec *egcfgv1a1.EnvoyProxySpec) []error {
 		if len(validateServiceTypeErrs) != 0 {
 			errs = append(errs, validateServiceTypeErrs...)
 		}
+		if spec.Provider.Kubernetes != nil {
+			errs = append(errs, validateHpa(spec.Provider.Kubernetes.EnvoyHpa)...)
+			errs = append(errs, validatePDB(spec.Provider.Kubernetes.EnvoyPDB)...)
+			errs = append(errs, validateDaemonSet(spec.Provider.Kubernetes)...)
+		}
+	}
+	return errs
+}
+
+func validateDaemonSet(provider *egcfgv1a1.EnvoyProxyKubernetesProvider) []error {
+	var errs []error
+	if provider.EnvoyDaemonSet == nil {
+		return errs
+	}
+	if provider.EnvoyDeployment != nil {
+		errs = append(errs, errors.New("envoy daemonset and envoy deployment cannot be set at the same time"))
+	}
+	if provider.EnvoyHpa != nil {
+		errs = append(errs, errors.New("envoy hpa cannot be set for an envoy daemonset"))
+	}
+	// The pod disruption budget of pods not managed by a scalable controller
+	// only supports an integer minAvailable.
+	if pdb := provider.EnvoyPDB; pdb != nil {
+		if pdb.MaxUnavailable != nil || (pdb.MinAvailable != nil && pdb.MinAvailable.Type != intstr.Int) {
+			errs = append(errs, errors.New("envoy pdb of an envoy daemonset only supports an integer minAvailable"))
+		}
+	}
+	return errs
+}
+
+func validateHpa(hpa *egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec) []error {
+	var errs []error
+	if hpa == nil {
+		return errs
+	}
+	if hpa.MaxReplicas < 1 {
+		errs = append(errs, fmt.Errorf("envoy hpa maxReplicas must be greater than 0, got %d", hpa.MaxReplicas))
+	}
+	if hpa.MinReplicas != nil {
+		if *hpa.MinReplicas < 1 {
+			errs = append(errs, fmt.Errorf("envoy hpa minReplicas must be greater than 0, got %d", *hpa.MinReplicas))
+		}
+		if *hpa.MinReplicas > hpa.MaxReplicas {
+			errs = append(errs, fmt.Errorf("envoy hpa minReplicas %d must not be greater than maxReplicas %d",
+				*hpa.MinReplicas, hpa.MaxReplicas))
+		}
+	}
+	return errs
+}
+
+func validatePDB(pdb *egcfgv1a1.KubernetesPodDisruptionBudgetSpec) []error {
+	var errs []error
+	if pdb == nil {
+		return errs
+	}
+	switch {
+	case pdb.MinAvailable != nil && pdb.MaxUnavailable != nil:
+		errs = append(errs, errors.New("envoy pdb minAvailable and maxUnavailable cannot be set at the same time"))
+	case pdb.MinAvailable == nil && pdb.MaxUnavailable == nil:
+		errs = append(errs, errors.New("envoy pdb must set one of minAvailable or maxUnavailable"))
 	}
 	return errs
 }
@@ -96,7 +270,7 @@ func validateServiceType(spec *egcfgv1a1.EnvoyProxySpec) []error {
 func validateBootstrap(boostrapConfig *egcfgv1a1.ProxyBootstrap) error {
 	defaultBootstrap := &bootstrapv3.Bootstrap{}
 	// TODO: need validate when enable prometheus?
-	defaultBootstrapStr, err := bootstrap.GetRenderedBootstrapConfig(nil)
+	defaultBootstrapStr, err := bootstrap.GetRenderedBootstrapConfig(nil, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -173,6 +347,97 @@ func validateProxyTelemetry(spec *egcfgv1a1.EnvoyProxySpec) []error {
 		}
 	}
 
+	if spec != nil && spec.Telemetry.Tracing != nil {
+		tracingErrs := validateProxyTracing(spec.Telemetry.Tracing)
+		if len(tracingErrs) > 0 {
+			errs = append(errs, tracingErrs...)
+		}
+	}
+
+	if spec != nil && spec.Telemetry.Metrics != nil {
+		metricsErrs := validateProxyMetrics(spec.Telemetry.Metrics)
+		if len(metricsErrs) > 0 {
+			errs = append(errs, metricsErrs...)
+		}
+	}
+
+	return errs
+}
+
+func validateProxyMetrics(metrics *egcfgv1a1.ProxyMetrics) []error {
+	var errs []error
+
+	for _, sink := range metrics.Sinks {
+		switch sink.Type {
+		case egcfgv1a1.MetricSinkTypeOpenTelemetry:
+			if sink.OpenTelemetry == nil {
+				err := fmt.Errorf("unable to configure metric sink when using OpenTelemetry sink type but \"openTelemetry\" field being empty")
+				errs = append(errs, err)
+			} else if _, ok := sink.OpenTelemetry.Resources[""]; ok {
+				err := fmt.Errorf("unable to configure OpenTelemetry metric sink with an empty resource attribute name")
+				errs = append(errs, err)
+			}
+		case egcfgv1a1.MetricSinkTypeStatsD:
+			if sink.StatsD == nil {
+				err := fmt.Errorf("unable to configure metric sink when using StatsD sink type but \"statsD\" field being empty")
+				errs = append(errs, err)
+			} else if net.ParseIP(sink.StatsD.Host) == nil {
+				err := fmt.Errorf("unable to configure StatsD metric sink with host %q, it must be an IP address", sink.StatsD.Host)
+				errs = append(errs, err)
+			}
+		case egcfgv1a1.MetricSinkTypeDogStatsD:
+			if sink.DogStatsD == nil {
+				err := fmt.Errorf("unable to configure metric sink when using DogStatsD sink type but \"dogStatsD\" field being empty")
+				errs = append(errs, err)
+			} else if net.ParseIP(sink.DogStatsD.Host) == nil {
+				err := fmt.Errorf("unable to configure DogStatsD metric sink with host %q, it must be an IP address", sink.DogStatsD.Host)
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	for _, tag := range metrics.StatsTags {
+		switch {
+		case (tag.Regex == nil) == (tag.FixedValue == nil):
+			err := fmt.Errorf("unable to configure stats tag %s, exactly one of \"regex\" and \"fixedValue\" must be set", tag.Name)
+			errs = append(errs, err)
+		case tag.Regex != nil:
+			if _, err := regexp.Compile(*tag.Regex); err != nil {
+				err = fmt.Errorf("unable to configure stats tag %s with invalid regex %q: %w", tag.Name, *tag.Regex, err)
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	for _, setting := range metrics.HistogramBuckets {
+		prev := -1.0
+		for _, bucket := range setting.Buckets {
+			value, err := strconv.ParseFloat(bucket, 64)
+			if err != nil {
+				err = fmt.Errorf("unable to configure histogram buckets for %s with invalid bucket %q", setting.Match.Value, bucket)
+				errs = append(errs, err)
+				break
+			}
+			if value <= prev {
+				err = fmt.Errorf("unable to configure histogram buckets for %s, the buckets must be in ascending order", setting.Match.Value)
+				errs = append(errs, err)
+				break
+			}
+			prev = value
+		}
+	}
+
+	return errs
+}
+
+func validateProxyTracing(tracing *egcfgv1a1.ProxyTracing) []error {
+	var errs []error
+
+	if tracing.Provider.Zipkin != nil && tracing.Provider.Type != egcfgv1a1.TracingProviderTypeZipkin {
+		err := fmt.Errorf("unable to configure tracing when using %s provider type but \"zipkin\" field being set", tracing.Provider.Type)
+		errs = append(errs, err)
+	}
+
 	return errs
 }
 
@@ -209,8 +474,50 @@ func validateProxyAccessLog(accessLog *egcfgv1a1.ProxyAccessLog) []error {
 					err := fmt.Errorf("unable to configure access log when using OpenTelemetry sink type but \"openTelemetry\" field being empty")
 					errs = append(errs, err)
 				}
+			case egcfgv1a1.ProxyAccessLogSinkTypeALS:
+				if sink.ALS == nil {
+					err := fmt.Errorf("unable to configure access log when using ALS sink type but \"als\" field being empty")
+					errs = append(errs, err)
+				} else if sink.ALS.HTTP != nil && sink.ALS.Type != nil && *sink.ALS.Type != egcfgv1a1.ALSEnvoyProxyAccessLogTypeHTTP {
+					err := fmt.Errorf("unable to configure access log when using %s ALS type but \"http\" field being set", *sink.ALS.Type)
+					errs = append(errs, err)
+				}
 			}
 		}
+
+		if setting.Filter != nil {
+			errs = append(errs, validateProxyAccessLogFilter(setting.Filter)...)
+		}
+	}
+
+	return errs
+}
+
+// validAccessLogResponseFlags are the response flags supported by the Envoy
+// response flag access log filter.
+var validAccessLogResponseFlags = map[string]bool{
+	"LH": true, "UH": true, "UT": true, "LR": true, "UR": true, "UF": true,
+	"UC": true, "UO": true, "NR": true, "DI": true, "FI": true, "RL": true,
+	"UAEX": true, "RLSE": true, "DC": true, "URX": true, "SI": true, "IH": true,
+	"DPE": true, "UMSDR": true, "RFCF": true, "NFCF": true, "DT": true, "UPE": true,
+	"NC": true, "OM": true,
+}
+
+func validateProxyAccessLogFilter(filter *egcfgv1a1.ProxyAccessLogFilter) []error {
+	var errs []error
+
+	for _, codes := range filter.StatusCodes {
+		if codes.Start > codes.End {
+			err := fmt.Errorf("unable to configure access log filter with status code range start %d greater than end %d", codes.Start, codes.End)
+			errs = append(errs, err)
+		}
+	}
+
+	for _, flag := range filter.ResponseFlags {
+		if !validAccessLogResponseFlags[flag] {
+			err := fmt.Errorf("unable to configure access log filter with unknown response flag %q", flag)
+			errs = append(errs, err)
+		}
 	}
 
 	return errs
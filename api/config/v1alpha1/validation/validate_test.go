@@ -10,11 +10,14 @@ import (
 	_ "embed"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
@@ -34,56 +37,659 @@ var (
 )
 
 func TestValidateEnvoyProxy(t *testing.T) {
+	tcpALSType := egcfgv1a1.ALSEnvoyProxyAccessLogTypeTCP
+
 	testCases := []struct {
 		name     string
 		proxy    *egcfgv1a1.EnvoyProxy
 		expected bool
 	}{
 		{
-			name:     "nil egcfgv1a1.EnvoyProxy",
-			proxy:    nil,
+			name:     "nil egcfgv1a1.EnvoyProxy",
+			proxy:    nil,
+			expected: false,
+		},
+		{
+			name: "nil provider",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: nil,
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "unsupported provider",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeFile,
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "nil envoy service",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyService: nil,
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "unsupported envoy service type \"\" ",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyService: &egcfgv1a1.KubernetesServiceSpec{
+								Type: egcfgv1a1.GetKubernetesServiceType(""),
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "unsupported envoy service type 'NodePort'",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyService: &egcfgv1a1.KubernetesServiceSpec{
+								Type: egcfgv1a1.GetKubernetesServiceType(egcfgv1a1.ServiceType(corev1.ServiceTypeNodePort)),
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "valid envoy service type 'LoadBalancer'",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyService: &egcfgv1a1.KubernetesServiceSpec{
+								Type: egcfgv1a1.GetKubernetesServiceType(egcfgv1a1.ServiceTypeLoadBalancer),
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "valid envoy service type 'ClusterIP'",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyService: &egcfgv1a1.KubernetesServiceSpec{
+								Type: egcfgv1a1.GetKubernetesServiceType(egcfgv1a1.ServiceTypeClusterIP),
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "valid envoy hpa and pdb",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyHpa: &egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+								MinReplicas: pointer.Int32(2),
+								MaxReplicas: 5,
+							},
+							EnvoyPDB: &egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+								MinAvailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when envoy hpa minReplicas greater than maxReplicas",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyHpa: &egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+								MinReplicas: pointer.Int32(5),
+								MaxReplicas: 2,
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when envoy pdb sets both minAvailable and maxUnavailable",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyPDB: &egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+								MinAvailable:   &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+								MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "50%"},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid envoy daemonset with host network",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{
+								Pod: &egcfgv1a1.KubernetesPodSpec{
+									HostNetwork: true,
+								},
+							},
+							EnvoyPDB: &egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+								MinAvailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when both envoy daemonset and envoy deployment are set",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet:  &egcfgv1a1.KubernetesDaemonSetSpec{},
+							EnvoyDeployment: &egcfgv1a1.KubernetesDeploymentSpec{},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when envoy hpa is set for an envoy daemonset",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{},
+							EnvoyHpa: &egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+								MaxReplicas: 5,
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when envoy pdb of an envoy daemonset sets maxUnavailable",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{},
+							EnvoyPDB: &egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+								MaxUnavailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid shutdown drain timeout and termination grace period",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDeployment: &egcfgv1a1.KubernetesDeploymentSpec{
+								Pod: &egcfgv1a1.KubernetesPodSpec{
+									TerminationGracePeriodSeconds: pointer.Int64(120),
+								},
+							},
+						},
+					},
+					Shutdown: &egcfgv1a1.ShutdownConfig{
+						DrainTimeout:     &metav1.Duration{Duration: 90 * time.Second},
+						MinDrainDuration: &metav1.Duration{Duration: 10 * time.Second},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when shutdown minDrainDuration greater than drainTimeout",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Shutdown: &egcfgv1a1.ShutdownConfig{
+						DrainTimeout:     &metav1.Duration{Duration: 10 * time.Second},
+						MinDrainDuration: &metav1.Duration{Duration: 30 * time.Second},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when termination grace period less than the default shutdown drainTimeout",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{
+								Pod: &egcfgv1a1.KubernetesPodSpec{
+									TerminationGracePeriodSeconds: pointer.Int64(30),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid termination grace period equal to the default shutdown drainTimeout",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{
+								Pod: &egcfgv1a1.KubernetesPodSpec{
+									TerminationGracePeriodSeconds: pointer.Int64(60),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when termination grace period less than shutdown drainTimeout",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{
+								Pod: &egcfgv1a1.KubernetesPodSpec{
+									TerminationGracePeriodSeconds: pointer.Int64(30),
+								},
+							},
+						},
+					},
+					Shutdown: &egcfgv1a1.ShutdownConfig{
+						DrainTimeout: &metav1.Duration{Duration: 45 * time.Second},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid overload manager with the memory limit of the envoy container",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Provider: &egcfgv1a1.EnvoyProxyProvider{
+						Type: egcfgv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDeployment: &egcfgv1a1.KubernetesDeploymentSpec{
+								Container: &egcfgv1a1.KubernetesContainerSpec{
+									Resources: &corev1.ResourceRequirements{
+										Limits: corev1.ResourceList{
+											corev1.ResourceMemory: resource.MustParse("1Gi"),
+										},
+									},
+								},
+							},
+						},
+					},
+					OverloadManager: &egcfgv1a1.ProxyOverloadManager{
+						Actions: []egcfgv1a1.OverloadAction{
+							{
+								Type:                 egcfgv1a1.OverloadActionTypeDisableHTTPKeepAlive,
+								HeapThresholdPercent: 90,
+							},
+						},
+						MaxActiveDownstreamConnections: pointer.Int64(10000),
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when overload manager actions have no heap size",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					OverloadManager: &egcfgv1a1.ProxyOverloadManager{
+						Actions: egcfgv1a1.DefaultOverloadActions(),
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when overload manager action is duplicated",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					OverloadManager: &egcfgv1a1.ProxyOverloadManager{
+						MaxHeapSize: resourcePtr(resource.MustParse("1Gi")),
+						Actions: []egcfgv1a1.OverloadAction{
+							{
+								Type:                 egcfgv1a1.OverloadActionTypeStopAcceptingRequests,
+								HeapThresholdPercent: 95,
+							},
+							{
+								Type:                 egcfgv1a1.OverloadActionTypeStopAcceptingRequests,
+								HeapThresholdPercent: 98,
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "should invalid when overload manager maxActiveDownstreamConnections is not positive",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					OverloadManager: &egcfgv1a1.ProxyOverloadManager{
+						MaxActiveDownstreamConnections: pointer.Int64(0),
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid user bootstrap replace type",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Bootstrap: &egcfgv1a1.ProxyBootstrap{
+						Value: validUserBootstrap,
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "valid user bootstrap merge type",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Bootstrap: &egcfgv1a1.ProxyBootstrap{
+						Type:  (*egcfgv1a1.BootstrapType)(pointer.String(string(egcfgv1a1.BootstrapTypeMerge))),
+						Value: mergeUserBootstrap,
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "user bootstrap with missing admin address",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Bootstrap: &egcfgv1a1.ProxyBootstrap{
+						Value: missingAdminAddressUserBootstrap,
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "user bootstrap with different dynamic resources",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Bootstrap: &egcfgv1a1.ProxyBootstrap{
+						Value: differentDynamicResourcesUserBootstrap,
+					},
+				},
+			},
 			expected: false,
 		},
 		{
-			name: "nil provider",
+			name: "user bootstrap with different xds_cluster endpoint",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Provider: nil,
+					Bootstrap: &egcfgv1a1.ProxyBootstrap{
+						Value: differentXdsClusterAddressBootstrap,
+					},
 				},
 			},
-			expected: true,
+			expected: false,
 		},
 		{
-			name: "unsupported provider",
+			name: "should invalid when accesslog enabled using Text format, but `text` field being empty",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Provider: &egcfgv1a1.EnvoyProxyProvider{
-						Type: egcfgv1a1.ProviderTypeFile,
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 			expected: false,
 		},
 		{
-			name: "nil envoy service",
+			name: "should invalid when accesslog enabled using File sink, but `file` field being empty",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Provider: &egcfgv1a1.EnvoyProxyProvider{
-						Type: egcfgv1a1.ProviderTypeKubernetes,
-						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
-							EnvoyService: nil,
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeFile,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "valid accesslog filter and ALS sink",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Filter: &egcfgv1a1.ProxyAccessLogFilter{
+										StatusCodes: []egcfgv1a1.StatusCodeRange{
+											{Start: 500, End: 599},
+										},
+										ResponseFlags:  []string{"UH", "UF"},
+										NotHealthCheck: true,
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeALS,
+											ALS: &egcfgv1a1.ALSEnvoyProxyAccessLog{
+												Host: "als.monitoring.svc.cluster.local",
+												Port: 9000,
+											},
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -91,18 +697,27 @@ func TestValidateEnvoyProxy(t *testing.T) {
 			expected: true,
 		},
 		{
-			name: "unsupported envoy service type \"\" ",
+			name: "should invalid when accesslog enabled using ALS sink, but `als` field being empty",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Provider: &egcfgv1a1.EnvoyProxyProvider{
-						Type: egcfgv1a1.ProviderTypeKubernetes,
-						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
-							EnvoyService: &egcfgv1a1.KubernetesServiceSpec{
-								Type: egcfgv1a1.GetKubernetesServiceType(""),
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeALS,
+										},
+									},
+								},
 							},
 						},
 					},
@@ -111,38 +726,87 @@ func TestValidateEnvoyProxy(t *testing.T) {
 			expected: false,
 		},
 		{
-			name: "unsupported envoy service type 'NodePort'",
+			name: "should invalid when accesslog ALS http settings set for a TCP ALS sink",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Provider: &egcfgv1a1.EnvoyProxyProvider{
-						Type: egcfgv1a1.ProviderTypeKubernetes,
-						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
-							EnvoyService: &egcfgv1a1.KubernetesServiceSpec{
-								Type: egcfgv1a1.GetKubernetesServiceType(egcfgv1a1.ServiceType(corev1.ServiceTypeNodePort)),
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						AccessLog: &egcfgv1a1.ProxyAccessLog{
+							Settings: []egcfgv1a1.ProxyAccessLogSetting{
+								{
+									Format: egcfgv1a1.ProxyAccessLogFormat{
+										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeALS,
+											ALS: &egcfgv1a1.ALSEnvoyProxyAccessLog{
+												Host: "als.monitoring.svc.cluster.local",
+												Port: 9000,
+												Type: &tcpALSType,
+												HTTP: &egcfgv1a1.ALSEnvoyProxyHTTPAccessLogConfig{
+													RequestHeaders: []string{"x-request-id"},
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
 				},
 			},
-			expected: true,
+			expected: false,
 		},
 		{
-			name: "valid envoy service type 'LoadBalancer'",
+			name: "valid StatsD and DogStatsD sinks, stats tags and histogram buckets",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Provider: &egcfgv1a1.EnvoyProxyProvider{
-						Type: egcfgv1a1.ProviderTypeKubernetes,
-						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
-							EnvoyService: &egcfgv1a1.KubernetesServiceSpec{
-								Type: egcfgv1a1.GetKubernetesServiceType(egcfgv1a1.ServiceTypeLoadBalancer),
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Metrics: &egcfgv1a1.ProxyMetrics{
+							Sinks: []egcfgv1a1.MetricSink{
+								{
+									Type: egcfgv1a1.MetricSinkTypeStatsD,
+									StatsD: &egcfgv1a1.StatsDSink{
+										Host: "10.0.0.1",
+										Port: 8125,
+									},
+								},
+								{
+									Type: egcfgv1a1.MetricSinkTypeDogStatsD,
+									DogStatsD: &egcfgv1a1.StatsDSink{
+										Host:   "10.0.0.2",
+										Port:   8125,
+										Prefix: pointer.String("eg"),
+									},
+								},
+							},
+							StatsTags: []egcfgv1a1.StatsTag{
+								{
+									Name:       "cluster",
+									FixedValue: pointer.String("cluster-1"),
+								},
+								{
+									Name:  "listener_port",
+									Regex: pointer.String(`^listener\.[^.]+_(\d+)\.`),
+								},
+							},
+							HistogramBuckets: []egcfgv1a1.HistogramBucketSetting{
+								{
+									Match: egcfgv1a1.Match{
+										Type:  egcfgv1a1.Suffix,
+										Value: "rq_time",
+									},
+									Buckets: []string{"0.5", "1", "5", "10", "100", "1000"},
+								},
 							},
 						},
 					},
@@ -151,18 +815,26 @@ func TestValidateEnvoyProxy(t *testing.T) {
 			expected: true,
 		},
 		{
-			name: "valid envoy service type 'ClusterIP'",
+			name: "valid OpenTelemetry sink with resource attributes",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Provider: &egcfgv1a1.EnvoyProxyProvider{
-						Type: egcfgv1a1.ProviderTypeKubernetes,
-						Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
-							EnvoyService: &egcfgv1a1.KubernetesServiceSpec{
-								Type: egcfgv1a1.GetKubernetesServiceType(egcfgv1a1.ServiceTypeClusterIP),
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Metrics: &egcfgv1a1.ProxyMetrics{
+							Sinks: []egcfgv1a1.MetricSink{
+								{
+									Type: egcfgv1a1.MetricSinkTypeOpenTelemetry,
+									OpenTelemetry: &egcfgv1a1.OpenTelemetrySink{
+										Host: "otel-collector.monitoring.svc.cluster.local",
+										Port: 4317,
+										Resources: map[string]string{
+											"k8s.cluster.name": "cluster-1",
+										},
+									},
+								},
 							},
 						},
 					},
@@ -171,83 +843,129 @@ func TestValidateEnvoyProxy(t *testing.T) {
 			expected: true,
 		},
 		{
-			name: "valid user bootstrap replace type",
+			name: "should invalid when OpenTelemetry sink sets an empty resource attribute name",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Bootstrap: &egcfgv1a1.ProxyBootstrap{
-						Value: validUserBootstrap,
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Metrics: &egcfgv1a1.ProxyMetrics{
+							Sinks: []egcfgv1a1.MetricSink{
+								{
+									Type: egcfgv1a1.MetricSinkTypeOpenTelemetry,
+									OpenTelemetry: &egcfgv1a1.OpenTelemetrySink{
+										Host: "otel-collector.monitoring.svc.cluster.local",
+										Port: 4317,
+										Resources: map[string]string{
+											"": "cluster-1",
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
-			expected: true,
+			expected: false,
 		},
 		{
-			name: "valid user bootstrap merge type",
+			name: "should invalid when StatsD sink host is not an IP address",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Bootstrap: &egcfgv1a1.ProxyBootstrap{
-						Type:  (*egcfgv1a1.BootstrapType)(pointer.String(string(egcfgv1a1.BootstrapTypeMerge))),
-						Value: mergeUserBootstrap,
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Metrics: &egcfgv1a1.ProxyMetrics{
+							Sinks: []egcfgv1a1.MetricSink{
+								{
+									Type: egcfgv1a1.MetricSinkTypeStatsD,
+									StatsD: &egcfgv1a1.StatsDSink{
+										Host: "statsd.monitoring.svc.cluster.local",
+										Port: 8125,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
-			expected: true,
+			expected: false,
 		},
 		{
-			name: "user bootstrap with missing admin address",
+			name: "should invalid when metric sink type DogStatsD, but `dogStatsD` field being empty",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Bootstrap: &egcfgv1a1.ProxyBootstrap{
-						Value: missingAdminAddressUserBootstrap,
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Metrics: &egcfgv1a1.ProxyMetrics{
+							Sinks: []egcfgv1a1.MetricSink{
+								{
+									Type: egcfgv1a1.MetricSinkTypeDogStatsD,
+								},
+							},
+						},
 					},
 				},
 			},
 			expected: false,
 		},
 		{
-			name: "user bootstrap with different dynamic resources",
+			name: "should invalid when stats tag sets both regex and fixed value",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Bootstrap: &egcfgv1a1.ProxyBootstrap{
-						Value: differentDynamicResourcesUserBootstrap,
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Metrics: &egcfgv1a1.ProxyMetrics{
+							StatsTags: []egcfgv1a1.StatsTag{
+								{
+									Name:       "cluster",
+									Regex:      pointer.String(`^cluster\.((.+?)\.)`),
+									FixedValue: pointer.String("cluster-1"),
+								},
+							},
+						},
 					},
 				},
 			},
 			expected: false,
 		},
 		{
-			name: "user bootstrap with different xds_cluster endpoint",
+			name: "should invalid when histogram buckets are not in ascending order",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
 					Name:      "test",
 				},
 				Spec: egcfgv1a1.EnvoyProxySpec{
-					Bootstrap: &egcfgv1a1.ProxyBootstrap{
-						Value: differentXdsClusterAddressBootstrap,
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Metrics: &egcfgv1a1.ProxyMetrics{
+							HistogramBuckets: []egcfgv1a1.HistogramBucketSetting{
+								{
+									Match: egcfgv1a1.Match{
+										Type:  egcfgv1a1.Prefix,
+										Value: "cluster.",
+									},
+									Buckets: []string{"10", "5"},
+								},
+							},
+						},
 					},
 				},
 			},
 			expected: false,
 		},
 		{
-			name: "should invalid when accesslog enabled using Text format, but `text` field being empty",
+			name: "should invalid when accesslog filter status code range start greater than end",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
@@ -260,6 +978,20 @@ func TestValidateEnvoyProxy(t *testing.T) {
 								{
 									Format: egcfgv1a1.ProxyAccessLogFormat{
 										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
+										Text: pointer.String("[%START_TIME%]"),
+									},
+									Filter: &egcfgv1a1.ProxyAccessLogFilter{
+										StatusCodes: []egcfgv1a1.StatusCodeRange{
+											{Start: 599, End: 500},
+										},
+									},
+									Sinks: []egcfgv1a1.ProxyAccessLogSink{
+										{
+											Type: egcfgv1a1.ProxyAccessLogSinkTypeFile,
+											File: &egcfgv1a1.FileEnvoyProxyAccessLog{
+												Path: "/dev/stdout",
+											},
+										},
 									},
 								},
 							},
@@ -270,7 +1002,7 @@ func TestValidateEnvoyProxy(t *testing.T) {
 			expected: false,
 		},
 		{
-			name: "should invalid when accesslog enabled using File sink, but `file` field being empty",
+			name: "should invalid when accesslog filter has an unknown response flag",
 			proxy: &egcfgv1a1.EnvoyProxy{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test",
@@ -285,9 +1017,15 @@ func TestValidateEnvoyProxy(t *testing.T) {
 										Type: egcfgv1a1.ProxyAccessLogFormatTypeText,
 										Text: pointer.String("[%START_TIME%]"),
 									},
+									Filter: &egcfgv1a1.ProxyAccessLogFilter{
+										ResponseFlags: []string{"UNKNOWN"},
+									},
 									Sinks: []egcfgv1a1.ProxyAccessLogSink{
 										{
 											Type: egcfgv1a1.ProxyAccessLogSinkTypeFile,
+											File: &egcfgv1a1.FileEnvoyProxyAccessLog{
+												Path: "/dev/stdout",
+											},
 										},
 									},
 								},
@@ -298,6 +1036,52 @@ func TestValidateEnvoyProxy(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "valid zipkin tracing provider",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Tracing: &egcfgv1a1.ProxyTracing{
+							Provider: egcfgv1a1.TracingProvider{
+								Type: egcfgv1a1.TracingProviderTypeZipkin,
+								Host: "zipkin.monitoring.svc.cluster.local",
+								Zipkin: &egcfgv1a1.ZipkinTracingProvider{
+									CollectorEndpoint: pointer.String("/api/v2/spans"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "should invalid when tracing zipkin settings set for a non Zipkin provider",
+			proxy: &egcfgv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Tracing: &egcfgv1a1.ProxyTracing{
+							Provider: egcfgv1a1.TracingProvider{
+								Type: egcfgv1a1.TracingProviderTypeDatadog,
+								Host: "datadog-agent.monitoring.svc.cluster.local",
+								Zipkin: &egcfgv1a1.ZipkinTracingProvider{
+									CollectorEndpoint: pointer.String("/api/v2/spans"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for i := range testCases {
@@ -481,6 +1265,9 @@ func TestEnvoyProxyProvider(t *testing.T) {
 
 	assert.True(t, envoyProxyProvider.Kubernetes.EnvoyService != nil)
 	assert.True(t, reflect.DeepEqual(envoyProxyProvider.Kubernetes.EnvoyService.Type, egcfgv1a1.GetKubernetesServiceType(egcfgv1a1.ServiceTypeLoadBalancer)))
+
+	assert.True(t, envoyProxyProvider.Kubernetes.ShutdownManager != nil)
+	assert.Equal(t, envoyProxyProvider.Kubernetes.ShutdownManager.Image, egcfgv1a1.DefaultKubernetesContainerImage(egcfgv1a1.DefaultShutdownManagerImage))
 }
 
 func TestEnvoyGatewayAdmin(t *testing.T) {
@@ -602,3 +1389,7 @@ func TestGetEnvoyProxyComponentLevelArgs(t *testing.T) {
 		})
 	}
 }
+
+func resourcePtr(quantity resource.Quantity) *resource.Quantity {
+	return &quantity
+}
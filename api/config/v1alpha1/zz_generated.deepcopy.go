@@ -12,12 +12,74 @@ package v1alpha1
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ALSEnvoyProxyAccessLog) DeepCopyInto(out *ALSEnvoyProxyAccessLog) {
+	*out = *in
+	if in.LogName != nil {
+		in, out := &in.LogName, &out.LogName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ALSEnvoyProxyAccessLogType)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ALSEnvoyProxyHTTPAccessLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALSEnvoyProxyAccessLog.
+func (in *ALSEnvoyProxyAccessLog) DeepCopy() *ALSEnvoyProxyAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(ALSEnvoyProxyAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ALSEnvoyProxyHTTPAccessLogConfig) DeepCopyInto(out *ALSEnvoyProxyHTTPAccessLogConfig) {
+	*out = *in
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseTrailers != nil {
+		in, out := &in.ResponseTrailers, &out.ResponseTrailers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALSEnvoyProxyHTTPAccessLogConfig.
+func (in *ALSEnvoyProxyHTTPAccessLogConfig) DeepCopy() *ALSEnvoyProxyHTTPAccessLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ALSEnvoyProxyHTTPAccessLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTag) DeepCopyInto(out *CustomTag) {
 	*out = *in
@@ -387,6 +449,26 @@ func (in *EnvoyProxyKubernetesProvider) DeepCopyInto(out *EnvoyProxyKubernetesPr
 		*out = new(KubernetesServiceSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EnvoyDaemonSet != nil {
+		in, out := &in.EnvoyDaemonSet, &out.EnvoyDaemonSet
+		*out = new(KubernetesDaemonSetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvoyHpa != nil {
+		in, out := &in.EnvoyHpa, &out.EnvoyHpa
+		*out = new(KubernetesHorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvoyPDB != nil {
+		in, out := &in.EnvoyPDB, &out.EnvoyPDB
+		*out = new(KubernetesPodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShutdownManager != nil {
+		in, out := &in.ShutdownManager, &out.ShutdownManager
+		*out = new(ShutdownManager)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyProxyKubernetesProvider.
@@ -471,6 +553,21 @@ func (in *EnvoyProxySpec) DeepCopyInto(out *EnvoyProxySpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.IPFamily != nil {
+		in, out := &in.IPFamily, &out.IPFamily
+		*out = new(IPFamily)
+		**out = **in
+	}
+	if in.Shutdown != nil {
+		in, out := &in.Shutdown, &out.Shutdown
+		*out = new(ShutdownConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OverloadManager != nil {
+		in, out := &in.OverloadManager, &out.OverloadManager
+		*out = new(ProxyOverloadManager)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyProxySpec.
@@ -644,6 +741,26 @@ func (in *GroupVersionKind) DeepCopy() *GroupVersionKind {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HistogramBucketSetting) DeepCopyInto(out *HistogramBucketSetting) {
+	*out = *in
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistogramBucketSetting.
+func (in *HistogramBucketSetting) DeepCopy() *HistogramBucketSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(HistogramBucketSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesContainerSpec) DeepCopyInto(out *KubernetesContainerSpec) {
 	*out = *in
@@ -688,6 +805,36 @@ func (in *KubernetesContainerSpec) DeepCopy() *KubernetesContainerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesDaemonSetSpec) DeepCopyInto(out *KubernetesDaemonSetSpec) {
+	*out = *in
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DaemonSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(KubernetesPodSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(KubernetesContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesDaemonSetSpec.
+func (in *KubernetesDaemonSetSpec) DeepCopy() *KubernetesDaemonSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesDaemonSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesDeployMode) DeepCopyInto(out *KubernetesDeployMode) {
 	*out = *in
@@ -738,6 +885,63 @@ func (in *KubernetesDeploymentSpec) DeepCopy() *KubernetesDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesHorizontalPodAutoscalerSpec) DeepCopyInto(out *KubernetesHorizontalPodAutoscalerSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesHorizontalPodAutoscalerSpec.
+func (in *KubernetesHorizontalPodAutoscalerSpec) DeepCopy() *KubernetesHorizontalPodAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesHorizontalPodAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesPodDisruptionBudgetSpec) DeepCopyInto(out *KubernetesPodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesPodDisruptionBudgetSpec.
+func (in *KubernetesPodDisruptionBudgetSpec) DeepCopy() *KubernetesPodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesPodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesPodSpec) DeepCopyInto(out *KubernetesPodSpec) {
 	*out = *in
@@ -779,6 +983,16 @@ func (in *KubernetesPodSpec) DeepCopyInto(out *KubernetesPodSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DNSPolicy != nil {
+		in, out := &in.DNSPolicy, &out.DNSPolicy
+		*out = new(corev1.DNSPolicy)
+		**out = **in
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesPodSpec.
@@ -879,7 +1093,17 @@ func (in *MetricSink) DeepCopyInto(out *MetricSink) {
 	if in.OpenTelemetry != nil {
 		in, out := &in.OpenTelemetry, &out.OpenTelemetry
 		*out = new(OpenTelemetrySink)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StatsD != nil {
+		in, out := &in.StatsD, &out.StatsD
+		*out = new(StatsDSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DogStatsD != nil {
+		in, out := &in.DogStatsD, &out.DogStatsD
+		*out = new(StatsDSink)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -918,6 +1142,23 @@ func (in *OpenTelemetryEnvoyProxyAccessLog) DeepCopy() *OpenTelemetryEnvoyProxyA
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenTelemetrySink) DeepCopyInto(out *OpenTelemetrySink) {
 	*out = *in
+	if in.EmitTagsAsAttributes != nil {
+		in, out := &in.EmitTagsAsAttributes, &out.EmitTagsAsAttributes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseTagExtractedName != nil {
+		in, out := &in.UseTagExtractedName, &out.UseTagExtractedName
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetrySink.
@@ -930,6 +1171,21 @@ func (in *OpenTelemetrySink) DeepCopy() *OpenTelemetrySink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverloadAction) DeepCopyInto(out *OverloadAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverloadAction.
+func (in *OverloadAction) DeepCopy() *OverloadAction {
+	if in == nil {
+		return nil
+	}
+	out := new(OverloadAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusProvider) DeepCopyInto(out *PrometheusProvider) {
 	*out = *in
@@ -967,6 +1223,46 @@ func (in *ProxyAccessLog) DeepCopy() *ProxyAccessLog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyAccessLogFilter) DeepCopyInto(out *ProxyAccessLogFilter) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]StatusCodeRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinDuration != nil {
+		in, out := &in.MinDuration, &out.MinDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ResponseFlags != nil {
+		in, out := &in.ResponseFlags, &out.ResponseFlags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(ProxyAccessLogSampling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyAccessLogFilter.
+func (in *ProxyAccessLogFilter) DeepCopy() *ProxyAccessLogFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyAccessLogFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyAccessLogFormat) DeepCopyInto(out *ProxyAccessLogFormat) {
 	*out = *in
@@ -994,10 +1290,35 @@ func (in *ProxyAccessLogFormat) DeepCopy() *ProxyAccessLogFormat {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyAccessLogSampling) DeepCopyInto(out *ProxyAccessLogSampling) {
+	*out = *in
+	if in.RuntimeKey != nil {
+		in, out := &in.RuntimeKey, &out.RuntimeKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyAccessLogSampling.
+func (in *ProxyAccessLogSampling) DeepCopy() *ProxyAccessLogSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyAccessLogSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyAccessLogSetting) DeepCopyInto(out *ProxyAccessLogSetting) {
 	*out = *in
 	in.Format.DeepCopyInto(&out.Format)
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(ProxyAccessLogFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Sinks != nil {
 		in, out := &in.Sinks, &out.Sinks
 		*out = make([]ProxyAccessLogSink, len(*in))
@@ -1030,6 +1351,11 @@ func (in *ProxyAccessLogSink) DeepCopyInto(out *ProxyAccessLogSink) {
 		*out = new(OpenTelemetryEnvoyProxyAccessLog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ALS != nil {
+		in, out := &in.ALS, &out.ALS
+		*out = new(ALSEnvoyProxyAccessLog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyAccessLogSink.
@@ -1104,6 +1430,20 @@ func (in *ProxyMetrics) DeepCopyInto(out *ProxyMetrics) {
 		*out = make([]Match, len(*in))
 		copy(*out, *in)
 	}
+	if in.StatsTags != nil {
+		in, out := &in.StatsTags, &out.StatsTags
+		*out = make([]StatsTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HistogramBuckets != nil {
+		in, out := &in.HistogramBuckets, &out.HistogramBuckets
+		*out = make([]HistogramBucketSetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyMetrics.
@@ -1116,6 +1456,36 @@ func (in *ProxyMetrics) DeepCopy() *ProxyMetrics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyOverloadManager) DeepCopyInto(out *ProxyOverloadManager) {
+	*out = *in
+	if in.MaxHeapSize != nil {
+		in, out := &in.MaxHeapSize, &out.MaxHeapSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]OverloadAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxActiveDownstreamConnections != nil {
+		in, out := &in.MaxActiveDownstreamConnections, &out.MaxActiveDownstreamConnections
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyOverloadManager.
+func (in *ProxyOverloadManager) DeepCopy() *ProxyOverloadManager {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyOverloadManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyTelemetry) DeepCopyInto(out *ProxyTelemetry) {
 	*out = *in
@@ -1161,7 +1531,7 @@ func (in *ProxyTracing) DeepCopyInto(out *ProxyTracing) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
-	out.Provider = in.Provider
+	in.Provider.DeepCopyInto(&out.Provider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyTracing.
@@ -1275,9 +1645,119 @@ func (in *RequestHeaderCustomTag) DeepCopy() *RequestHeaderCustomTag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownConfig) DeepCopyInto(out *ShutdownConfig) {
+	*out = *in
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinDrainDuration != nil {
+		in, out := &in.MinDrainDuration, &out.MinDrainDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownConfig.
+func (in *ShutdownConfig) DeepCopy() *ShutdownConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownManager) DeepCopyInto(out *ShutdownManager) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownManager.
+func (in *ShutdownManager) DeepCopy() *ShutdownManager {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatsDSink) DeepCopyInto(out *StatsDSink) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatsDSink.
+func (in *StatsDSink) DeepCopy() *StatsDSink {
+	if in == nil {
+		return nil
+	}
+	out := new(StatsDSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatsTag) DeepCopyInto(out *StatsTag) {
+	*out = *in
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+	if in.FixedValue != nil {
+		in, out := &in.FixedValue, &out.FixedValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatsTag.
+func (in *StatsTag) DeepCopy() *StatsTag {
+	if in == nil {
+		return nil
+	}
+	out := new(StatsTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusCodeRange) DeepCopyInto(out *StatusCodeRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusCodeRange.
+func (in *StatusCodeRange) DeepCopy() *StatusCodeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCodeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingProvider) DeepCopyInto(out *TracingProvider) {
 	*out = *in
+	if in.Zipkin != nil {
+		in, out := &in.Zipkin, &out.Zipkin
+		*out = new(ZipkinTracingProvider)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingProvider.
@@ -1314,3 +1794,33 @@ func (in *XDSTranslatorHooks) DeepCopy() *XDSTranslatorHooks {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZipkinTracingProvider) DeepCopyInto(out *ZipkinTracingProvider) {
+	*out = *in
+	if in.CollectorEndpoint != nil {
+		in, out := &in.CollectorEndpoint, &out.CollectorEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(ZipkinEncoding)
+		**out = **in
+	}
+	if in.Enable128BitTraceID != nil {
+		in, out := &in.Enable128BitTraceID, &out.Enable128BitTraceID
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZipkinTracingProvider.
+func (in *ZipkinTracingProvider) DeepCopy() *ZipkinTracingProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ZipkinTracingProvider)
+	in.DeepCopyInto(out)
+	return out
+}
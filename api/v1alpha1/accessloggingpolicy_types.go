@@ -0,0 +1,126 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+const (
+	// KindAccessLoggingPolicy is the name of the AccessLoggingPolicy kind.
+	KindAccessLoggingPolicy = "AccessLoggingPolicy"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AccessLoggingPolicy allows the user to log the requests handled by a Gateway
+// Listener or a route, in addition to the access logs configured in the EnvoyProxy.
+type AccessLoggingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of AccessLoggingPolicy.
+	Spec AccessLoggingPolicySpec `json:"spec"`
+
+	// Status defines the current status of AccessLoggingPolicy.
+	Status AccessLoggingPolicyStatus `json:"status,omitempty"`
+}
+
+// AccessLoggingPolicySpec defines the desired state of AccessLoggingPolicy.
+type AccessLoggingPolicySpec struct {
+	// TargetRef is the name of the Gateway, HTTPRoute or GRPCRoute
+	// resource this policy is being attached to.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect and be applied to the resource.
+	// If SectionName is set when targeting a Gateway, the policy only
+	// applies to the Listener with that name, and takes precedence
+	// over a policy that targets the whole Gateway.
+	// A policy targeting a route replaces the policy of its Gateway
+	// for the requests of the route.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+	// Settings defines the access logs of the requests handled by the target.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Settings []AccessLoggingPolicySetting `json:"settings"`
+}
+
+// AccessLoggingPolicySetting defines an access log of the requests handled
+// by the target of the policy.
+type AccessLoggingPolicySetting struct {
+	// Format defines the format of the access log.
+	Format egcfgv1a1.ProxyAccessLogFormat `json:"format"`
+	// Filter defines the conditions a request must match to be logged.
+	// If unspecified, all the requests are logged.
+	//
+	// +optional
+	Filter *egcfgv1a1.ProxyAccessLogFilter `json:"filter,omitempty"`
+	// Sinks defines the sinks of the access log.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Sinks []AccessLoggingPolicySink `json:"sinks"`
+}
+
+// AccessLoggingPolicySink defines a sink of the access log.
+type AccessLoggingPolicySink struct {
+	// Type defines the type of the sink.
+	//
+	// +kubebuilder:validation:Enum=File;OpenTelemetry
+	Type egcfgv1a1.ProxyAccessLogSinkType `json:"type"`
+	// File defines the file sink. It's required when the type is "File".
+	//
+	// +optional
+	File *egcfgv1a1.FileEnvoyProxyAccessLog `json:"file,omitempty"`
+	// OpenTelemetry defines the OpenTelemetry sink. It's required when
+	// the type is "OpenTelemetry".
+	//
+	// +optional
+	OpenTelemetry *AccessLoggingPolicyOpenTelemetrySink `json:"openTelemetry,omitempty"`
+}
+
+// AccessLoggingPolicyOpenTelemetrySink defines an OpenTelemetry collector
+// the access logs are sent to.
+type AccessLoggingPolicyOpenTelemetrySink struct {
+	// BackendRef references the Service of the OpenTelemetry collector.
+	// A reference to a Service in another namespace must be allowed
+	// by a ReferenceGrant.
+	BackendRef gwapiv1b1.BackendObjectReference `json:"backendRef"`
+	// Resources is a set of labels that describe the source of a log entry.
+	// It's recommended to follow [semantic conventions](https://opentelemetry.io/docs/reference/specification/resource/semantic_conventions/).
+	//
+	// +optional
+	Resources map[string]string `json:"resources,omitempty"`
+}
+
+// AccessLoggingPolicyStatus defines the state of AccessLoggingPolicy
+type AccessLoggingPolicyStatus struct {
+	// Conditions describe the current conditions of the AccessLoggingPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AccessLoggingPolicyList contains a list of AccessLoggingPolicy resources.
+type AccessLoggingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessLoggingPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessLoggingPolicy{}, &AccessLoggingPolicyList{})
+}
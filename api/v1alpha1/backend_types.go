@@ -0,0 +1,98 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KindBackend is the name of the Backend kind.
+	KindBackend = "Backend"
+)
+
+// +kubebuilder:object:root=true
+
+// Backend allows the user to configure the endpoints of a backend that
+// is not a Kubernetes Service, such as an external API reachable through
+// its fully qualified domain name. A Backend is referenced from the
+// backendRefs of a route.
+type Backend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of Backend.
+	Spec BackendSpec `json:"spec"`
+}
+
+// BackendSpec defines the desired state of Backend.
+type BackendSpec struct {
+	// Endpoints is the list of endpoints of the backend. Traffic is
+	// load balanced across all the endpoints.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Endpoints []BackendEndpoint `json:"endpoints"`
+	// TLS contains the TLS settings used to connect to the endpoints.
+	// If unset, connections to the endpoints are plaintext.
+	//
+	// +optional
+	TLS *BackendTLSConfig `json:"tls,omitempty"`
+	// DNS contains the settings used to resolve the FQDN endpoints.
+	//
+	// +optional
+	DNS *BackendDNSSettings `json:"dns,omitempty"`
+}
+
+// BackendEndpoint describes an endpoint of a Backend.
+// Exactly one of FQDN and IP must be set.
+type BackendEndpoint struct {
+	// FQDN is the fully qualified domain name of the endpoint,
+	// which is periodically resolved by Envoy Proxy.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^(([a-z0-9][-a-z0-9]*[a-z0-9]|[a-z0-9])\.)*([a-z0-9][-a-z0-9]*[a-z0-9]|[a-z0-9])$`
+	FQDN *string `json:"fqdn,omitempty"`
+	// IP is the IPv4 or IPv6 address of the endpoint.
+	//
+	// +optional
+	IP *string `json:"ip,omitempty"`
+	// Port is the port of the endpoint.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// BackendDNSSettings describes how the FQDN endpoints of a Backend are resolved.
+type BackendDNSSettings struct {
+	// RefreshRate is the interval at which the FQDN endpoints are
+	// resolved. Defaults to 30s.
+	//
+	// +optional
+	RefreshRate *metav1.Duration `json:"refreshRate,omitempty"`
+	// RespectDNSTTL configures Envoy Proxy to use the TTL of the DNS
+	// records as the refresh interval, instead of RefreshRate.
+	// Defaults to true.
+	//
+	// +optional
+	RespectDNSTTL *bool `json:"respectDnsTtl,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BackendList contains a list of Backend resources.
+type BackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Backend `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Backend{}, &BackendList{})
+}
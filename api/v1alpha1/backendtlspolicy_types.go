@@ -0,0 +1,101 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindBackendTLSPolicy is the name of the BackendTLSPolicy kind.
+	KindBackendTLSPolicy = "BackendTLSPolicy"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// BackendTLSPolicy allows the user to configure the TLS connection
+// established by Envoy Proxy to a backend Service.
+type BackendTLSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of BackendTLSPolicy.
+	Spec BackendTLSPolicySpec `json:"spec"`
+
+	// Status defines the current status of BackendTLSPolicy.
+	Status BackendTLSPolicyStatus `json:"status,omitempty"`
+}
+
+// BackendTLSPolicySpec defines the desired state of BackendTLSPolicy.
+type BackendTLSPolicySpec struct {
+	// TargetRef is the name of the Service resource this policy
+	// is being attached to.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect and be applied to the Service.
+	// If SectionName is set, the policy only applies to the Service
+	// port with that name, and takes precedence over a policy that
+	// targets the whole Service.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+	// TLS contains the TLS settings used to connect to the backend.
+	TLS BackendTLSConfig `json:"tls"`
+}
+
+// BackendTLSConfig describes the TLS settings used to connect to a backend.
+type BackendTLSConfig struct {
+	// CACertificateRefs contains one or more references to Secrets or
+	// ConfigMaps holding the CA certificates, under the "ca.crt" key,
+	// used to validate the certificate presented by the backend.
+	//
+	// References to a resource in a different namespace are invalid
+	// unless there is a ReferenceGrant in the target namespace that
+	// allows the reference.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	CACertificateRefs []gwapiv1b1.SecretObjectReference `json:"caCertificateRefs"`
+	// Hostname is the server name sent to the backend using SNI. The
+	// certificate presented by the backend must contain it as a DNS
+	// Subject Alternative Name.
+	Hostname gwapiv1b1.PreciseHostname `json:"hostname"`
+	// ClientCertificateRef is a reference to a Secret of type
+	// "kubernetes.io/tls" holding the client certificate presented
+	// to the backend when it requires mutual TLS.
+	//
+	// References to a Secret in a different namespace are invalid
+	// unless there is a ReferenceGrant in the target namespace that
+	// allows the reference.
+	//
+	// +optional
+	ClientCertificateRef *gwapiv1b1.SecretObjectReference `json:"clientCertificateRef,omitempty"`
+}
+
+// BackendTLSPolicyStatus defines the state of BackendTLSPolicy
+type BackendTLSPolicyStatus struct {
+	// Conditions describe the current conditions of the BackendTLSPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BackendTLSPolicyList contains a list of BackendTLSPolicy resources.
+type BackendTLSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackendTLSPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BackendTLSPolicy{}, &BackendTLSPolicyList{})
+}
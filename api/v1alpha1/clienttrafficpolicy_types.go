@@ -0,0 +1,242 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KindClientTrafficPolicy is the name of the ClientTrafficPolicy kind.
+	KindClientTrafficPolicy = "ClientTrafficPolicy"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClientTrafficPolicy allows the user to configure the behavior of the connection
+// between the downstream client and Envoy Proxy listener.
+type ClientTrafficPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of ClientTrafficPolicy.
+	Spec ClientTrafficPolicySpec `json:"spec"`
+
+	// Status defines the current status of ClientTrafficPolicy.
+	Status ClientTrafficPolicyStatus `json:"status,omitempty"`
+}
+
+// ClientTrafficPolicySpec defines the desired state of ClientTrafficPolicy.
+type ClientTrafficPolicySpec struct {
+	// TargetRef is the name of the Gateway resource this policy
+	// is being attached to.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect and be applied to the Gateway.
+	// If SectionName is set, the policy only applies to the Listener
+	// with that name, and takes precedence over a policy that
+	// targets the whole Gateway.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+	// TLS settings configure TLS termination settings with the downstream client.
+	// They only apply to HTTPS Listeners.
+	//
+	// +optional
+	TLS *TLSSettings `json:"tls,omitempty"`
+	// Headers defines settings for the headers of the requests
+	// received from the downstream client.
+	//
+	// +optional
+	Headers *HeaderSettings `json:"headers,omitempty"`
+	// Timeouts defines the timeouts of the connections and requests
+	// received from the downstream client.
+	//
+	// +optional
+	Timeouts *ClientTimeouts `json:"timeouts,omitempty"`
+	// Path defines how the path of the requests received from the
+	// downstream client is normalized before routing.
+	//
+	// +optional
+	Path *PathSettings `json:"path,omitempty"`
+	// HTTP1 defines the HTTP/1 protocol options of the Listener.
+	//
+	// +optional
+	HTTP1 *HTTP1Settings `json:"http1,omitempty"`
+	// Upgrades defines the HTTP protocol upgrades, such as WebSocket,
+	// and the CONNECT requests accepted by the Listener. They can be
+	// overridden for the requests of a route with an UpgradeFilter.
+	//
+	// +optional
+	Upgrades *UpgradeSettings `json:"upgrades,omitempty"`
+	// LocalReply defines the custom responses returned in place of
+	// the responses generated by Envoy Proxy itself.
+	//
+	// +optional
+	LocalReply *LocalReplyConfig `json:"localReply,omitempty"`
+}
+
+// HeaderSettings provides configuration options for headers on the listener.
+type HeaderSettings struct {
+	// XForwardedClientCert configures how the x-forwarded-client-cert (XFCC)
+	// HTTP header is handled for requests received over a mutual TLS connection.
+	// Defaults to sanitizing the header.
+	//
+	// +optional
+	XForwardedClientCert *XForwardedClientCert `json:"xForwardedClientCert,omitempty"`
+	// MaxRequestHeadersKiB is the maximum size, in KiB, of the headers
+	// of a request. Requests with larger headers are rejected.
+	// Defaults to 60 KiB.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8192
+	MaxRequestHeadersKiB *uint32 `json:"maxRequestHeadersKiB,omitempty"`
+	// MaxRequestHeaders is the maximum number of headers of a request.
+	// Requests with more headers are rejected.
+	// Defaults to 100.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestHeaders *uint32 `json:"maxRequestHeaders,omitempty"`
+}
+
+// XForwardedClientCert configures the handling of the x-forwarded-client-cert
+// (XFCC) HTTP header.
+type XForwardedClientCert struct {
+	// Mode defines how the XFCC header is handled.
+	// Valid values are "Sanitize", "ForwardOnly", "AppendForward",
+	// "SanitizeSet" and "AlwaysForwardOnly".
+	//
+	// +optional
+	Mode *XFCCForwardMode `json:"mode,omitempty"`
+	// CertDetailsToAdd specifies the fields of the client certificate
+	// to be added to the XFCC header. The header always contains the
+	// By and Hash fields.
+	// Only applies when Mode is "AppendForward" or "SanitizeSet".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=5
+	CertDetailsToAdd []XFCCCertData `json:"certDetailsToAdd,omitempty"`
+}
+
+// XFCCForwardMode defines how the XFCC header is forwarded to the upstream.
+// +kubebuilder:validation:Enum=Sanitize;ForwardOnly;AppendForward;SanitizeSet;AlwaysForwardOnly
+type XFCCForwardMode string
+
+const (
+	// XFCCForwardModeSanitize removes the XFCC header from the request.
+	XFCCForwardModeSanitize XFCCForwardMode = "Sanitize"
+	// XFCCForwardModeForwardOnly forwards the XFCC header in the request
+	// when the client connection is mTLS.
+	XFCCForwardModeForwardOnly XFCCForwardMode = "ForwardOnly"
+	// XFCCForwardModeAppendForward appends the client certificate
+	// information to the XFCC header when the client connection is mTLS.
+	XFCCForwardModeAppendForward XFCCForwardMode = "AppendForward"
+	// XFCCForwardModeSanitizeSet resets the XFCC header with the client
+	// certificate information when the client connection is mTLS.
+	XFCCForwardModeSanitizeSet XFCCForwardMode = "SanitizeSet"
+	// XFCCForwardModeAlwaysForwardOnly always forwards the XFCC header
+	// in the request, regardless of whether the client connection is mTLS.
+	XFCCForwardModeAlwaysForwardOnly XFCCForwardMode = "AlwaysForwardOnly"
+)
+
+// XFCCCertData specifies a field of the client certificate to be
+// added to the XFCC header.
+// +kubebuilder:validation:Enum=Subject;Cert;Chain;DNS;URI
+type XFCCCertData string
+
+const (
+	// XFCCCertDataSubject adds the Subject field of the client certificate.
+	XFCCCertDataSubject XFCCCertData = "Subject"
+	// XFCCCertDataCert adds the entire PEM encoded client certificate.
+	XFCCCertDataCert XFCCCertData = "Cert"
+	// XFCCCertDataChain adds the entire PEM encoded client certificate chain.
+	XFCCCertDataChain XFCCCertData = "Chain"
+	// XFCCCertDataDNS adds the DNS type Subject Alternative Names of the
+	// client certificate.
+	XFCCCertDataDNS XFCCCertData = "DNS"
+	// XFCCCertDataURI adds the URI type Subject Alternative Name of the
+	// client certificate.
+	XFCCCertDataURI XFCCCertData = "URI"
+)
+
+// PathSettings provides settings that manage how the incoming path is
+// normalized before routing.
+type PathSettings struct {
+	// DisableNormalization disables the normalization of the path
+	// according to RFC 3986. The path is normalized by default.
+	//
+	// +optional
+	DisableNormalization bool `json:"disableNormalization,omitempty"`
+	// DisableMergeSlashes disables merging adjacent slashes in the path.
+	// Adjacent slashes are merged by default.
+	//
+	// +optional
+	DisableMergeSlashes bool `json:"disableMergeSlashes,omitempty"`
+	// EscapedSlashesAction determines how the escaped slashes ("%2F",
+	// "%2f", "%5C" and "%5c") in the path are handled.
+	// Defaults to "UnescapeAndRedirect".
+	//
+	// +optional
+	EscapedSlashesAction *PathEscapedSlashAction `json:"escapedSlashesAction,omitempty"`
+}
+
+// PathEscapedSlashAction determines the action taken when the path
+// contains escaped slashes.
+// +kubebuilder:validation:Enum=KeepUnchanged;RejectRequest;UnescapeAndRedirect;UnescapeAndForward
+type PathEscapedSlashAction string
+
+const (
+	// PathEscapedSlashActionKeepUnchanged keeps the escaped slashes as they are.
+	PathEscapedSlashActionKeepUnchanged PathEscapedSlashAction = "KeepUnchanged"
+	// PathEscapedSlashActionRejectRequest rejects the request with a 400 status.
+	PathEscapedSlashActionRejectRequest PathEscapedSlashAction = "RejectRequest"
+	// PathEscapedSlashActionUnescapeAndRedirect unescapes the slashes and
+	// redirects the client to the unescaped path.
+	PathEscapedSlashActionUnescapeAndRedirect PathEscapedSlashAction = "UnescapeAndRedirect"
+	// PathEscapedSlashActionUnescapeAndForward unescapes the slashes and
+	// forwards the request with the unescaped path.
+	PathEscapedSlashActionUnescapeAndForward PathEscapedSlashAction = "UnescapeAndForward"
+)
+
+// HTTP1Settings provides HTTP/1 configuration on the listener.
+type HTTP1Settings struct {
+	// PreserveHeaderCase determines if the case of the HTTP/1 headers
+	// is preserved when proxying the request, instead of being lowercased.
+	//
+	// +optional
+	PreserveHeaderCase bool `json:"preserveHeaderCase,omitempty"`
+	// EnableHTTP10 determines if HTTP/1.0 requests are accepted.
+	// They are rejected by default.
+	//
+	// +optional
+	EnableHTTP10 bool `json:"enableHTTP10,omitempty"`
+}
+
+// ClientTrafficPolicyStatus defines the state of ClientTrafficPolicy
+type ClientTrafficPolicyStatus struct {
+	// Conditions describe the current conditions of the ClientTrafficPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClientTrafficPolicyList contains a list of ClientTrafficPolicy resources.
+type ClientTrafficPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClientTrafficPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClientTrafficPolicy{}, &ClientTrafficPolicyList{})
+}
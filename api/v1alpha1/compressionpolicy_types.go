@@ -0,0 +1,116 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KindCompressionPolicy is the name of the CompressionPolicy kind.
+	KindCompressionPolicy = "CompressionPolicy"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CompressionPolicy allows the user to configure the compression
+// of the responses sent by Envoy Proxy to the downstream clients.
+type CompressionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of CompressionPolicy.
+	Spec CompressionPolicySpec `json:"spec"`
+
+	// Status defines the current status of CompressionPolicy.
+	Status CompressionPolicyStatus `json:"status,omitempty"`
+}
+
+// CompressionPolicySpec defines the desired state of CompressionPolicy.
+type CompressionPolicySpec struct {
+	// TargetRef is the name of the Gateway or HTTPRoute resource this
+	// policy is being attached to.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect and be applied to the resource.
+	// If SectionName is set when targeting a Gateway, the policy only
+	// applies to the Listener with that name, and takes precedence
+	// over a policy that targets the whole Gateway.
+	// A policy targeting an HTTPRoute can only be used to disable the
+	// compression enabled on its Gateway.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+	// Compressors is the list of compression algorithms offered to the
+	// clients, in order of preference. The first one accepted by the
+	// client, according to the "Accept-Encoding" request header, is used.
+	// It is required when targeting a Gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=3
+	Compressors []CompressorType `json:"compressors,omitempty"`
+	// MinContentLength is the minimum size, in bytes, of the responses
+	// to compress. Defaults to 30 bytes.
+	//
+	// +optional
+	MinContentLength *uint32 `json:"minContentLength,omitempty"`
+	// ContentTypes is the list of content types of the responses to
+	// compress. Defaults to common text types such as "text/html",
+	// "application/json" and "application/javascript".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	ContentTypes []string `json:"contentTypes,omitempty"`
+	// DisableOnETagHeader disables the compression of the responses
+	// that contain an "ETag" header, since the compression changes the
+	// representation of the response. Otherwise, strong ETags are
+	// converted to weak ones.
+	//
+	// +optional
+	DisableOnETagHeader bool `json:"disableOnETagHeader,omitempty"`
+	// Disabled disables the compression of the responses of the targeted
+	// HTTPRoute. It can only be set when targeting an HTTPRoute.
+	//
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// CompressorType defines the compression algorithm of a compressor.
+// +kubebuilder:validation:Enum=Gzip;Brotli;Zstd
+type CompressorType string
+
+const (
+	// GzipCompressorType compresses responses using gzip.
+	GzipCompressorType CompressorType = "Gzip"
+	// BrotliCompressorType compresses responses using brotli.
+	BrotliCompressorType CompressorType = "Brotli"
+	// ZstdCompressorType compresses responses using zstd.
+	ZstdCompressorType CompressorType = "Zstd"
+)
+
+// CompressionPolicyStatus defines the state of CompressionPolicy
+type CompressionPolicyStatus struct {
+	// Conditions describe the current conditions of the CompressionPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// CompressionPolicyList contains a list of CompressionPolicy resources.
+type CompressionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompressionPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CompressionPolicy{}, &CompressionPolicyList{})
+}
@@ -0,0 +1,237 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindEnvoyExtensionPolicy is the name of the EnvoyExtensionPolicy kind.
+	KindEnvoyExtensionPolicy = "EnvoyExtensionPolicy"
+
+	// LuaSourceKey is the key of the ConfigMap holding the source code of a Lua filter.
+	LuaSourceKey = "source.lua"
+	// WasmCodeKey is the key of the ConfigMap or Secret holding the code of a Wasm module.
+	WasmCodeKey = "plugin.wasm"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// EnvoyExtensionPolicy allows the user to run external processors, custom
+// Lua scripts and Wasm modules on the requests handled by Envoy Proxy.
+type EnvoyExtensionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of EnvoyExtensionPolicy.
+	Spec EnvoyExtensionPolicySpec `json:"spec"`
+
+	// Status defines the current status of EnvoyExtensionPolicy.
+	Status EnvoyExtensionPolicyStatus `json:"status,omitempty"`
+}
+
+// EnvoyExtensionPolicySpec defines the desired state of EnvoyExtensionPolicy.
+type EnvoyExtensionPolicySpec struct {
+	// TargetRef is the name of the Gateway, HTTPRoute or GRPCRoute
+	// resource this policy is being attached to.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect and be applied to the resource.
+	// If SectionName is set when targeting a Gateway, the policy only
+	// applies to the Listener with that name, and takes precedence
+	// over a policy that targets the whole Gateway.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+	// ExtProc is the list of external processors the requests are sent
+	// to, in order, before the Lua filters and the Wasm modules.
+	// The external processors of a policy targeting an HTTPRoute or a
+	// GRPCRoute replace the ones of the Gateway for the requests of the
+	// route.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ExtProc []ExtProc `json:"extProc,omitempty"`
+	// Lua is the list of Lua filters run on the requests, in order.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Lua []Lua `json:"lua,omitempty"`
+	// Wasm is the list of Wasm modules run on the requests, in order,
+	// after the Lua filters.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Wasm []Wasm `json:"wasm,omitempty"`
+}
+
+// ExtProc defines an external processor, implementing the Envoy external
+// processing gRPC API, that the requests and responses are sent to.
+type ExtProc struct {
+	// BackendRef references the Service of the external processor.
+	// A reference to a Service in another namespace must be allowed
+	// by a ReferenceGrant.
+	BackendRef gwapiv1b1.BackendObjectReference `json:"backendRef"`
+	// ProcessingMode defines the parts of the requests and responses
+	// sent to the external processor. If unset, the headers of the
+	// requests and of the responses are sent.
+	//
+	// +optional
+	ProcessingMode *ExtProcProcessingMode `json:"processingMode,omitempty"`
+	// MessageTimeout is the time allowed to the external processor to
+	// respond to each message sent by Envoy Proxy. Defaults to 200ms.
+	//
+	// +optional
+	MessageTimeout *metav1.Duration `json:"messageTimeout,omitempty"`
+	// FailOpen allows the requests to proceed when the external processor
+	// fails or times out, instead of returning an error to the client.
+	//
+	// +optional
+	FailOpen bool `json:"failOpen,omitempty"`
+}
+
+// ExtProcProcessingMode defines the parts of the requests and responses
+// sent to an external processor.
+type ExtProcProcessingMode struct {
+	// Request defines the parts of the requests sent to the external
+	// processor. If unset, the requests are not sent.
+	//
+	// +optional
+	Request *ProcessingModeOptions `json:"request,omitempty"`
+	// Response defines the parts of the responses sent to the external
+	// processor. If unset, the responses are not sent.
+	//
+	// +optional
+	Response *ProcessingModeOptions `json:"response,omitempty"`
+}
+
+// ProcessingModeOptions defines the parts of the requests or responses sent
+// to an external processor. The headers are always sent.
+type ProcessingModeOptions struct {
+	// Body defines how the body is sent. If unset, the body is not sent.
+	//
+	// +optional
+	Body *ExtProcBodyProcessingMode `json:"body,omitempty"`
+	// Trailers sends the trailers.
+	//
+	// +optional
+	Trailers bool `json:"trailers,omitempty"`
+}
+
+// ExtProcBodyProcessingMode defines how the body is sent to an external processor.
+// +kubebuilder:validation:Enum=Streamed;Buffered;BufferedPartial
+type ExtProcBodyProcessingMode string
+
+const (
+	// ExtProcBodyStreamed sends the body in chunks, as it is received.
+	ExtProcBodyStreamed ExtProcBodyProcessingMode = "Streamed"
+	// ExtProcBodyBuffered buffers the whole body and sends it in a
+	// single message. The requests or responses with a body larger than
+	// the buffer limit are rejected.
+	ExtProcBodyBuffered ExtProcBodyProcessingMode = "Buffered"
+	// ExtProcBodyBufferedPartial buffers the body up to the buffer limit
+	// and sends it in a single message.
+	ExtProcBodyBufferedPartial ExtProcBodyProcessingMode = "BufferedPartial"
+)
+
+// Lua defines a Lua filter. Exactly one of Inline or ValueRef must be set.
+type Lua struct {
+	// Inline is the source code of the Lua filter.
+	//
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+	// ValueRef is a reference to a ConfigMap holding the source code
+	// of the Lua filter under the "source.lua" key.
+	//
+	// +optional
+	ValueRef *gwapiv1b1.LocalObjectReference `json:"valueRef,omitempty"`
+}
+
+// Wasm defines a Wasm module run using the Wasm filter.
+type Wasm struct {
+	// Name is the name of the Wasm module, unique within the policy.
+	// It is used in the logs and the stats of the module.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	Name string `json:"name"`
+	// RootID is the root ID of the module, used to select one of the
+	// root contexts of the module when it contains several of them.
+	//
+	// +optional
+	RootID *string `json:"rootID,omitempty"`
+	// Code is the source of the code of the Wasm module.
+	Code WasmCodeSource `json:"code"`
+	// Config is the configuration passed to the Wasm module, serialized
+	// as a JSON string.
+	//
+	// +optional
+	Config *apiextensionsv1.JSON `json:"config,omitempty"`
+	// FailOpen allows the requests to proceed when the Wasm module
+	// fails, instead of returning an error to the client.
+	//
+	// +optional
+	FailOpen bool `json:"failOpen,omitempty"`
+}
+
+// WasmCodeSource defines the source of the code of a Wasm module.
+// Exactly one of ValueRef, HTTP or Image must be set.
+type WasmCodeSource struct {
+	// ValueRef is a reference to a ConfigMap or a Secret holding the
+	// code of the Wasm module under the "plugin.wasm" key.
+	//
+	// +optional
+	ValueRef *gwapiv1b1.LocalObjectReference `json:"valueRef,omitempty"`
+	// HTTP is the HTTP or HTTPS URL the code of the Wasm module is
+	// downloaded from by Envoy Proxy.
+	//
+	// +optional
+	HTTP *string `json:"http,omitempty"`
+	// Image is the OCI image holding the Wasm module, in the
+	// "oci://<registry>/<repository>" format, without a tag or a digest.
+	// Envoy Proxy downloads the layer of the image matching SHA256 from
+	// the blob API of the registry, so the registry must allow anonymous
+	// downloads and the image must contain the module as a single layer.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^oci://[^/]+/[^:@]+$`
+	Image *string `json:"image,omitempty"`
+	// SHA256 is the hex-encoded SHA-256 checksum of the code of the
+	// Wasm module. It is required for the HTTP and Image sources and
+	// checked when set for the ValueRef source.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-f0-9]{64}$`
+	SHA256 *string `json:"sha256,omitempty"`
+}
+
+// EnvoyExtensionPolicyStatus defines the state of EnvoyExtensionPolicy
+type EnvoyExtensionPolicyStatus struct {
+	// Conditions describe the current conditions of the EnvoyExtensionPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EnvoyExtensionPolicyList contains a list of EnvoyExtensionPolicy resources.
+type EnvoyExtensionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvoyExtensionPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&EnvoyExtensionPolicy{}, &EnvoyExtensionPolicyList{})
+}
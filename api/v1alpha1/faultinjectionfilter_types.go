@@ -0,0 +1,113 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KindFaultInjectionFilter is the name of the FaultInjectionFilter kind.
+	KindFaultInjectionFilter = "FaultInjectionFilter"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// FaultInjectionFilter allows the user to inject delays and aborts into the
+// requests matching a route, to test the resiliency of the clients and of
+// the backends.
+type FaultInjectionFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of FaultInjectionFilter.
+	Spec FaultInjectionFilterSpec `json:"spec"`
+}
+
+// FaultInjectionFilterSpec defines the desired state of FaultInjectionFilter.
+// At least one of Delay and Abort must be set.
+type FaultInjectionFilterSpec struct {
+	// Delay defines the delay added to the requests before they are
+	// forwarded to the backend.
+	//
+	// +optional
+	Delay *FaultInjectionDelay `json:"delay,omitempty"`
+	// Abort defines the error returned to the requests instead of
+	// forwarding them to the backend.
+	//
+	// +optional
+	Abort *FaultInjectionAbort `json:"abort,omitempty"`
+	// MaxActiveFaults is the maximum number of requests that may be
+	// delayed or aborted at the same time. Unlimited by default.
+	//
+	// +optional
+	MaxActiveFaults *uint32 `json:"maxActiveFaults,omitempty"`
+}
+
+// FaultInjectionDelay defines the delay added to the requests.
+// Exactly one of FixedDelay and HeaderControlled must be set.
+type FaultInjectionDelay struct {
+	// FixedDelay is the delay added to the requests.
+	//
+	// +optional
+	FixedDelay *metav1.Duration `json:"fixedDelay,omitempty"`
+	// HeaderControlled determines that the delay, in milliseconds, is read
+	// from the "x-envoy-fault-delay-request" header of the request.
+	//
+	// +optional
+	HeaderControlled bool `json:"headerControlled,omitempty"`
+	// Percentage is the percentage of the requests that are delayed.
+	// Defaults to 100.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *uint32 `json:"percentage,omitempty"`
+}
+
+// FaultInjectionAbort defines the error returned to the requests.
+// Exactly one of HTTPStatus, GRPCStatus and HeaderControlled must be set.
+type FaultInjectionAbort struct {
+	// HTTPStatus is the HTTP status code returned to the requests.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	HTTPStatus *int32 `json:"httpStatus,omitempty"`
+	// GRPCStatus is the gRPC status code returned to the requests.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	GRPCStatus *int32 `json:"grpcStatus,omitempty"`
+	// HeaderControlled determines that the HTTP or gRPC status code is read
+	// from the "x-envoy-fault-abort-request" or "x-envoy-fault-abort-grpc-request"
+	// header of the request.
+	//
+	// +optional
+	HeaderControlled bool `json:"headerControlled,omitempty"`
+	// Percentage is the percentage of the requests that are aborted.
+	// Defaults to 100.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *uint32 `json:"percentage,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FaultInjectionFilterList contains a list of FaultInjectionFilter resources.
+type FaultInjectionFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FaultInjectionFilter `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FaultInjectionFilter{}, &FaultInjectionFilterList{})
+}
@@ -0,0 +1,104 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindGRPCTranscodingFilter is the name of the GRPCTranscodingFilter kind.
+	KindGRPCTranscodingFilter = "GRPCTranscodingFilter"
+
+	// GRPCDescriptorSetKey is the key of the ConfigMap holding the
+	// serialized proto descriptor set of a gRPC-JSON transcoder.
+	GRPCDescriptorSetKey = "descriptor.pb"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GRPCTranscodingFilter allows the REST clients that cannot use native gRPC
+// to call the backends of a GRPCRoute rule. It can only be referenced by the
+// filters of a GRPCRoute rule.
+type GRPCTranscodingFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of GRPCTranscodingFilter.
+	Spec GRPCTranscodingFilterSpec `json:"spec"`
+}
+
+// GRPCTranscodingFilterSpec defines the desired state of GRPCTranscodingFilter.
+// The gRPC-Web requests of browser clients are accepted by all the GRPCRoutes,
+// without any filter.
+type GRPCTranscodingFilterSpec struct {
+	// JSON enables the transcoding of RESTful JSON requests to gRPC
+	// requests, following the HTTP mappings of the proto descriptor set.
+	JSON *GRPCJSONTranscoding `json:"json"`
+}
+
+// GRPCJSONTranscoding defines the transcoding of RESTful JSON requests to
+// gRPC requests.
+type GRPCJSONTranscoding struct {
+	// DescriptorSetRef references the ConfigMap holding the serialized
+	// FileDescriptorSet of the gRPC services, including their HTTP
+	// annotations, under the "descriptor.pb" key of its binaryData or data.
+	DescriptorSetRef gwapiv1b1.LocalObjectReference `json:"descriptorSetRef"`
+	// Services are the fully qualified names of the gRPC services exposed
+	// through the transcoder, such as "package.Service". When unset, the
+	// services are the ones of the exact method matches of the GRPCRoute rule.
+	//
+	// +optional
+	Services []string `json:"services,omitempty"`
+	// PrintOptions defines how the gRPC responses are printed as JSON.
+	//
+	// +optional
+	PrintOptions *GRPCJSONPrintOptions `json:"printOptions,omitempty"`
+	// IgnoreUnknownQueryParameters determines that the query parameters of
+	// the requests that cannot be mapped to fields of the gRPC requests are
+	// ignored, instead of rejecting the requests.
+	//
+	// +optional
+	IgnoreUnknownQueryParameters bool `json:"ignoreUnknownQueryParameters,omitempty"`
+}
+
+// GRPCJSONPrintOptions defines how the gRPC responses are printed as JSON.
+type GRPCJSONPrintOptions struct {
+	// AddWhitespace determines that the JSON responses are indented.
+	//
+	// +optional
+	AddWhitespace bool `json:"addWhitespace,omitempty"`
+	// AlwaysPrintPrimitiveFields determines that the primitive fields of
+	// the responses are printed even when they hold their default value.
+	//
+	// +optional
+	AlwaysPrintPrimitiveFields bool `json:"alwaysPrintPrimitiveFields,omitempty"`
+	// AlwaysPrintEnumsAsInts determines that the enums are printed as
+	// integers instead of their names.
+	//
+	// +optional
+	AlwaysPrintEnumsAsInts bool `json:"alwaysPrintEnumsAsInts,omitempty"`
+	// PreserveProtoFieldNames determines that the field names of the proto
+	// definitions are used instead of their lowerCamelCase JSON names.
+	//
+	// +optional
+	PreserveProtoFieldNames bool `json:"preserveProtoFieldNames,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// GRPCTranscodingFilterList contains a list of GRPCTranscodingFilter resources.
+type GRPCTranscodingFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCTranscodingFilter `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GRPCTranscodingFilter{}, &GRPCTranscodingFilterList{})
+}
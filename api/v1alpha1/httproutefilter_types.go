@@ -0,0 +1,204 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// KindHTTPRouteFilter is the name of the HTTPRouteFilter kind.
+	KindHTTPRouteFilter = "HTTPRouteFilter"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HTTPRouteFilter extends the URL rewrites, the header modifiers and the
+// request mirrors of the Gateway API with the capabilities of Envoy Proxy,
+// and defines the virtual clusters collecting the statistics of the requests.
+// It can be referenced by the filters of an HTTPRoute rule.
+type HTTPRouteFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of HTTPRouteFilter.
+	Spec HTTPRouteFilterSpec `json:"spec"`
+}
+
+// HTTPRouteFilterSpec defines the desired state of HTTPRouteFilter.
+// At least one of URLRewrite, RequestHeaderModifier, ResponseHeaderModifier,
+// RequestMirror and VirtualClusters must be set.
+type HTTPRouteFilterSpec struct {
+	// URLRewrite rewrites the path and the host of the requests. It cannot
+	// be combined with the URLRewrite filter of the Gateway API in a rule.
+	//
+	// +optional
+	URLRewrite *HTTPURLRewriteFilter `json:"urlRewrite,omitempty"`
+	// RequestHeaderModifier adds or sets the headers of the requests, using
+	// values that may be templated from Envoy variables.
+	//
+	// +optional
+	RequestHeaderModifier *HTTPHeaderTemplateFilter `json:"requestHeaderModifier,omitempty"`
+	// ResponseHeaderModifier adds or sets the headers of the responses, using
+	// values that may be templated from Envoy variables.
+	//
+	// +optional
+	ResponseHeaderModifier *HTTPHeaderTemplateFilter `json:"responseHeaderModifier,omitempty"`
+	// RequestMirror mirrors a sample of the requests to a backend. It can be
+	// combined with other request mirrors in a rule, each mirroring the
+	// requests to its own backend.
+	//
+	// +optional
+	RequestMirror *HTTPRequestMirrorFilter `json:"requestMirror,omitempty"`
+	// VirtualClusters collect the statistics of the requests matching them,
+	// such as the requests to an endpoint of an API, under their own names.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	VirtualClusters []VirtualCluster `json:"virtualClusters,omitempty"`
+}
+
+// HTTPURLRewriteFilter defines how the path and the host of the requests are
+// rewritten. At least one of Path and Hostname must be set.
+type HTTPURLRewriteFilter struct {
+	// Path rewrites the path of the requests.
+	//
+	// +optional
+	Path *HTTPPathRewrite `json:"path,omitempty"`
+	// Hostname rewrites the host of the requests.
+	//
+	// +optional
+	Hostname *HTTPHostnameRewrite `json:"hostname,omitempty"`
+}
+
+// HTTPPathRewrite defines how the path of the requests is rewritten.
+type HTTPPathRewrite struct {
+	// ReplaceRegexMatch replaces the parts of the path matched by a regular
+	// expression.
+	ReplaceRegexMatch ReplaceRegexMatch `json:"replaceRegexMatch"`
+}
+
+// ReplaceRegexMatch defines a regular expression substitution.
+type ReplaceRegexMatch struct {
+	// Pattern is the RE2 regular expression matching the parts of the path
+	// to replace. All the non-overlapping matches are replaced.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Pattern string `json:"pattern"`
+	// Substitution replaces the matched parts of the path. It may reference
+	// the capture groups of the pattern, such as "\1".
+	// An empty substitution removes the matched parts of the path.
+	Substitution string `json:"substitution"`
+}
+
+// HTTPHostnameRewriteType defines the source of the rewritten host.
+// +kubebuilder:validation:Enum=Header;Backend
+type HTTPHostnameRewriteType string
+
+const (
+	// HTTPHostnameRewriteTypeHeader rewrites the host with the value of a
+	// request header.
+	HTTPHostnameRewriteTypeHeader HTTPHostnameRewriteType = "Header"
+	// HTTPHostnameRewriteTypeBackend rewrites the host with the hostname of
+	// the backend endpoint selected for the request. It only applies to the
+	// backends whose endpoints are resolved using DNS.
+	HTTPHostnameRewriteTypeBackend HTTPHostnameRewriteType = "Backend"
+)
+
+// HTTPHostnameRewrite defines how the host of the requests is rewritten.
+type HTTPHostnameRewrite struct {
+	// Type of the hostname rewrite.
+	Type HTTPHostnameRewriteType `json:"type"`
+	// Header is the name of the request header holding the rewritten host.
+	// It must be set when the type is Header. The host is unchanged when
+	// the header is missing from the request.
+	//
+	// +optional
+	Header *gwapiv1b1.HTTPHeaderName `json:"header,omitempty"`
+}
+
+// HTTPHeaderTemplateFilter defines the headers added or set.
+type HTTPHeaderTemplateFilter struct {
+	// Set overwrites the headers with the provided values.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Set []HTTPHeaderTemplate `json:"set,omitempty"`
+	// Add appends the provided values to the existing values of the headers.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Add []HTTPHeaderTemplate `json:"add,omitempty"`
+}
+
+// HTTPHeaderTemplate defines a header whose value is templated from Envoy
+// variables.
+type HTTPHeaderTemplate struct {
+	// Name of the header.
+	Name gwapiv1b1.HTTPHeaderName `json:"name"`
+	// Value of the header. It may contain Envoy variables enclosed in "%",
+	// such as "%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%" for the client IP
+	// or "%REQ(x-request-id)%" for the request ID. A literal "%" is written
+	// "%%".
+	// Reference: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/headers#custom-request-response-headers
+	//
+	// +kubebuilder:validation:MaxLength=4096
+	Value string `json:"value"`
+}
+
+// HTTPRequestMirrorFilter defines the backend the requests are mirrored to
+// and the percentage of the requests mirrored. The responses of the backend
+// are ignored.
+type HTTPRequestMirrorFilter struct {
+	// BackendRef references the resource the requests are mirrored to.
+	// Only Service kinds are supported.
+	BackendRef gwapiv1b1.BackendObjectReference `json:"backendRef"`
+	// Percentage is the percentage of the requests that are mirrored.
+	// Defaults to 100.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *uint32 `json:"percentage,omitempty"`
+}
+
+// VirtualCluster defines the requests whose statistics are collected under
+// the name of the virtual cluster. The virtual clusters match the requests
+// to the hostnames of the route, including the requests of the other routes
+// of these hostnames. At least one of Method and Path must be set.
+type VirtualCluster struct {
+	// Name of the virtual cluster. It must be unique in the filter.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	Name string `json:"name"`
+	// Method matches the HTTP method of the requests.
+	//
+	// +optional
+	Method *gwapiv1b1.HTTPMethod `json:"method,omitempty"`
+	// Path matches the path of the requests. The query string is ignored by
+	// the Exact and PathPrefix matches, and must be matched by the regular
+	// expression of a RegularExpression match.
+	//
+	// +optional
+	Path *gwapiv1b1.HTTPPathMatch `json:"path,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// HTTPRouteFilterList contains a list of HTTPRouteFilter resources.
+type HTTPRouteFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPRouteFilter `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HTTPRouteFilter{}, &HTTPRouteFilterList{})
+}
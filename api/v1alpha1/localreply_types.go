@@ -0,0 +1,94 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// LocalReplyBodyKey is the key of the ConfigMap data holding
+	// the body of a custom local reply.
+	LocalReplyBodyKey = "response.body"
+)
+
+// LocalReplyConfig defines the custom responses returned in place of the
+// responses generated by Envoy Proxy itself, such as when no route matches
+// the request, when no healthy backend is available, or when the route has
+// no valid backend.
+type LocalReplyConfig struct {
+	// Mappings is the list of custom responses. The first mapping
+	// matching a response generated by Envoy Proxy is applied to it.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Mappings []LocalReplyMapping `json:"mappings"`
+}
+
+// LocalReplyMapping defines a custom response, and the responses
+// generated by Envoy Proxy it is returned in place of.
+type LocalReplyMapping struct {
+	// Match defines the responses the mapping applies to.
+	Match LocalReplyMatch `json:"match"`
+	// StatusCode overrides the status code of the response.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+	// ContentType is the content type of the custom body.
+	// Defaults to "text/plain".
+	//
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+	// Body overrides the body of the response.
+	//
+	// +optional
+	Body *CustomResponseBody `json:"body,omitempty"`
+}
+
+// LocalReplyMatch defines the responses a LocalReplyMapping applies to.
+// At least one of StatusCodes and ResponseFlags must be set. When both
+// are set, a response must match both of them.
+type LocalReplyMatch struct {
+	// StatusCodes matches the responses with any of the status codes.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	StatusCodes []HTTPStatus `json:"statusCodes,omitempty"`
+	// ResponseFlags matches the responses with any of the Envoy response
+	// flags, such as "NR" (no route) or "UH" (no healthy upstream).
+	// See https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#config-access-log-format-response-flags
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ResponseFlags []ResponseFlag `json:"responseFlags,omitempty"`
+}
+
+// HTTPStatus is an HTTP status code.
+//
+// +kubebuilder:validation:Minimum=100
+// +kubebuilder:validation:Maximum=599
+type HTTPStatus int32
+
+// ResponseFlag is the short name of an Envoy response flag.
+//
+// +kubebuilder:validation:Enum=LH;UH;UT;LR;UR;UF;UC;UO;NR;DI;FI;RL;UAEX;RLSE;DC;URX;SI;IH;DPE;UMSDR;RFCF;NFCF;DT;UPE;NC;OM;DF;DO
+type ResponseFlag string
+
+// CustomResponseBody defines the body of a custom response.
+// Exactly one of Inline and ValueRef must be set.
+type CustomResponseBody struct {
+	// Inline contains the body.
+	//
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+	// ValueRef is a reference to a ConfigMap, in the same namespace
+	// as the policy, holding the body under the "response.body" key.
+	//
+	// +optional
+	ValueRef *gwapiv1b1.LocalObjectReference `json:"valueRef,omitempty"`
+}
@@ -0,0 +1,24 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// PolicyTargetReferenceWithSectionName identifies an API object to apply a policy to,
+// and optionally a section of that object, e.g. a Listener of a Gateway.
+type PolicyTargetReferenceWithSectionName struct {
+	gwapiv1a2.PolicyTargetReference `json:",inline"`
+
+	// SectionName is the name of a section within the target resource. When
+	// unspecified, this targetRef targets the entire resource. For a Gateway,
+	// it is the name of a Listener.
+	//
+	// +optional
+	SectionName *gwapiv1b1.SectionName `json:"sectionName,omitempty"`
+}
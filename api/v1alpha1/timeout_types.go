@@ -0,0 +1,36 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClientTimeouts defines the timeouts of the connections and requests
+// received from the downstream client.
+type ClientTimeouts struct {
+	// Request is the time allowed to receive the entire request from
+	// the client. Disabled by default.
+	//
+	// +optional
+	Request *metav1.Duration `json:"request,omitempty"`
+	// RequestHeaders is the time allowed to receive the headers of a request
+	// from the client, starting when the first byte is received.
+	// Disabled by default.
+	//
+	// +optional
+	RequestHeaders *metav1.Duration `json:"requestHeaders,omitempty"`
+	// Idle is the time after which a connection without any active
+	// request is closed. Defaults to 1 hour.
+	//
+	// +optional
+	Idle *metav1.Duration `json:"idle,omitempty"`
+	// StreamIdle is the time after which a request that has neither
+	// received nor sent any data is reset. Defaults to 5 minutes.
+	//
+	// +optional
+	StreamIdle *metav1.Duration `json:"streamIdle,omitempty"`
+}
@@ -0,0 +1,136 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// TLSSettings defines the TLS parameters used when terminating
+// TLS connections from the downstream client.
+type TLSSettings struct {
+	// MinVersion is the minimum TLS version supported.
+	// If unspecified, Envoy's default (TLS 1.2) is used.
+	//
+	// +optional
+	MinVersion *TLSVersion `json:"minVersion,omitempty"`
+	// MaxVersion is the maximum TLS version supported.
+	// If unspecified, Envoy's default (TLS 1.3) is used.
+	//
+	// +optional
+	MaxVersion *TLSVersion `json:"maxVersion,omitempty"`
+	// Ciphers specifies the set of cipher suites supported when
+	// negotiating TLS 1.0 - 1.2. This setting has no effect for TLS 1.3.
+	// If unspecified, Envoy's default cipher list is used.
+	// Refer to https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/tls/v3/common.proto#extensions-transport-sockets-tls-v3-tlsparameters
+	// for the supported values.
+	//
+	// +optional
+	Ciphers []string `json:"ciphers,omitempty"`
+	// ECDHCurves specifies the set of supported ECDH curves.
+	// If unspecified, Envoy's default curves are used.
+	//
+	// +optional
+	ECDHCurves []string `json:"ecdhCurves,omitempty"`
+	// ALPNProtocols supplies the list of ALPN protocols that should be
+	// exposed by the listener, in order of preference.
+	// Defaults to ["h2", "http/1.1"].
+	//
+	// +optional
+	ALPNProtocols []ALPNProtocol `json:"alpnProtocols,omitempty"`
+	// ClientValidation specifies the configuration to validate the client
+	// initiating the TLS connection to the Gateway listener, i.e. mutual TLS.
+	//
+	// +optional
+	ClientValidation *ClientValidationContext `json:"clientValidation,omitempty"`
+}
+
+// TLSVersion specifies the TLS version
+// +kubebuilder:validation:Enum=Auto;"1.0";"1.1";"1.2";"1.3"
+type TLSVersion string
+
+const (
+	// TLSAuto allows Envoy to choose the optimal TLS Version
+	TLSAuto TLSVersion = "Auto"
+	// TLSv10 specifies TLS version 1.0
+	TLSv10 TLSVersion = "1.0"
+	// TLSv11 specifies TLS version 1.1
+	TLSv11 TLSVersion = "1.1"
+	// TLSv12 specifies TLS version 1.2
+	TLSv12 TLSVersion = "1.2"
+	// TLSv13 specifies TLS version 1.3
+	TLSv13 TLSVersion = "1.3"
+)
+
+// ALPNProtocol specifies the protocol to be negotiated using ALPN
+// +kubebuilder:validation:Enum=http/1.0;http/1.1;h2
+type ALPNProtocol string
+
+const (
+	// HTTPProtocolVersion1_0 specifies that HTTP/1.0 should be negotiable with ALPN
+	HTTPProtocolVersion1_0 ALPNProtocol = "http/1.0"
+	// HTTPProtocolVersion1_1 specifies that HTTP/1.1 should be negotiable with ALPN
+	HTTPProtocolVersion1_1 ALPNProtocol = "http/1.1"
+	// HTTPProtocolVersion2 specifies that HTTP/2 should be negotiable with ALPN
+	HTTPProtocolVersion2 ALPNProtocol = "h2"
+)
+
+// ClientValidationContext holds configuration that can be used to validate the
+// client initiating the TLS connection to the Gateway.
+type ClientValidationContext struct {
+	// CACertificateRefs contains one or more references to Kubernetes objects that
+	// contain TLS certificates of the Certificate Authorities that can be used
+	// as a trust anchor to validate the certificates presented by the client.
+	// Secrets and ConfigMaps are supported, and the CA bundle must be stored
+	// under the "ca.crt" key.
+	//
+	// References to a resource in a different namespace are invalid UNLESS there
+	// is a ReferenceGrant in the target namespace that allows the certificate
+	// to be attached.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	CACertificateRefs []gwapiv1b1.SecretObjectReference `json:"caCertificateRefs"`
+	// SubjectAltNames is an optional allow-list of Subject Alternative Names.
+	// If specified, the client certificate must contain at least one SAN
+	// matching one of the entries.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	SubjectAltNames []SubjectAltName `json:"subjectAltNames,omitempty"`
+	// Optional set to true accepts connections even when a client
+	// certificate is not presented. A certificate that is presented
+	// is still validated.
+	// Defaults to false, i.e. a client certificate is required.
+	//
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// SubjectAltName defines a Subject Alternative Name to match.
+type SubjectAltName struct {
+	// Type is the type of the Subject Alternative Name.
+	Type SubjectAltNameType `json:"type"`
+	// Value is the exact value of the Subject Alternative Name.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// SubjectAltNameType specifies the type of a Subject Alternative Name.
+// +kubebuilder:validation:Enum=DNS;URI;Email;IPAddress
+type SubjectAltNameType string
+
+const (
+	// SubjectAltNameTypeDNS matches a DNS name SAN.
+	SubjectAltNameTypeDNS SubjectAltNameType = "DNS"
+	// SubjectAltNameTypeURI matches a URI SAN.
+	SubjectAltNameTypeURI SubjectAltNameType = "URI"
+	// SubjectAltNameTypeEmail matches an email address SAN.
+	SubjectAltNameTypeEmail SubjectAltNameType = "Email"
+	// SubjectAltNameTypeIPAddress matches an IP address SAN.
+	SubjectAltNameTypeIPAddress SubjectAltNameType = "IPAddress"
+)
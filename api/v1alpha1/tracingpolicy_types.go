@@ -0,0 +1,111 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+const (
+	// KindTracingPolicy is the name of the TracingPolicy kind.
+	KindTracingPolicy = "TracingPolicy"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Accepted")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TracingPolicy allows the user to override, for a Gateway or a route,
+// the tracing configured in the EnvoyProxy.
+type TracingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of TracingPolicy.
+	Spec TracingPolicySpec `json:"spec"`
+
+	// Status defines the current status of TracingPolicy.
+	Status TracingPolicyStatus `json:"status,omitempty"`
+}
+
+// TracingPolicySpec defines the desired state of TracingPolicy.
+// The policy is not accepted when no tracing provider is configured
+// in the EnvoyProxy of the targeted Gateways.
+type TracingPolicySpec struct {
+	// TargetRef is the name of the Gateway, HTTPRoute or GRPCRoute
+	// resource this policy is being attached to.
+	// This Policy and the TargetRef MUST be in the same namespace
+	// for this Policy to have effect and be applied to the resource.
+	// If SectionName is set when targeting a Gateway, the policy only
+	// applies to the Listener with that name, and takes precedence
+	// over a policy that targets the whole Gateway.
+	// A policy targeting a route replaces the policy of its Gateway
+	// for the requests of the route.
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
+	// SamplingRate is the percentage of the requests that are randomly
+	// selected for tracing, when no prior sampling decision has been made.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SamplingRate *uint32 `json:"samplingRate,omitempty"`
+	// ClientSamplingRate is the percentage of the requests that are traced
+	// when the client sets the "x-client-trace-id" request header.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ClientSamplingRate *uint32 `json:"clientSamplingRate,omitempty"`
+	// OverallSamplingRate is the maximum percentage of the requests that
+	// are traced, applied after all the other sampling checks.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	OverallSamplingRate *uint32 `json:"overallSamplingRate,omitempty"`
+	// CustomTags defines the custom tags to add to each span, in addition
+	// to the ones configured in the EnvoyProxy.
+	//
+	// +optional
+	CustomTags map[string]egcfgv1a1.CustomTag `json:"customTags,omitempty"`
+	// OperationName is the name of the spans created for the requests.
+	// Defaults to the name of the route.
+	//
+	// +optional
+	OperationName *string `json:"operationName,omitempty"`
+	// Disabled disables the tracing of the requests. It cannot be set
+	// with any other setting.
+	//
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// TracingPolicyStatus defines the state of TracingPolicy
+type TracingPolicyStatus struct {
+	// Conditions describe the current conditions of the TracingPolicy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TracingPolicyList contains a list of TracingPolicy resources.
+type TracingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TracingPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TracingPolicy{}, &TracingPolicyList{})
+}
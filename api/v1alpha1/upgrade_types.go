@@ -0,0 +1,61 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpgradeType is the type of an HTTP protocol upgrade, which is the value of
+// the Upgrade header of the requests, such as "websocket" or "spdy/3.1", or
+// one of "CONNECT" and "CONNECT-UDP" for the CONNECT requests.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=64
+type UpgradeType string
+
+const (
+	// UpgradeTypeWebSocket is the type of the WebSocket upgrades.
+	UpgradeTypeWebSocket UpgradeType = "websocket"
+	// UpgradeTypeConnect is the type of the CONNECT requests, tunneling
+	// TCP connections.
+	UpgradeTypeConnect UpgradeType = "CONNECT"
+	// UpgradeTypeConnectUDP is the type of the CONNECT-UDP requests,
+	// tunneling UDP datagrams as defined by RFC 9298.
+	UpgradeTypeConnectUDP UpgradeType = "CONNECT-UDP"
+)
+
+// UpgradeSettings defines the HTTP protocol upgrades and the CONNECT
+// requests accepted from the downstream client.
+type UpgradeSettings struct {
+	// Upgrades enable or disable the upgrade types. The WebSocket upgrades
+	// are enabled by default on the Listeners that do not serve GRPCRoutes,
+	// and the other upgrade types are disabled by default.
+	//
+	// The CONNECT and CONNECT-UDP requests are terminated by Envoy Proxy,
+	// which forwards their payload to the backend of the route.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Upgrades []Upgrade `json:"upgrades,omitempty"`
+	// IdleTimeout is the time after which an upgraded stream or a CONNECT
+	// tunnel that has neither received nor sent any data is closed.
+	// Defaults to the stream idle timeout of the Listener.
+	//
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+}
+
+// Upgrade enables or disables an upgrade type.
+type Upgrade struct {
+	// Type is the upgrade type.
+	Type UpgradeType `json:"type"`
+	// Enabled determines if the upgrade type is accepted.
+	// Defaults to true.
+	//
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
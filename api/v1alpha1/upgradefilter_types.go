@@ -0,0 +1,42 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KindUpgradeFilter is the name of the UpgradeFilter kind.
+	KindUpgradeFilter = "UpgradeFilter"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// UpgradeFilter allows the user to enable or disable the HTTP protocol
+// upgrades, such as WebSocket, and the CONNECT requests on the requests
+// matching a route, overriding the settings of the Listener.
+type UpgradeFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of UpgradeFilter.
+	Spec UpgradeSettings `json:"spec"`
+}
+
+//+kubebuilder:object:root=true
+
+// UpgradeFilterList contains a list of UpgradeFilter resources.
+type UpgradeFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpgradeFilter `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&UpgradeFilter{}, &UpgradeFilterList{})
+}
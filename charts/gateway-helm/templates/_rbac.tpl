@@ -30,6 +30,7 @@ Namespaced
 apiGroups:
 - ""
 resources:
+- configmaps
 - secrets
 - services
 verbs:
@@ -43,6 +44,7 @@ apiGroups:
 - apps
 resources:
 - deployments
+- daemonsets
 verbs:
 - get
 - list
@@ -76,9 +78,20 @@ verbs:
 apiGroups:
 - gateway.envoyproxy.io
 resources:
+- accessloggingpolicies
 - authenticationfilters
+- backends
+- backendtlspolicies
+- clienttrafficpolicies
+- compressionpolicies
+- envoyextensionpolicies
 - envoypatchpolicies
+- faultinjectionfilters
+- grpctranscodingfilters
+- httproutefilters
 - ratelimitfilters
+- tracingpolicies
+- upgradefilters
 verbs:
 - get
 - list
@@ -91,7 +104,13 @@ verbs:
 apiGroups:
 - gateway.envoyproxy.io
 resources:
+- accessloggingpolicies/status
+- backendtlspolicies/status
+- clienttrafficpolicies/status
+- compressionpolicies/status
+- envoyextensionpolicies/status
 - envoypatchpolicies/status
+- tracingpolicies/status
 verbs:
 - update
 {{- end }}
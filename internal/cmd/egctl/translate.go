@@ -387,7 +387,21 @@ func constructConfigDump(resources *gatewayapi.Resources, tCtx *xds_types.Resour
 	// construct bootstrap config
 	var bootstrapConfigurations string
 	var err error
-	if bootstrapConfigurations, err = bootstrap.GetRenderedBootstrapConfig(nil); err != nil {
+	var (
+		ipFamily        *egv1alpha1.IPFamily
+		overloadManager *egv1alpha1.ProxyOverloadManager
+	)
+	if resources.EnvoyProxy != nil {
+		ipFamily = resources.EnvoyProxy.Spec.IPFamily
+		// the defaults of the provider are not applied: the default container
+		// has no memory limit to derive the maximum heap size from.
+		var container *egv1alpha1.KubernetesContainerSpec
+		if provider := resources.EnvoyProxy.Spec.Provider; provider != nil {
+			container = provider.Kubernetes.GetEnvoyContainer()
+		}
+		overloadManager = resources.EnvoyProxy.Spec.OverloadManager.ForContainer(container)
+	}
+	if bootstrapConfigurations, err = bootstrap.GetRenderedBootstrapConfig(nil, ipFamily, overloadManager); err != nil {
 		return nil, err
 	}
 
@@ -751,6 +765,104 @@ func kubernetesYAMLToResources(str string, addMissingResources bool) (*gatewayap
 				Spec: typedSpec.(egv1a1.AuthenticationFilterSpec),
 			}
 			resources.AuthenticationFilters = append(resources.AuthenticationFilters, authenticationFilter)
+		case egv1a1.KindClientTrafficPolicy:
+			typedSpec := spec.Interface()
+			clientTrafficPolicy := &egv1a1.ClientTrafficPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindClientTrafficPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.ClientTrafficPolicySpec),
+			}
+			resources.ClientTrafficPolicies = append(resources.ClientTrafficPolicies, clientTrafficPolicy)
+		case egv1a1.KindBackend:
+			typedSpec := spec.Interface()
+			backend := &egv1a1.Backend{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindBackend,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.BackendSpec),
+			}
+			resources.Backends = append(resources.Backends, backend)
+		case egv1a1.KindBackendTLSPolicy:
+			typedSpec := spec.Interface()
+			backendTLSPolicy := &egv1a1.BackendTLSPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindBackendTLSPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.BackendTLSPolicySpec),
+			}
+			resources.BackendTLSPolicies = append(resources.BackendTLSPolicies, backendTLSPolicy)
+		case egv1a1.KindCompressionPolicy:
+			typedSpec := spec.Interface()
+			compressionPolicy := &egv1a1.CompressionPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindCompressionPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.CompressionPolicySpec),
+			}
+			resources.CompressionPolicies = append(resources.CompressionPolicies, compressionPolicy)
+		case egv1a1.KindTracingPolicy:
+			typedSpec := spec.Interface()
+			tracingPolicy := &egv1a1.TracingPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindTracingPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.TracingPolicySpec),
+			}
+			resources.TracingPolicies = append(resources.TracingPolicies, tracingPolicy)
+		case egv1a1.KindAccessLoggingPolicy:
+			typedSpec := spec.Interface()
+			accessLoggingPolicy := &egv1a1.AccessLoggingPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindAccessLoggingPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.AccessLoggingPolicySpec),
+			}
+			resources.AccessLoggingPolicies = append(resources.AccessLoggingPolicies, accessLoggingPolicy)
+		case egv1a1.KindEnvoyExtensionPolicy:
+			typedSpec := spec.Interface()
+			envoyExtensionPolicy := &egv1a1.EnvoyExtensionPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindEnvoyExtensionPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.EnvoyExtensionPolicySpec),
+			}
+			resources.EnvoyExtensionPolicies = append(resources.EnvoyExtensionPolicies, envoyExtensionPolicy)
 		case egv1a1.KindEnvoyPatchPolicy:
 			typedSpec := spec.Interface()
 			envoyPatchPolicy := &egv1a1.EnvoyPatchPolicy{
@@ -779,6 +891,62 @@ func kubernetesYAMLToResources(str string, addMissingResources bool) (*gatewayap
 				Spec: typedSpec.(egv1a1.RateLimitFilterSpec),
 			}
 			resources.RateLimitFilters = append(resources.RateLimitFilters, rateLimitFilter)
+		case egv1a1.KindFaultInjectionFilter:
+			typedSpec := spec.Interface()
+			faultInjectionFilter := &egv1a1.FaultInjectionFilter{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindFaultInjectionFilter,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.FaultInjectionFilterSpec),
+			}
+			resources.FaultInjectionFilters = append(resources.FaultInjectionFilters, faultInjectionFilter)
+		case egv1a1.KindGRPCTranscodingFilter:
+			typedSpec := spec.Interface()
+			grpcTranscodingFilter := &egv1a1.GRPCTranscodingFilter{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindGRPCTranscodingFilter,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.GRPCTranscodingFilterSpec),
+			}
+			resources.GRPCTranscodingFilters = append(resources.GRPCTranscodingFilters, grpcTranscodingFilter)
+		case egv1a1.KindUpgradeFilter:
+			typedSpec := spec.Interface()
+			upgradeFilter := &egv1a1.UpgradeFilter{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindUpgradeFilter,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.UpgradeSettings),
+			}
+			resources.UpgradeFilters = append(resources.UpgradeFilters, upgradeFilter)
+		case egv1a1.KindHTTPRouteFilter:
+			typedSpec := spec.Interface()
+			httpRouteFilter := &egv1a1.HTTPRouteFilter{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindHTTPRouteFilter,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Spec: typedSpec.(egv1a1.HTTPRouteFilterSpec),
+			}
+			resources.HTTPRouteFilters = append(resources.HTTPRouteFilters, httpRouteFilter)
 		}
 	}
 
@@ -872,7 +1040,7 @@ func addDefaultEnvoyProxy(resources *gatewayapi.Resources) error {
 
 	defaultEnvoyProxyName := "default-envoy-proxy"
 	namespace := resources.GatewayClass.Namespace
-	defaultBootstrapStr, err := bootstrap.GetRenderedBootstrapConfig(nil)
+	defaultBootstrapStr, err := bootstrap.GetRenderedBootstrapConfig(nil, nil, nil)
 	if err != nil {
 		return err
 	}
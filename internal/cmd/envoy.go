@@ -0,0 +1,62 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/cmd/envoy"
+)
+
+// getEnvoyCommand returns the envoy cobra command to be executed.
+func getEnvoyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "envoy",
+		Short: "Envoy proxy management",
+	}
+
+	cmd.AddCommand(getShutdownManagerCommand())
+	cmd.AddCommand(getShutdownCommand())
+
+	return cmd
+}
+
+// getShutdownManagerCommand returns the shutdown manager cobra command to be executed.
+func getShutdownManagerCommand() *cobra.Command {
+	var readyTimeout time.Duration
+	cmd := &cobra.Command{
+		Use:   "shutdown-manager",
+		Short: "Serve the shutdown manager of the Envoy pods",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envoy.ShutdownManager(readyTimeout)
+		},
+	}
+	cmd.PersistentFlags().DurationVarP(&readyTimeout, "ready-timeout", "", 70*time.Second,
+		"The maximum time to wait for the connections of Envoy to drain.")
+
+	return cmd
+}
+
+// getShutdownCommand returns the shutdown cobra command to be executed.
+func getShutdownCommand() *cobra.Command {
+	var drainTimeout, minDrainDuration time.Duration
+	cmd := &cobra.Command{
+		Use:   "shutdown",
+		Short: "Drain the connections of Envoy before it terminates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return envoy.Shutdown(drainTimeout, minDrainDuration)
+		},
+	}
+	cmd.PersistentFlags().DurationVarP(&drainTimeout, "drain-timeout", "", v1alpha1.DefaultShutdownDrainTimeout,
+		"The maximum time to wait for the connections of Envoy to drain.")
+	cmd.PersistentFlags().DurationVarP(&minDrainDuration, "min-drain-duration", "", v1alpha1.DefaultShutdownMinDrainDuration,
+		"The minimum time to drain the listeners of Envoy, even when no connections are active.")
+
+	return cmd
+}
@@ -0,0 +1,186 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package envoy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/xds/bootstrap"
+)
+
+const (
+	// ShutdownManagerPort is the port the shutdown manager listens on.
+	ShutdownManagerPort = 19002
+	// ShutdownManagerReadyPath is the path of the shutdown manager endpoint which
+	// responds once the connections of Envoy are drained.
+	ShutdownManagerReadyPath = "/shutdown/ready"
+	// shutdownReadyFile is the file which signals that the connections of Envoy are drained.
+	shutdownReadyFile = "/tmp/shutdown-ready"
+	// totalConnectionsStat is the Envoy stat of the active connections of all listeners.
+	totalConnectionsStat = "server.total_connections"
+)
+
+var (
+	logger = logging.DefaultLogger(v1alpha1.LogLevelInfo)
+
+	// pollInterval is the interval to check the draining of Envoy.
+	pollInterval = 1 * time.Second
+	// adminClient is the client of the Envoy admin interface.
+	adminClient = &http.Client{Timeout: 5 * time.Second}
+)
+
+// ShutdownManager serves the shutdown manager of the Envoy pods until it receives
+// a termination signal. The preStop hook of the Envoy container calls the ready
+// endpoint, which blocks until Shutdown has drained Envoy or readyTimeout elapses.
+func ShutdownManager(readyTimeout time.Duration) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ShutdownManagerReadyPath, func(w http.ResponseWriter, _ *http.Request) {
+		shutdownReadyHandler(w, shutdownReadyFile, readyTimeout)
+	})
+	srv := &http.Server{
+		Addr:              fmt.Sprintf(":%d", ShutdownManagerPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+	logger.Info("started shutdown manager", "port", ShutdownManagerPort)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("failed to serve shutdown manager: %w", err)
+	case <-ctx.Done():
+	}
+
+	// Let the pending preStop hook of the Envoy container complete.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), readyTimeout)
+	defer cancel()
+	return srv.Shutdown(shutdownCtx)
+}
+
+// shutdownReadyHandler responds once readyFile exists, or with an error once
+// timeout elapses.
+func shutdownReadyHandler(w http.ResponseWriter, readyFile string, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for {
+		if _, err := os.Stat(readyFile); err == nil {
+			logger.Info("envoy connections are drained")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if time.Now().After(deadline) {
+			logger.Info("timed out waiting for envoy connections to drain", "timeout", timeout)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// Shutdown gracefully drains the listeners of Envoy and waits until no connections
+// are active, at least for minDrainDuration and at most for drainTimeout. It then
+// signals the shutdown manager that the Envoy container can terminate.
+func Shutdown(drainTimeout, minDrainDuration time.Duration) error {
+	adminURL := fmt.Sprintf("http://%s", net.JoinHostPort("localhost", strconv.Itoa(bootstrap.EnvoyAdminPort)))
+	return shutdown(adminURL, shutdownReadyFile, drainTimeout, minDrainDuration)
+}
+
+func shutdown(adminURL, readyFile string, drainTimeout, minDrainDuration time.Duration) error {
+	start := time.Now()
+	// Always signal the shutdown manager, so that a failed drain does not hold
+	// the Envoy container until the termination grace period expires.
+	defer func() {
+		if err := os.WriteFile(readyFile, nil, 0o600); err != nil {
+			logger.Error(err, "failed to signal the shutdown manager", "file", readyFile)
+		}
+	}()
+
+	// Stop accepting new connections and ask the clients of the active ones to
+	// close them, without exiting Envoy once the drain completes.
+	resp, err := adminClient.Post(adminURL+"/drain_listeners?graceful&skip_exit", "", nil)
+	if err != nil {
+		return fmt.Errorf("failed to drain envoy listeners: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to drain envoy listeners: unexpected status %d", resp.StatusCode)
+	}
+	logger.Info("draining envoy listeners", "drainTimeout", drainTimeout, "minDrainDuration", minDrainDuration)
+
+	for {
+		elapsed := time.Since(start)
+		if elapsed >= drainTimeout {
+			logger.Info("drain timeout elapsed", "drainTimeout", drainTimeout)
+			return nil
+		}
+		if elapsed >= minDrainDuration {
+			conns, err := getTotalConnections(adminURL)
+			switch {
+			case err != nil:
+				logger.Error(err, "failed to get envoy connections")
+			case conns == 0:
+				logger.Info("drained envoy connections", "duration", elapsed)
+				return nil
+			default:
+				logger.Info("waiting for envoy connections to drain", "connections", conns)
+			}
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// getTotalConnections returns the number of active connections of all the
+// listeners of Envoy.
+func getTotalConnections(adminURL string) (int, error) {
+	query := url.Values{}
+	query.Set("filter", "^"+totalConnectionsStat+"$")
+	query.Set("format", "json")
+	resp, err := adminClient.Get(adminURL + "/stats?" + query.Encode())
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var stats struct {
+		Stats []struct {
+			Name  string `json:"name"`
+			Value int    `json:"value"`
+		} `json:"stats"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return 0, err
+	}
+	for _, stat := range stats.Stats {
+		if stat.Name == totalConnectionsStat {
+			return stat.Value, nil
+		}
+	}
+
+	return 0, fmt.Errorf("stat %s not found", totalConnectionsStat)
+}
@@ -0,0 +1,118 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package envoy
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAdmin returns an Envoy admin server whose active connections drop by one
+// on every stats request, down to zero, and records the drain requests.
+func fakeAdmin(t *testing.T, conns int32, drained *atomic.Bool) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/drain_listeners":
+			if r.Method != http.MethodPost || !r.URL.Query().Has("graceful") || !r.URL.Query().Has("skip_exit") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			drained.Store(true)
+		case "/stats":
+			if r.URL.Query().Get("filter") != "^server.total_connections$" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			value := conns
+			if conns > 0 {
+				conns--
+			}
+			fmt.Fprintf(w, `{"stats":[{"name":"server.total_connections","value":%d}]}`, value)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestShutdown(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+
+	testCases := []struct {
+		name             string
+		conns            int32
+		drainTimeout     time.Duration
+		minDrainDuration time.Duration
+	}{
+		{
+			name:         "connections drained",
+			conns:        3,
+			drainTimeout: time.Minute,
+		},
+		{
+			name:             "min drain duration",
+			drainTimeout:     time.Minute,
+			minDrainDuration: 50 * time.Millisecond,
+		},
+		{
+			name:         "drain timeout",
+			conns:        1000,
+			drainTimeout: 50 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var drained atomic.Bool
+			admin := fakeAdmin(t, tc.conns, &drained)
+			readyFile := filepath.Join(t.TempDir(), "shutdown-ready")
+
+			start := time.Now()
+			require.NoError(t, shutdown(admin.URL, readyFile, tc.drainTimeout, tc.minDrainDuration))
+			require.True(t, drained.Load())
+			require.GreaterOrEqual(t, time.Since(start), tc.minDrainDuration)
+			require.FileExists(t, readyFile)
+		})
+	}
+}
+
+func TestShutdownDrainFailure(t *testing.T) {
+	admin := httptest.NewServer(http.NotFoundHandler())
+	defer admin.Close()
+	readyFile := filepath.Join(t.TempDir(), "shutdown-ready")
+
+	require.Error(t, shutdown(admin.URL, readyFile, time.Minute, 0))
+	// The shutdown manager is signaled even though the drain failed.
+	require.FileExists(t, readyFile)
+}
+
+func TestShutdownReadyHandler(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	readyFile := filepath.Join(t.TempDir(), "shutdown-ready")
+
+	w := httptest.NewRecorder()
+	shutdownReadyHandler(w, readyFile, 50*time.Millisecond)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = os.WriteFile(readyFile, nil, 0o600)
+	}()
+	w = httptest.NewRecorder()
+	shutdownReadyHandler(w, readyFile, time.Minute)
+	require.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,72 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// TestShutdownManagerContainer checks that the shutdown manager container of the
+// Envoy pods runs commands of the Envoy Gateway binary of its default image.
+func TestShutdownManagerContainer(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	deployment, err := proxy.NewResourceRender(cfg.Namespace, infra.GetProxyInfra()).Deployment()
+	require.NoError(t, err)
+
+	var shutdownManager *corev1.Container
+	for i, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == "shutdown-manager" {
+			shutdownManager = &deployment.Spec.Template.Spec.Containers[i]
+		}
+	}
+	require.NotNil(t, shutdownManager)
+	require.Equal(t, v1alpha1.DefaultShutdownManagerImage, shutdownManager.Image)
+
+	testCases := []struct {
+		name    string
+		command []string
+		want    string
+	}{
+		{
+			name:    "shutdown manager",
+			command: append(append([]string{}, shutdownManager.Command...), shutdownManager.Args...),
+			want:    "shutdown-manager",
+		},
+		{
+			name:    "shutdown pre-stop hook",
+			command: shutdownManager.Lifecycle.PreStop.Exec.Command,
+			want:    "shutdown",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			root := GetRootCommand()
+			require.Equal(t, root.Use, tc.command[0])
+
+			cmd, args, err := root.Find(tc.command[1:])
+			require.NoError(t, err)
+			require.Equal(t, tc.want, cmd.Use)
+			require.NoError(t, cmd.ParseFlags(args))
+			require.Empty(t, cmd.Flags().Args())
+		})
+	}
+}
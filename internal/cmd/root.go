@@ -21,6 +21,7 @@ func GetRootCommand() *cobra.Command {
 	cmd.AddCommand(getServerCommand())
 	cmd.AddCommand(getVersionCommand())
 	cmd.AddCommand(getCertGenCommand())
+	cmd.AddCommand(getEnvoyCommand())
 
 	return cmd
 }
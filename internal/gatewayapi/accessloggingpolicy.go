@@ -0,0 +1,187 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+func (t *Translator) ProcessAccessLoggingPolicies(accessLoggingPolicies []*egv1a1.AccessLoggingPolicy,
+	gateways []*GatewayContext,
+	routes []RouteContext,
+	xdsIR XdsIRMap,
+	resources *Resources) []*egv1a1.AccessLoggingPolicy {
+	resolver := &policyTargetResolver[*egv1a1.AccessLoggingPolicy]{
+		kind:       egv1a1.KindAccessLoggingPolicy,
+		routeKinds: []string{KindHTTPRoute, KindGRPCRoute},
+		targetRef: func(policy *egv1a1.AccessLoggingPolicy) *egv1a1.PolicyTargetReferenceWithSectionName {
+			return &policy.Spec.TargetRef
+		},
+		setCondition: status.SetAccessLoggingPolicyCondition,
+	}
+
+	return resolver.attach(accessLoggingPolicies, gateways, routes, func(policy *egv1a1.AccessLoggingPolicy, target *policyTarget) error {
+		accessLog, err := t.buildRouteAccessLog(policy, resources)
+		if err != nil {
+			return err
+		}
+		if target.route != nil {
+			for _, irRoute := range irRoutesForRoute(target.route, xdsIR) {
+				irRoute.AccessLog = accessLog
+			}
+		} else {
+			setListenersRouteAccessLog(accessLog, target.listeners, xdsIR)
+		}
+		return nil
+	})
+}
+
+// setListenersRouteAccessLog sets the access logs of all the routes of the provided Listeners in the IR.
+func setListenersRouteAccessLog(accessLog *ir.RouteAccessLog, listeners []*ListenerContext, xdsIR XdsIRMap) {
+	for _, listener := range listeners {
+		gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+		if !ok {
+			continue
+		}
+		// Only valid HTTP and HTTPS Listeners are present in the IR.
+		irListener := gwXdsIR.GetHTTPListener(irHTTPListenerName(listener))
+		if irListener == nil {
+			continue
+		}
+		for _, irRoute := range irListener.Routes {
+			irRoute.AccessLog = accessLog
+		}
+	}
+}
+
+func irAccessLoggingPolicyName(policy *egv1a1.AccessLoggingPolicy) string {
+	return fmt.Sprintf("%s/%s/%s", strings.ToLower(egv1a1.KindAccessLoggingPolicy), policy.Namespace, policy.Name)
+}
+
+// buildRouteAccessLog validates the settings of the policy and translates them
+// into the route access logs of the IR.
+func (t *Translator) buildRouteAccessLog(policy *egv1a1.AccessLoggingPolicy, resources *Resources) (*ir.RouteAccessLog, error) {
+	accessLog := &ir.AccessLog{}
+	for i, setting := range policy.Spec.Settings {
+		filter := processAccessLogFilter(setting.Filter)
+		for j, sink := range setting.Sinks {
+			switch sink.Type {
+			case egcfgv1a1.ProxyAccessLogSinkTypeFile:
+				if sink.File == nil {
+					return nil, fmt.Errorf("sink %d of setting %d of type %s must set file", j, i, sink.Type)
+				}
+
+				switch setting.Format.Type {
+				case egcfgv1a1.ProxyAccessLogFormatTypeText:
+					accessLog.Text = append(accessLog.Text, &ir.TextAccessLog{
+						Format: setting.Format.Text,
+						Path:   sink.File.Path,
+						Filter: filter,
+					})
+				case egcfgv1a1.ProxyAccessLogFormatTypeJSON:
+					if len(setting.Format.JSON) == 0 {
+						return nil, fmt.Errorf("setting %d of format %s must set json", i, setting.Format.Type)
+					}
+					accessLog.JSON = append(accessLog.JSON, &ir.JSONAccessLog{
+						JSON:   setting.Format.JSON,
+						Path:   sink.File.Path,
+						Filter: filter,
+					})
+				}
+			case egcfgv1a1.ProxyAccessLogSinkTypeOpenTelemetry:
+				if sink.OpenTelemetry == nil {
+					return nil, fmt.Errorf("sink %d of setting %d of type %s must set openTelemetry", j, i, sink.Type)
+				}
+
+				host, port, err := t.resolveAccessLoggingPolicyBackendRef(policy, sink.OpenTelemetry.BackendRef, resources)
+				if err != nil {
+					return nil, err
+				}
+				al := &ir.OpenTelemetryAccessLog{
+					Host:      host,
+					Port:      port,
+					Resources: sink.OpenTelemetry.Resources,
+					Filter:    filter,
+				}
+				switch setting.Format.Type {
+				case egcfgv1a1.ProxyAccessLogFormatTypeJSON:
+					al.Attributes = setting.Format.JSON
+				case egcfgv1a1.ProxyAccessLogFormatTypeText:
+					al.Text = setting.Format.Text
+				}
+				accessLog.OpenTelemetry = append(accessLog.OpenTelemetry, al)
+			default:
+				return nil, fmt.Errorf("sink %d of setting %d has an unsupported type %s", j, i, sink.Type)
+			}
+		}
+	}
+
+	return &ir.RouteAccessLog{
+		Name:      irAccessLoggingPolicyName(policy),
+		AccessLog: accessLog,
+	}, nil
+}
+
+// resolveAccessLoggingPolicyBackendRef resolves the Service of a collector
+// of the policy into the address the access logs are sent to.
+func (t *Translator) resolveAccessLoggingPolicyBackendRef(policy *egv1a1.AccessLoggingPolicy,
+	backendRef gwv1b1.BackendObjectReference, resources *Resources) (string, uint32, error) {
+	if GroupDerefOr(backendRef.Group, "") != "" || KindDerefOr(backendRef.Kind, KindService) != KindService {
+		return "", 0, fmt.Errorf("BackendRef %s/%s must be a core %s",
+			GroupDerefOr(backendRef.Group, ""), KindDerefOr(backendRef.Kind, KindService), KindService)
+	}
+	if backendRef.Port == nil {
+		return "", 0, errors.New("BackendRef.Port must be set")
+	}
+
+	namespace := NamespaceDerefOr(backendRef.Namespace, policy.Namespace)
+	if namespace != policy.Namespace {
+		if !t.validateCrossNamespaceRef(
+			crossNamespaceFrom{
+				group:     egv1a1.GroupVersion.Group,
+				kind:      egv1a1.KindAccessLoggingPolicy,
+				namespace: policy.Namespace,
+			},
+			crossNamespaceTo{
+				group:     "",
+				kind:      KindService,
+				namespace: namespace,
+				name:      string(backendRef.Name),
+			},
+			resources.ReferenceGrants,
+		) {
+			return "", 0, fmt.Errorf("backend ref to Service %s/%s not permitted by any ReferenceGrant",
+				namespace, backendRef.Name)
+		}
+	}
+
+	service := resources.GetService(namespace, string(backendRef.Name))
+	if service == nil {
+		return "", 0, fmt.Errorf("Service %s/%s does not exist", namespace, backendRef.Name)
+	}
+	var portFound bool
+	for _, port := range service.Spec.Ports {
+		if port.Port == int32(*backendRef.Port) && (port.Protocol == "" || port.Protocol == v1.ProtocolTCP) {
+			portFound = true
+			break
+		}
+	}
+	if !portFound {
+		return "", 0, fmt.Errorf("TCP Port %d not found on Service %s/%s", *backendRef.Port, namespace, backendRef.Name)
+	}
+
+	return service.Spec.ClusterIP, uint32(*backendRef.Port), nil
+}
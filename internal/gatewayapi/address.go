@@ -6,6 +6,8 @@
 package gatewayapi
 
 import (
+	"net"
+
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
@@ -21,9 +23,13 @@ func (t *Translator) ProcessAddresses(gateways []*GatewayContext, xdsIR XdsIRMap
 		irKey := irStringKey(gateway.Gateway.Namespace, gateway.Gateway.Name)
 		gwInfraIR := infraIR[irKey]
 
+		// Only the IP addresses are provisioned by the infrastructure. The hostname
+		// addresses are reported in the Gateway status once the infrastructure has
+		// been assigned an address, and the addresses that cannot be honoured are
+		// reported in the Programmed condition of the Gateway.
 		var ipAddr []string
 		for _, addr := range gateway.Spec.Addresses {
-			if *addr.Type == v1beta1.IPAddressType {
+			if (addr.Type == nil || *addr.Type == v1beta1.IPAddressType) && net.ParseIP(addr.Value) != nil {
 				ipAddr = append(ipAddr, addr.Value)
 			}
 		}
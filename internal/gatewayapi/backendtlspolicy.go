@@ -0,0 +1,370 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+// RouteReasonInvalidBackendTLSPolicy is used with the "ResolvedRefs" condition when
+// the upstream TLS configuration of a backend cannot be resolved.
+const RouteReasonInvalidBackendTLSPolicy v1beta1.RouteConditionReason = "InvalidBackendTLSPolicy"
+
+// backendTLSPolicyKey identifies the Service, and optionally the Service port,
+// targeted by a BackendTLSPolicy.
+type backendTLSPolicyKey struct {
+	types.NamespacedName
+	sectionName string
+}
+
+func (t *Translator) ProcessBackendTLSPolicies(backendTLSPolicies []*egv1a1.BackendTLSPolicy,
+	resources *Resources) []*egv1a1.BackendTLSPolicy {
+	var res []*egv1a1.BackendTLSPolicy
+
+	// Sort based on timestamp, so that the oldest policy wins when several
+	// policies target the same Service port. Routes rely on this order to
+	// look up the policy of their backends.
+	sort.Slice(backendTLSPolicies, func(i, j int) bool {
+		if backendTLSPolicies[i].CreationTimestamp.Equal(&(backendTLSPolicies[j].CreationTimestamp)) {
+			return backendTLSPolicies[i].Namespace+"/"+backendTLSPolicies[i].Name <
+				backendTLSPolicies[j].Namespace+"/"+backendTLSPolicies[j].Name
+		}
+		return backendTLSPolicies[i].CreationTimestamp.Before(&(backendTLSPolicies[j].CreationTimestamp))
+	})
+
+	targetsWithPolicy := make(map[backendTLSPolicyKey]bool)
+
+	for _, currPolicy := range backendTLSPolicies {
+		policy := currPolicy.DeepCopy()
+		res = append(res, policy)
+
+		service := resolveBackendTLSPolicyTargetRef(policy, resources)
+		if service == nil {
+			continue
+		}
+
+		key := backendTLSPolicyKey{
+			NamespacedName: types.NamespacedName{Namespace: service.Namespace, Name: service.Name},
+		}
+		if policy.Spec.TargetRef.SectionName != nil {
+			key.sectionName = string(*policy.Spec.TargetRef.SectionName)
+		}
+		if targetsWithPolicy[key] {
+			message := fmt.Sprintf("Unable to target Service %s/%s, another BackendTLSPolicy has already attached to it.",
+				service.Namespace, service.Name)
+			if key.sectionName != "" {
+				message = fmt.Sprintf("Unable to target port %s of Service %s/%s, another BackendTLSPolicy has already attached to it.",
+					key.sectionName, service.Namespace, service.Name)
+			}
+
+			status.SetBackendTLSPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonConflicted,
+				message,
+			)
+			continue
+		}
+		targetsWithPolicy[key] = true
+
+		if _, err := t.buildBackendTLSConfig(policy, resources); err != nil {
+			status.SetBackendTLSPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				err.Error(),
+			)
+			continue
+		}
+
+		// Set Accepted=True
+		status.SetBackendTLSPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionTrue,
+			gwv1a2.PolicyReasonAccepted,
+			"BackendTLSPolicy has been accepted.",
+		)
+	}
+
+	return res
+}
+
+// resolveBackendTLSPolicyTargetRef returns the Service targeted by the policy,
+// or nil after setting the policy status if the target is invalid or cannot be found.
+func resolveBackendTLSPolicyTargetRef(policy *egv1a1.BackendTLSPolicy, resources *Resources) *v1.Service {
+	targetNs := policy.Spec.TargetRef.Namespace
+	// If empty, default to namespace of policy
+	if targetNs == nil {
+		targetNs = NamespacePtr(policy.Namespace)
+	}
+
+	// Ensure policy can only target a Service
+	if policy.Spec.TargetRef.Group != "" || policy.Spec.TargetRef.Kind != KindService {
+		message := fmt.Sprintf("TargetRef.Group:%s TargetRef.Kind:%s, only TargetRef.Group:\"\" and TargetRef.Kind:%s is supported.",
+			policy.Spec.TargetRef.Group, policy.Spec.TargetRef.Kind, KindService)
+
+		status.SetBackendTLSPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonInvalid,
+			message,
+		)
+		return nil
+	}
+
+	// Ensure Policy and target Service are in the same namespace
+	if policy.Namespace != string(*targetNs) {
+		message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, BackendTLSPolicy can only target a Service in the same namespace.",
+			policy.Namespace, *targetNs)
+
+		status.SetBackendTLSPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonInvalid,
+			message,
+		)
+		return nil
+	}
+
+	service := resources.GetService(string(*targetNs), string(policy.Spec.TargetRef.Name))
+	if service == nil {
+		message := fmt.Sprintf("Service:%s not found.", policy.Spec.TargetRef.Name)
+
+		status.SetBackendTLSPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonTargetNotFound,
+			message,
+		)
+		return nil
+	}
+
+	if policy.Spec.TargetRef.SectionName != nil && getServicePortByName(service, *policy.Spec.TargetRef.SectionName) == nil {
+		message := fmt.Sprintf("No port named %s found for Service %s/%s.",
+			*policy.Spec.TargetRef.SectionName, service.Namespace, service.Name)
+
+		status.SetBackendTLSPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonTargetNotFound,
+			message,
+		)
+		return nil
+	}
+
+	return service
+}
+
+// getServicePortByName returns the port of the Service with the given name, or nil if there is none.
+func getServicePortByName(service *v1.Service, name v1beta1.SectionName) *v1.ServicePort {
+	for i, port := range service.Spec.Ports {
+		if port.Name == string(name) {
+			return &service.Spec.Ports[i]
+		}
+	}
+	return nil
+}
+
+// getBackendTLSPolicy returns the BackendTLSPolicy attached to the given port of the Service,
+// or nil if there is none. A policy targeting the port by name takes precedence over a policy
+// targeting the whole Service, and the oldest policy wins when several target the same port.
+func getBackendTLSPolicy(policies []*egv1a1.BackendTLSPolicy, service *v1.Service, port int32) *egv1a1.BackendTLSPolicy {
+	var portName string
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Port == port {
+			portName = servicePort.Name
+			break
+		}
+	}
+
+	var servicePolicy *egv1a1.BackendTLSPolicy
+	for _, policy := range policies {
+		targetRef := policy.Spec.TargetRef
+		if targetRef.Group != "" || targetRef.Kind != KindService ||
+			policy.Namespace != service.Namespace ||
+			NamespaceDerefOr(targetRef.Namespace, policy.Namespace) != service.Namespace ||
+			string(targetRef.Name) != service.Name {
+			continue
+		}
+
+		if targetRef.SectionName == nil {
+			if servicePolicy == nil {
+				servicePolicy = policy
+			}
+			continue
+		}
+		if portName != "" && string(*targetRef.SectionName) == portName {
+			return policy
+		}
+	}
+
+	return servicePolicy
+}
+
+// buildBackendTLSConfig translates the policy into the upstream TLS configuration
+// used to connect to the targeted backends.
+func (t *Translator) buildBackendTLSConfig(policy *egv1a1.BackendTLSPolicy, resources *Resources) (*ir.TLSUpstreamConfig, error) {
+	return t.buildUpstreamTLSConfig(egv1a1.KindBackendTLSPolicy, policy.Namespace, policy.Name, &policy.Spec.TLS, resources)
+}
+
+// buildUpstreamTLSConfig translates the TLS settings of the resource of the given kind
+// into the upstream TLS configuration used to connect to its backends.
+func (t *Translator) buildUpstreamTLSConfig(kind, namespace, name string, tls *egv1a1.BackendTLSConfig,
+	resources *Resources) (*ir.TLSUpstreamConfig, error) {
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupVersion.Group,
+		kind:      kind,
+		namespace: namespace,
+	}
+
+	caCertificate, err := t.getCACertificate(from, tls.CACertificateRefs, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &ir.TLSUpstreamConfig{
+		Name:          irBackendTLSSecretName(kind, namespace, name, caCertKey),
+		SNI:           string(tls.Hostname),
+		CACertificate: caCertificate,
+	}
+
+	if tls.ClientCertificateRef != nil {
+		secret, err := t.getClientCertificateSecret(from, *tls.ClientCertificateRef, resources)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCertificate = &ir.TLSListenerConfig{
+			Name:              irBackendTLSSecretName(kind, namespace, name, v1.TLSCertKey),
+			ServerCertificate: secret.Data[v1.TLSCertKey],
+			PrivateKey:        secret.Data[v1.TLSPrivateKeyKey],
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// getClientCertificateSecret returns the Secret of type kubernetes.io/tls
+// referenced by the client certificate ref.
+func (t *Translator) getClientCertificateSecret(from crossNamespaceFrom, certRef v1beta1.SecretObjectReference,
+	resources *Resources) (*v1.Secret, error) {
+	group := GroupDerefOr(certRef.Group, "")
+	kind := KindDerefOr(certRef.Kind, KindSecret)
+	namespace := NamespaceDerefOr(certRef.Namespace, from.namespace)
+
+	if group != "" || kind != KindSecret {
+		return nil, fmt.Errorf("client certificate ref %s/%s must be a core %s", group, kind, KindSecret)
+	}
+
+	if namespace != from.namespace {
+		if !t.validateCrossNamespaceRef(
+			from,
+			crossNamespaceTo{
+				group:     "",
+				kind:      KindSecret,
+				namespace: namespace,
+				name:      string(certRef.Name),
+			},
+			resources.ReferenceGrants,
+		) {
+			return nil, fmt.Errorf("client certificate ref to Secret %s/%s not permitted by any ReferenceGrant",
+				namespace, certRef.Name)
+		}
+	}
+
+	secret := resources.GetSecret(namespace, string(certRef.Name))
+	if secret == nil {
+		return nil, fmt.Errorf("Secret %s/%s does not exist", namespace, certRef.Name)
+	}
+	if secret.Type != v1.SecretTypeTLS {
+		return nil, fmt.Errorf("Secret %s/%s must be of type %s", namespace, certRef.Name, v1.SecretTypeTLS)
+	}
+	if err := validateTLSSecretsData([]*v1.Secret{secret}, nil); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// destinationTLS tracks the upstream TLS configuration of the backends
+// of a route destination, which share a single cluster.
+type destinationTLS struct {
+	// resolved is set once a backend has been added to the destination.
+	resolved bool
+	config   *ir.TLSUpstreamConfig
+}
+
+// processBackendTLS resolves the upstream TLS configuration of the backend from the
+// BackendTLSPolicy attached to it, or from its TLS settings if it is a Backend, and ensures it matches the configuration of the
+// other backends of the destination. If it does not, the ResolvedRefs condition is
+// set on the route and false is returned, meaning the backend must not be used.
+func (t *Translator) processBackendTLS(backendRef v1beta1.BackendObjectReference, destTLS *destinationTLS,
+	parentRef *RouteParentContext, route RouteContext, resources *Resources) bool {
+	var tlsConfig *ir.TLSUpstreamConfig
+
+	backendNamespace := NamespaceDerefOr(backendRef.Namespace, route.GetNamespace())
+	service := resources.GetService(backendNamespace, string(backendRef.Name))
+	backend := resources.GetBackend(backendNamespace, string(backendRef.Name))
+	switch backendKind := KindDerefOr(backendRef.Kind, KindService); {
+	case backendKind == egv1a1.KindBackend && backend != nil && backend.Spec.TLS != nil:
+		var err error
+		if tlsConfig, err = t.buildUpstreamTLSConfig(egv1a1.KindBackend, backend.Namespace, backend.Name, backend.Spec.TLS, resources); err != nil {
+			parentRef.SetCondition(route,
+				v1beta1.RouteConditionResolvedRefs,
+				metav1.ConditionFalse,
+				RouteReasonInvalidBackendTLSPolicy,
+				fmt.Sprintf("TLS settings of Backend %s/%s are invalid: %v.", backend.Namespace, backend.Name, err),
+			)
+			return false
+		}
+	case backendKind == KindService && service != nil && backendRef.Port != nil:
+		if policy := getBackendTLSPolicy(resources.BackendTLSPolicies, service, int32(*backendRef.Port)); policy != nil {
+			var err error
+			if tlsConfig, err = t.buildBackendTLSConfig(policy, resources); err != nil {
+				parentRef.SetCondition(route,
+					v1beta1.RouteConditionResolvedRefs,
+					metav1.ConditionFalse,
+					RouteReasonInvalidBackendTLSPolicy,
+					fmt.Sprintf("BackendTLSPolicy %s/%s of Service %s/%s is invalid: %v.",
+						policy.Namespace, policy.Name, service.Namespace, service.Name, err),
+				)
+				return false
+			}
+		}
+	}
+
+	if destTLS.resolved && !isSameTLSUpstreamConfig(destTLS.config, tlsConfig) {
+		parentRef.SetCondition(route,
+			v1beta1.RouteConditionResolvedRefs,
+			metav1.ConditionFalse,
+			RouteReasonInvalidBackendTLSPolicy,
+			fmt.Sprintf("Backend %s/%s must use the same BackendTLSPolicy as the other backends of the rule.",
+				backendNamespace, backendRef.Name),
+		)
+		return false
+	}
+
+	destTLS.resolved = true
+	destTLS.config = tlsConfig
+	return true
+}
+
+func isSameTLSUpstreamConfig(a, b *ir.TLSUpstreamConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Name == b.Name
+}
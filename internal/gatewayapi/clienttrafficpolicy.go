@@ -0,0 +1,437 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+func (t *Translator) ProcessClientTrafficPolicies(clientTrafficPolicies []*egv1a1.ClientTrafficPolicy,
+	gateways []*GatewayContext,
+	xdsIR XdsIRMap,
+	resources *Resources) []*egv1a1.ClientTrafficPolicy {
+	var res []*egv1a1.ClientTrafficPolicy
+
+	// Sort based on timestamp, so that the oldest policy wins
+	// when several policies target the same Gateway or Listener.
+	sort.Slice(clientTrafficPolicies, func(i, j int) bool {
+		if clientTrafficPolicies[i].CreationTimestamp.Equal(&(clientTrafficPolicies[j].CreationTimestamp)) {
+			return clientTrafficPolicies[i].Namespace+"/"+clientTrafficPolicies[i].Name <
+				clientTrafficPolicies[j].Namespace+"/"+clientTrafficPolicies[j].Name
+		}
+		return clientTrafficPolicies[i].CreationTimestamp.Before(&(clientTrafficPolicies[j].CreationTimestamp))
+	})
+
+	// Listeners that a policy has already been attached to using a sectionName.
+	listenersWithPolicy := make(map[*ListenerContext]bool)
+	// Gateways that a policy has already been attached to as a whole.
+	gatewaysWithPolicy := make(map[types.NamespacedName]bool)
+
+	// Policies targeting a Listener take precedence over policies targeting
+	// the whole Gateway, so process them first.
+	for _, currPolicy := range clientTrafficPolicies {
+		if currPolicy.Spec.TargetRef.SectionName == nil {
+			continue
+		}
+		policy := currPolicy.DeepCopy()
+		res = append(res, policy)
+
+		gateway := resolveClientTrafficPolicyTargetRef(policy, gateways)
+		if gateway == nil {
+			continue
+		}
+
+		var listener *ListenerContext
+		for _, l := range gateway.listeners {
+			if l.Name == *policy.Spec.TargetRef.SectionName {
+				listener = l
+				break
+			}
+		}
+		if listener == nil {
+			message := fmt.Sprintf("No section name %s found for Gateway %s/%s.",
+				*policy.Spec.TargetRef.SectionName, gateway.Namespace, gateway.Name)
+
+			status.SetClientTrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonTargetNotFound,
+				message,
+			)
+			continue
+		}
+
+		if listenersWithPolicy[listener] {
+			message := fmt.Sprintf("Unable to target Listener %s of Gateway %s/%s, another ClientTrafficPolicy has already attached to it.",
+				listener.Name, gateway.Namespace, gateway.Name)
+
+			status.SetClientTrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonConflicted,
+				message,
+			)
+			continue
+		}
+		listenersWithPolicy[listener] = true
+
+		t.translateClientTrafficPolicy(policy, []*ListenerContext{listener}, xdsIR, resources)
+	}
+
+	for _, currPolicy := range clientTrafficPolicies {
+		if currPolicy.Spec.TargetRef.SectionName != nil {
+			continue
+		}
+		policy := currPolicy.DeepCopy()
+		res = append(res, policy)
+
+		gateway := resolveClientTrafficPolicyTargetRef(policy, gateways)
+		if gateway == nil {
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}
+		if gatewaysWithPolicy[key] {
+			message := fmt.Sprintf("Unable to target Gateway %s/%s, another ClientTrafficPolicy has already attached to it.",
+				gateway.Namespace, gateway.Name)
+
+			status.SetClientTrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonConflicted,
+				message,
+			)
+			continue
+		}
+		gatewaysWithPolicy[key] = true
+
+		// Only apply the policy to the Listeners that do not have
+		// a more specific policy attached to them.
+		var listeners []*ListenerContext
+		for _, listener := range gateway.listeners {
+			if !listenersWithPolicy[listener] {
+				listeners = append(listeners, listener)
+			}
+		}
+
+		t.translateClientTrafficPolicy(policy, listeners, xdsIR, resources)
+	}
+
+	return res
+}
+
+// resolveClientTrafficPolicyTargetRef returns the Gateway targeted by the policy,
+// or nil after setting the policy status if the target is invalid or cannot be found.
+func resolveClientTrafficPolicyTargetRef(policy *egv1a1.ClientTrafficPolicy, gateways []*GatewayContext) *GatewayContext {
+	targetNs := policy.Spec.TargetRef.Namespace
+	// If empty, default to namespace of policy
+	if targetNs == nil {
+		targetNs = NamespacePtr(policy.Namespace)
+	}
+
+	// Ensure policy can only target a Gateway
+	if policy.Spec.TargetRef.Group != gwv1b1.GroupName || policy.Spec.TargetRef.Kind != KindGateway {
+		message := fmt.Sprintf("TargetRef.Group:%s TargetRef.Kind:%s, only TargetRef.Group:%s and TargetRef.Kind:%s is supported.",
+			policy.Spec.TargetRef.Group, policy.Spec.TargetRef.Kind, gwv1b1.GroupName, KindGateway)
+
+		status.SetClientTrafficPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonInvalid,
+			message,
+		)
+		return nil
+	}
+
+	// Ensure Policy and target Gateway are in the same namespace
+	if policy.Namespace != string(*targetNs) {
+		message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, ClientTrafficPolicy can only target a Gateway in the same namespace.",
+			policy.Namespace, *targetNs)
+
+		status.SetClientTrafficPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonInvalid,
+			message,
+		)
+		return nil
+	}
+
+	for _, gateway := range gateways {
+		if gateway.Namespace == string(*targetNs) && gateway.Name == string(policy.Spec.TargetRef.Name) {
+			return gateway
+		}
+	}
+
+	message := fmt.Sprintf("Gateway:%s not found.", policy.Spec.TargetRef.Name)
+
+	status.SetClientTrafficPolicyCondition(policy,
+		gwv1a2.PolicyConditionAccepted,
+		metav1.ConditionFalse,
+		gwv1a2.PolicyReasonTargetNotFound,
+		message,
+	)
+	return nil
+}
+
+// translateClientTrafficPolicy translates the policy into the IR of the
+// provided Listeners and sets the policy status.
+func (t *Translator) translateClientTrafficPolicy(policy *egv1a1.ClientTrafficPolicy, listeners []*ListenerContext,
+	xdsIR XdsIRMap, resources *Resources) {
+	tlsSettings, err := t.buildTLSSettings(policy, resources)
+	if err != nil {
+		status.SetClientTrafficPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonInvalid,
+			err.Error(),
+		)
+		return
+	}
+	localReply, err := buildLocalReply(policy, resources)
+	if err != nil {
+		status.SetClientTrafficPolicyCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonInvalid,
+			err.Error(),
+		)
+		return
+	}
+	var upgrades *ir.Upgrades
+	if policy.Spec.Upgrades != nil {
+		if upgrades, err = buildUpgrades(policy.Spec.Upgrades); err != nil {
+			status.SetClientTrafficPolicyCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonInvalid,
+				err.Error(),
+			)
+			return
+		}
+	}
+	headers := buildHeaderSettings(policy)
+	timeout := buildClientTimeout(policy)
+	path := buildPathSettings(policy)
+	http1 := buildHTTP1Settings(policy)
+
+	for _, listener := range listeners {
+		gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+		if !ok {
+			continue
+		}
+		// Only valid HTTP and HTTPS Listeners are present in the IR.
+		irListener := gwXdsIR.GetHTTPListener(irHTTPListenerName(listener))
+		if irListener == nil {
+			continue
+		}
+		if irListener.TLS != nil {
+			irListener.TLSSettings = tlsSettings
+		}
+		irListener.Headers = headers
+		irListener.Timeout = timeout
+		irListener.Path = path
+		irListener.HTTP1 = http1
+		irListener.Upgrades = upgrades
+		irListener.LocalReply = localReply
+	}
+
+	// Set Accepted=True
+	status.SetClientTrafficPolicyCondition(policy,
+		gwv1a2.PolicyConditionAccepted,
+		metav1.ConditionTrue,
+		gwv1a2.PolicyReasonAccepted,
+		"ClientTrafficPolicy has been accepted.",
+	)
+}
+
+func (t *Translator) buildTLSSettings(policy *egv1a1.ClientTrafficPolicy, resources *Resources) (*ir.TLSSettings, error) {
+	tls := policy.Spec.TLS
+	if tls == nil {
+		return nil, nil
+	}
+
+	if tls.MinVersion != nil && tls.MaxVersion != nil &&
+		*tls.MinVersion != egv1a1.TLSAuto && *tls.MaxVersion != egv1a1.TLSAuto &&
+		*tls.MinVersion > *tls.MaxVersion {
+		return nil, fmt.Errorf("TLS.MinVersion %s must not be greater than TLS.MaxVersion %s", *tls.MinVersion, *tls.MaxVersion)
+	}
+
+	irTLS := &ir.TLSSettings{
+		MinVersion: tls.MinVersion,
+		MaxVersion: tls.MaxVersion,
+		Ciphers:    tls.Ciphers,
+		ECDHCurves: tls.ECDHCurves,
+	}
+	for _, protocol := range tls.ALPNProtocols {
+		irTLS.ALPNProtocols = append(irTLS.ALPNProtocols, string(protocol))
+	}
+
+	if tls.ClientValidation != nil {
+		from := crossNamespaceFrom{
+			group:     egv1a1.GroupVersion.Group,
+			kind:      egv1a1.KindClientTrafficPolicy,
+			namespace: policy.Namespace,
+		}
+		caCertificate, err := t.getCACertificate(from, tls.ClientValidation.CACertificateRefs, resources)
+		if err != nil {
+			return nil, err
+		}
+		irTLS.ClientCertificateValidation = &ir.ClientCertificateValidation{
+			Name:            irTLSCACertName(policy.Namespace, policy.Name),
+			CACertificate:   caCertificate,
+			SubjectAltNames: tls.ClientValidation.SubjectAltNames,
+			Optional:        tls.ClientValidation.Optional,
+		}
+	}
+
+	return irTLS, nil
+}
+
+func buildHeaderSettings(policy *egv1a1.ClientTrafficPolicy) *ir.HeaderSettings {
+	headers := policy.Spec.Headers
+	if headers == nil {
+		return nil
+	}
+
+	irHeaders := &ir.HeaderSettings{
+		MaxRequestHeadersKiB: headers.MaxRequestHeadersKiB,
+		MaxRequestHeaders:    headers.MaxRequestHeaders,
+	}
+
+	if xfcc := headers.XForwardedClientCert; xfcc != nil {
+		irXFCC := &ir.XForwardedClientCert{
+			Mode: egv1a1.XFCCForwardModeSanitize,
+		}
+		if xfcc.Mode != nil {
+			irXFCC.Mode = *xfcc.Mode
+		}
+		if irXFCC.Mode == egv1a1.XFCCForwardModeAppendForward || irXFCC.Mode == egv1a1.XFCCForwardModeSanitizeSet {
+			irXFCC.CertDetailsToAdd = xfcc.CertDetailsToAdd
+		}
+		irHeaders.XForwardedClientCert = irXFCC
+	}
+
+	return irHeaders
+}
+
+func buildClientTimeout(policy *egv1a1.ClientTrafficPolicy) *ir.ClientTimeout {
+	timeouts := policy.Spec.Timeouts
+	if timeouts == nil {
+		return nil
+	}
+
+	return &ir.ClientTimeout{
+		Request:        timeouts.Request,
+		RequestHeaders: timeouts.RequestHeaders,
+		Idle:           timeouts.Idle,
+		StreamIdle:     timeouts.StreamIdle,
+	}
+}
+
+func buildPathSettings(policy *egv1a1.ClientTrafficPolicy) *ir.PathSettings {
+	path := policy.Spec.Path
+	if path == nil {
+		return nil
+	}
+
+	irPath := &ir.PathSettings{
+		DisableNormalization: path.DisableNormalization,
+		DisableMergeSlashes:  path.DisableMergeSlashes,
+		EscapedSlashesAction: egv1a1.PathEscapedSlashActionUnescapeAndRedirect,
+	}
+	if path.EscapedSlashesAction != nil {
+		irPath.EscapedSlashesAction = *path.EscapedSlashesAction
+	}
+
+	return irPath
+}
+
+func buildHTTP1Settings(policy *egv1a1.ClientTrafficPolicy) *ir.HTTP1Settings {
+	http1 := policy.Spec.HTTP1
+	if http1 == nil {
+		return nil
+	}
+
+	return &ir.HTTP1Settings{
+		PreserveHeaderCase: http1.PreserveHeaderCase,
+		EnableHTTP10:       http1.EnableHTTP10,
+	}
+}
+
+func buildLocalReply(policy *egv1a1.ClientTrafficPolicy, resources *Resources) (*ir.LocalReply, error) {
+	localReply := policy.Spec.LocalReply
+	if localReply == nil {
+		return nil, nil
+	}
+
+	irLocalReply := &ir.LocalReply{}
+	for i, mapping := range localReply.Mappings {
+		if len(mapping.Match.StatusCodes) == 0 && len(mapping.Match.ResponseFlags) == 0 {
+			return nil, fmt.Errorf("LocalReply.Mappings[%d].Match must set at least one of StatusCodes and ResponseFlags", i)
+		}
+
+		irMapping := &ir.LocalReplyMapping{
+			ContentType: mapping.ContentType,
+		}
+		for _, statusCode := range mapping.Match.StatusCodes {
+			irMapping.StatusCodes = append(irMapping.StatusCodes, uint32(statusCode))
+		}
+		for _, flag := range mapping.Match.ResponseFlags {
+			irMapping.ResponseFlags = append(irMapping.ResponseFlags, string(flag))
+		}
+		if mapping.StatusCode != nil {
+			statusCode := uint32(*mapping.StatusCode)
+			irMapping.StatusCode = &statusCode
+		}
+		if mapping.Body != nil {
+			body, err := getCustomResponseBody(policy.Namespace, mapping.Body, resources)
+			if err != nil {
+				return nil, fmt.Errorf("LocalReply.Mappings[%d].Body: %w", i, err)
+			}
+			irMapping.Body = &body
+		}
+		irLocalReply.Mappings = append(irLocalReply.Mappings, irMapping)
+	}
+
+	return irLocalReply, nil
+}
+
+// getCustomResponseBody returns the body of a custom response, either set inline
+// or read from the referenced ConfigMap.
+func getCustomResponseBody(namespace string, body *egv1a1.CustomResponseBody, resources *Resources) (string, error) {
+	switch {
+	case body.Inline != nil && body.ValueRef != nil:
+		return "", fmt.Errorf("only one of Inline and ValueRef may be set")
+	case body.Inline != nil:
+		return *body.Inline, nil
+	case body.ValueRef != nil:
+		if body.ValueRef.Group != "" || body.ValueRef.Kind != KindConfigMap {
+			return "", fmt.Errorf("ValueRef %s/%s must be a core %s", body.ValueRef.Group, body.ValueRef.Kind, KindConfigMap)
+		}
+		configMap := resources.GetConfigMap(namespace, string(body.ValueRef.Name))
+		if configMap == nil {
+			return "", fmt.Errorf("ConfigMap %s/%s does not exist", namespace, body.ValueRef.Name)
+		}
+		value, ok := configMap.Data[egv1a1.LocalReplyBodyKey]
+		if !ok {
+			return "", fmt.Errorf("ConfigMap %s/%s does not contain the %s key", namespace, body.ValueRef.Name, egv1a1.LocalReplyBodyKey)
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("one of Inline and ValueRef must be set")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+func (t *Translator) ProcessCompressionPolicies(compressionPolicies []*egv1a1.CompressionPolicy,
+	gateways []*GatewayContext,
+	routes []RouteContext,
+	xdsIR XdsIRMap) []*egv1a1.CompressionPolicy {
+	resolver := &policyTargetResolver[*egv1a1.CompressionPolicy]{
+		kind:       egv1a1.KindCompressionPolicy,
+		routeKinds: []string{KindHTTPRoute},
+		targetRef: func(policy *egv1a1.CompressionPolicy) *egv1a1.PolicyTargetReferenceWithSectionName {
+			return &policy.Spec.TargetRef
+		},
+		setCondition: status.SetCompressionPolicyCondition,
+		validate:     validateCompressionPolicy,
+	}
+
+	return resolver.attach(compressionPolicies, gateways, routes, func(policy *egv1a1.CompressionPolicy, target *policyTarget) error {
+		if target.route != nil {
+			translateHTTPRouteCompressionPolicy(target.route, xdsIR)
+		} else {
+			translateGatewayCompressionPolicy(policy, target.listeners, xdsIR)
+		}
+		return nil
+	})
+}
+
+// validateCompressionPolicy ensures that the settings of the policy
+// are consistent with the kind of resource it targets.
+func validateCompressionPolicy(policy *egv1a1.CompressionPolicy) error {
+	spec := policy.Spec
+	if spec.TargetRef.Kind == KindHTTPRoute {
+		switch {
+		case !spec.Disabled:
+			return errors.New("a policy targeting an HTTPRoute must set Disabled")
+		case len(spec.Compressors) > 0 || spec.MinContentLength != nil || len(spec.ContentTypes) > 0 || spec.DisableOnETagHeader:
+			return errors.New("a policy targeting an HTTPRoute can only set Disabled")
+		}
+		return nil
+	}
+
+	if spec.Disabled {
+		return errors.New("a policy targeting a Gateway cannot set Disabled")
+	}
+	if len(spec.Compressors) == 0 {
+		return errors.New("at least one compressor must be set when targeting a Gateway")
+	}
+	seen := make(map[egv1a1.CompressorType]bool)
+	for _, compressor := range spec.Compressors {
+		if seen[compressor] {
+			return fmt.Errorf("compressor %s is set more than once", compressor)
+		}
+		seen[compressor] = true
+	}
+	return nil
+}
+
+// translateGatewayCompressionPolicy translates the policy into the IR of the
+// provided Listeners.
+func translateGatewayCompressionPolicy(policy *egv1a1.CompressionPolicy, listeners []*ListenerContext, xdsIR XdsIRMap) {
+	compression := &ir.Compression{
+		Compressors:         policy.Spec.Compressors,
+		MinContentLength:    policy.Spec.MinContentLength,
+		ContentTypes:        policy.Spec.ContentTypes,
+		DisableOnETagHeader: policy.Spec.DisableOnETagHeader,
+	}
+
+	for _, listener := range listeners {
+		gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+		if !ok {
+			continue
+		}
+		// Only valid HTTP and HTTPS Listeners are present in the IR.
+		irListener := gwXdsIR.GetHTTPListener(irHTTPListenerName(listener))
+		if irListener == nil {
+			continue
+		}
+		irListener.Compression = compression
+	}
+}
+
+// translateHTTPRouteCompressionPolicy disables the compression of the IR routes
+// generated for the HTTPRoute.
+func translateHTTPRouteCompressionPolicy(route RouteContext, xdsIR XdsIRMap) {
+	for _, irRoute := range irRoutesForRoute(route, xdsIR) {
+		irRoute.DisableCompression = true
+	}
+}
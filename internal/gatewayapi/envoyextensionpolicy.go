@@ -0,0 +1,331 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/yuin/gopher-lua/parse"
+	v1 "k8s.io/api/core/v1"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+func (t *Translator) ProcessEnvoyExtensionPolicies(envoyExtensionPolicies []*egv1a1.EnvoyExtensionPolicy,
+	gateways []*GatewayContext,
+	routes []RouteContext,
+	xdsIR XdsIRMap,
+	resources *Resources) []*egv1a1.EnvoyExtensionPolicy {
+	resolver := &policyTargetResolver[*egv1a1.EnvoyExtensionPolicy]{
+		kind:       egv1a1.KindEnvoyExtensionPolicy,
+		routeKinds: []string{KindHTTPRoute, KindGRPCRoute},
+		targetRef: func(policy *egv1a1.EnvoyExtensionPolicy) *egv1a1.PolicyTargetReferenceWithSectionName {
+			return &policy.Spec.TargetRef
+		},
+		setCondition: status.SetEnvoyExtensionPolicyCondition,
+	}
+
+	return resolver.attach(envoyExtensionPolicies, gateways, routes, func(policy *egv1a1.EnvoyExtensionPolicy, target *policyTarget) error {
+		extensions, err := t.buildEnvoyExtensions(policy, resources)
+		if err != nil {
+			return err
+		}
+		if target.route != nil {
+			for _, irRoute := range irRoutesForRoute(target.route, xdsIR) {
+				irRoute.EnvoyExtensions = extensions
+			}
+		} else {
+			setListenersEnvoyExtensions(extensions, target.listeners, xdsIR)
+		}
+		return nil
+	})
+}
+
+// setListenersEnvoyExtensions sets the extensions in the IR of the provided Listeners.
+func setListenersEnvoyExtensions(extensions *ir.EnvoyExtensions, listeners []*ListenerContext, xdsIR XdsIRMap) {
+	for _, listener := range listeners {
+		gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+		if !ok {
+			continue
+		}
+		// Only valid HTTP and HTTPS Listeners are present in the IR.
+		irListener := gwXdsIR.GetHTTPListener(irHTTPListenerName(listener))
+		if irListener == nil {
+			continue
+		}
+		irListener.EnvoyExtensions = extensions
+	}
+}
+
+// buildEnvoyExtensions resolves and validates the external processors, Lua filters
+// and Wasm modules of the policy.
+func (t *Translator) buildEnvoyExtensions(policy *egv1a1.EnvoyExtensionPolicy, resources *Resources) (*ir.EnvoyExtensions, error) {
+	if len(policy.Spec.ExtProc) == 0 && len(policy.Spec.Lua) == 0 && len(policy.Spec.Wasm) == 0 {
+		return nil, errors.New("at least one external processor, Lua filter or Wasm module must be set")
+	}
+
+	extensions := &ir.EnvoyExtensions{}
+	for i := range policy.Spec.ExtProc {
+		extProc, err := t.buildExtProc(policy, i, resources)
+		if err != nil {
+			return nil, fmt.Errorf("ExtProc[%d]: %w", i, err)
+		}
+		extensions.ExtProc = append(extensions.ExtProc, extProc)
+	}
+
+	for i := range policy.Spec.Lua {
+		code, err := getLuaCode(policy.Namespace, &policy.Spec.Lua[i], resources)
+		if err != nil {
+			return nil, fmt.Errorf("Lua[%d]: %w", i, err)
+		}
+		if _, err := parse.Parse(strings.NewReader(code), fmt.Sprintf("lua-%d", i)); err != nil {
+			return nil, fmt.Errorf("Lua[%d]: invalid source code: %s", i, strings.TrimSpace(err.Error()))
+		}
+		extensions.Lua = append(extensions.Lua, &ir.Lua{
+			Name: irEnvoyExtensionName(policy, "lua", fmt.Sprint(i)),
+			Code: code,
+		})
+	}
+
+	names := make(map[string]bool)
+	for i := range policy.Spec.Wasm {
+		wasm := &policy.Spec.Wasm[i]
+		if names[wasm.Name] {
+			return nil, fmt.Errorf("Wasm[%d]: name %s is used by several modules", i, wasm.Name)
+		}
+		names[wasm.Name] = true
+
+		code, err := getWasmCode(policy.Namespace, &wasm.Code, resources)
+		if err != nil {
+			return nil, fmt.Errorf("Wasm[%d]: %w", i, err)
+		}
+		irWasm := &ir.Wasm{
+			Name:     irEnvoyExtensionName(policy, "wasm", wasm.Name),
+			RootID:   wasm.RootID,
+			Code:     code,
+			FailOpen: wasm.FailOpen,
+		}
+		if wasm.Config != nil {
+			config := string(wasm.Config.Raw)
+			irWasm.Config = &config
+		}
+		extensions.Wasm = append(extensions.Wasm, irWasm)
+	}
+
+	return extensions, nil
+}
+
+func irEnvoyExtensionName(policy *egv1a1.EnvoyExtensionPolicy, extensionType, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", strings.ToLower(egv1a1.KindEnvoyExtensionPolicy), policy.Namespace, policy.Name, extensionType, name)
+}
+
+// buildExtProc resolves the Service of the external processor of the policy at the provided index.
+func (t *Translator) buildExtProc(policy *egv1a1.EnvoyExtensionPolicy, idx int, resources *Resources) (*ir.ExtProc, error) {
+	extProc := &policy.Spec.ExtProc[idx]
+	backendRef := extProc.BackendRef
+	if GroupDerefOr(backendRef.Group, "") != "" || KindDerefOr(backendRef.Kind, KindService) != KindService {
+		return nil, fmt.Errorf("BackendRef %s/%s must be a core %s",
+			GroupDerefOr(backendRef.Group, ""), KindDerefOr(backendRef.Kind, KindService), KindService)
+	}
+	if backendRef.Port == nil {
+		return nil, errors.New("BackendRef.Port must be set")
+	}
+
+	namespace := NamespaceDerefOr(backendRef.Namespace, policy.Namespace)
+	if namespace != policy.Namespace {
+		if !t.validateCrossNamespaceRef(
+			crossNamespaceFrom{
+				group:     egv1a1.GroupVersion.Group,
+				kind:      egv1a1.KindEnvoyExtensionPolicy,
+				namespace: policy.Namespace,
+			},
+			crossNamespaceTo{
+				group:     "",
+				kind:      KindService,
+				namespace: namespace,
+				name:      string(backendRef.Name),
+			},
+			resources.ReferenceGrants,
+		) {
+			return nil, fmt.Errorf("backend ref to Service %s/%s not permitted by any ReferenceGrant",
+				namespace, backendRef.Name)
+		}
+	}
+
+	service := resources.GetService(namespace, string(backendRef.Name))
+	if service == nil {
+		return nil, fmt.Errorf("Service %s/%s does not exist", namespace, backendRef.Name)
+	}
+	var portFound bool
+	for _, port := range service.Spec.Ports {
+		if port.Port == int32(*backendRef.Port) && (port.Protocol == "" || port.Protocol == v1.ProtocolTCP) {
+			portFound = true
+			break
+		}
+	}
+	if !portFound {
+		return nil, fmt.Errorf("TCP Port %d not found on Service %s/%s", *backendRef.Port, namespace, backendRef.Name)
+	}
+
+	name := irEnvoyExtensionName(policy, "extproc", fmt.Sprint(idx))
+	return &ir.ExtProc{
+		Name: name,
+		Destination: &ir.RouteDestination{
+			Name:      name,
+			Endpoints: []*ir.DestinationEndpoint{ir.NewDestEndpoint(service.Spec.ClusterIP, uint32(*backendRef.Port))},
+		},
+		Authority:      fmt.Sprintf("%s.%s:%d", service.Name, service.Namespace, *backendRef.Port),
+		ProcessingMode: extProc.ProcessingMode,
+		MessageTimeout: extProc.MessageTimeout,
+		FailOpen:       extProc.FailOpen,
+	}, nil
+}
+
+// getLuaCode returns the source code of the Lua filter.
+func getLuaCode(namespace string, lua *egv1a1.Lua, resources *Resources) (string, error) {
+	switch {
+	case lua.Inline != nil && lua.ValueRef != nil:
+		return "", fmt.Errorf("only one of Inline and ValueRef may be set")
+	case lua.Inline != nil:
+		return *lua.Inline, nil
+	case lua.ValueRef != nil:
+		if lua.ValueRef.Group != "" || lua.ValueRef.Kind != KindConfigMap {
+			return "", fmt.Errorf("ValueRef %s/%s must be a core %s", lua.ValueRef.Group, lua.ValueRef.Kind, KindConfigMap)
+		}
+		configMap := resources.GetConfigMap(namespace, string(lua.ValueRef.Name))
+		if configMap == nil {
+			return "", fmt.Errorf("ConfigMap %s/%s does not exist", namespace, lua.ValueRef.Name)
+		}
+		value, ok := configMap.Data[egv1a1.LuaSourceKey]
+		if !ok {
+			return "", fmt.Errorf("ConfigMap %s/%s does not contain the %s key", namespace, lua.ValueRef.Name, egv1a1.LuaSourceKey)
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("one of Inline and ValueRef must be set")
+	}
+}
+
+// getWasmCode returns the code of the Wasm module, checking its checksum.
+func getWasmCode(namespace string, source *egv1a1.WasmCodeSource, resources *Resources) (*ir.WasmCode, error) {
+	set := 0
+	for _, isSet := range []bool{source.ValueRef != nil, source.HTTP != nil, source.Image != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, errors.New("exactly one of ValueRef, HTTP and Image must be set")
+	}
+
+	if source.ValueRef != nil {
+		code, err := getWasmCodeFromRef(namespace, source.ValueRef, resources)
+		if err != nil {
+			return nil, err
+		}
+		if source.SHA256 != nil {
+			sum := sha256.Sum256(code)
+			if hex.EncodeToString(sum[:]) != *source.SHA256 {
+				return nil, fmt.Errorf("the SHA256 checksum of the code of %s %s/%s does not match %s",
+					source.ValueRef.Kind, namespace, source.ValueRef.Name, *source.SHA256)
+			}
+		}
+		return &ir.WasmCode{Inline: code}, nil
+	}
+
+	if source.SHA256 == nil {
+		return nil, errors.New("SHA256 must be set to download the code of the module")
+	}
+
+	var codeURL string
+	if source.HTTP != nil {
+		u, err := url.Parse(*source.HTTP)
+		if err != nil {
+			return nil, fmt.Errorf("invalid HTTP URL %s: %w", *source.HTTP, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid HTTP URL %s: only absolute http and https URLs are supported", *source.HTTP)
+		}
+		codeURL = *source.HTTP
+	} else {
+		blobURL, err := wasmImageBlobURL(*source.Image, *source.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Image %s: %w", *source.Image, err)
+		}
+		codeURL = blobURL
+	}
+
+	return &ir.WasmCode{URL: &codeURL, SHA256: *source.SHA256}, nil
+}
+
+// wasmImageBlobURL returns the URL Envoy Proxy downloads the layer of the image
+// holding the Wasm module from, using the blob API of the OCI distribution spec.
+// The layer is addressed by its digest, which is the checksum of the module, so
+// neither the tag nor the manifest of the image has to be resolved.
+func wasmImageBlobURL(image, sum string) (string, error) {
+	ref, ok := strings.CutPrefix(image, "oci://")
+	if !ok {
+		return "", errors.New("the oci://<registry>/<repository> format must be used")
+	}
+	registry, repository, ok := strings.Cut(ref, "/")
+	if !ok || registry == "" || repository == "" {
+		return "", errors.New("the oci://<registry>/<repository> format must be used")
+	}
+	if strings.ContainsAny(repository, ":@") {
+		return "", errors.New("the repository must not have a tag or a digest, the layer is selected by SHA256")
+	}
+	if registry == "docker.io" {
+		// Docker Hub serves the distribution API on its own host, and
+		// prefixes the official images with "library/".
+		registry = "registry-1.docker.io"
+		if !strings.Contains(repository, "/") {
+			repository = "library/" + repository
+		}
+	}
+	return fmt.Sprintf("https://%s/v2/%s/blobs/sha256:%s", registry, repository, sum), nil
+}
+
+// getWasmCodeFromRef returns the code of the Wasm module held by the referenced ConfigMap or Secret.
+func getWasmCodeFromRef(namespace string, ref *gwv1b1.LocalObjectReference, resources *Resources) ([]byte, error) {
+	if ref.Group != "" || (ref.Kind != KindConfigMap && ref.Kind != KindSecret) {
+		return nil, fmt.Errorf("ValueRef %s/%s must be a core %s or %s", ref.Group, ref.Kind, KindConfigMap, KindSecret)
+	}
+
+	var (
+		code  []byte
+		found bool
+	)
+	if ref.Kind == KindConfigMap {
+		configMap := resources.GetConfigMap(namespace, string(ref.Name))
+		if configMap == nil {
+			return nil, fmt.Errorf("ConfigMap %s/%s does not exist", namespace, ref.Name)
+		}
+		if code, found = configMap.BinaryData[egv1a1.WasmCodeKey]; !found {
+			var value string
+			value, found = configMap.Data[egv1a1.WasmCodeKey]
+			code = []byte(value)
+		}
+	} else {
+		secret := resources.GetSecret(namespace, string(ref.Name))
+		if secret == nil {
+			return nil, fmt.Errorf("Secret %s/%s does not exist", namespace, ref.Name)
+		}
+		code, found = secret.Data[egv1a1.WasmCodeKey]
+	}
+	if !found {
+		return nil, fmt.Errorf("%s %s/%s does not contain the %s key", ref.Kind, namespace, ref.Name, egv1a1.WasmCodeKey)
+	}
+
+	return code, nil
+}
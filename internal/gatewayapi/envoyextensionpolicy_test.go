@@ -0,0 +1,76 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWasmImageBlobURL(t *testing.T) {
+	sum := "93a44bbb96c751218e4c00d479e4c14358122a389acca16205b1e4d0dc5f9476"
+	testCases := []struct {
+		name     string
+		image    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "registry with nested repository",
+			image:    "oci://ghcr.io/example/wasm/plugin",
+			expected: "https://ghcr.io/v2/example/wasm/plugin/blobs/sha256:" + sum,
+		},
+		{
+			name:     "registry with port",
+			image:    "oci://registry.example.com:5000/plugin",
+			expected: "https://registry.example.com:5000/v2/plugin/blobs/sha256:" + sum,
+		},
+		{
+			name:     "docker hub",
+			image:    "oci://docker.io/example/plugin",
+			expected: "https://registry-1.docker.io/v2/example/plugin/blobs/sha256:" + sum,
+		},
+		{
+			name:     "docker hub official image",
+			image:    "oci://docker.io/plugin",
+			expected: "https://registry-1.docker.io/v2/library/plugin/blobs/sha256:" + sum,
+		},
+		{
+			name:    "missing scheme",
+			image:   "ghcr.io/example/plugin",
+			wantErr: true,
+		},
+		{
+			name:    "missing repository",
+			image:   "oci://ghcr.io",
+			wantErr: true,
+		},
+		{
+			name:    "tag",
+			image:   "oci://ghcr.io/example/plugin:v1",
+			wantErr: true,
+		},
+		{
+			name:    "digest",
+			image:   "oci://ghcr.io/example/plugin@sha256:" + sum,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			blobURL, err := wasmImageBlobURL(tc.image, sum)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, blobURL)
+		})
+	}
+}
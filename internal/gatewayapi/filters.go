@@ -6,6 +6,7 @@
 package gatewayapi
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -56,10 +57,15 @@ type HTTPFilterIR struct {
 	AddResponseHeaders    []ir.AddHeader
 	RemoveResponseHeaders []string
 
-	Mirror *ir.RouteDestination
+	Mirrors []*ir.MirrorPolicy
 
 	RequestAuthentication *ir.RequestAuthentication
 	RateLimit             *ir.RateLimit
+	FaultInjection        *ir.FaultInjection
+	GRPCTranscoding       *ir.GRPCTranscoding
+	Upgrades              *ir.Upgrades
+
+	VirtualClusters []*ir.VirtualCluster
 
 	ExtensionRefs []*ir.UnstructuredRef
 }
@@ -113,10 +119,12 @@ func (t *Translator) ProcessHTTPFilters(parentRef *RouteParentContext,
 func (t *Translator) ProcessGRPCFilters(parentRef *RouteParentContext,
 	route RouteContext,
 	filters []v1alpha2.GRPCRouteFilter,
+	ruleIdx int,
 	resources *Resources) *HTTPFiltersContext {
 	httpFiltersContext := &HTTPFiltersContext{
 		ParentRef: parentRef,
 		Route:     route,
+		RuleIdx:   ruleIdx,
 
 		HTTPFilterIR: &HTTPFilterIR{},
 	}
@@ -777,6 +785,83 @@ func (t *Translator) processExtensionRefHTTPFilter(extFilter *v1beta1.LocalObjec
 		}
 	}
 
+	// Set the filter context and return early if a matching FaultInjectionFilter is found.
+	if string(extFilter.Kind) == egv1a1.KindFaultInjectionFilter {
+		for _, faultInjectionFilter := range resources.FaultInjectionFilters {
+			if faultInjectionFilter.Namespace == filterNs &&
+				faultInjectionFilter.Name == string(extFilter.Name) {
+				faultInjection, err := buildFaultInjection(&faultInjectionFilter.Spec)
+				if err != nil {
+					errMsg := fmt.Sprintf("Unable to translate FaultInjectionFilter %s/%s: %v", filterNs,
+						extFilter.Name, err)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+					return
+				}
+				filterContext.HTTPFilterIR.FaultInjection = faultInjection
+				return
+			}
+		}
+	}
+
+	// Set the filter context and return early if a matching UpgradeFilter is found.
+	if string(extFilter.Kind) == egv1a1.KindUpgradeFilter {
+		for _, upgradeFilter := range resources.UpgradeFilters {
+			if upgradeFilter.Namespace == filterNs &&
+				upgradeFilter.Name == string(extFilter.Name) {
+				upgrades, err := buildUpgrades(&upgradeFilter.Spec)
+				if err != nil {
+					errMsg := fmt.Sprintf("Unable to translate UpgradeFilter %s/%s: %v", filterNs,
+						extFilter.Name, err)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+					return
+				}
+				filterContext.HTTPFilterIR.Upgrades = upgrades
+				return
+			}
+		}
+	}
+
+	// Set the filter context and return early if a matching HTTPRouteFilter is found.
+	if string(extFilter.Kind) == egv1a1.KindHTTPRouteFilter {
+		for _, httpRouteFilter := range resources.HTTPRouteFilters {
+			if httpRouteFilter.Namespace == filterNs &&
+				httpRouteFilter.Name == string(extFilter.Name) {
+				if err := t.processHTTPRouteFilter(httpRouteFilter, filterContext, resources); err != nil {
+					errMsg := fmt.Sprintf("Unable to translate HTTPRouteFilter %s/%s: %v", filterNs,
+						extFilter.Name, err)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+				}
+				return
+			}
+		}
+	}
+
+	// Set the filter context and return early if a matching GRPCTranscodingFilter is found.
+	if string(extFilter.Kind) == egv1a1.KindGRPCTranscodingFilter {
+		for _, grpcTranscodingFilter := range resources.GRPCTranscodingFilters {
+			if grpcTranscodingFilter.Namespace == filterNs &&
+				grpcTranscodingFilter.Name == string(extFilter.Name) {
+				grpcRoute, ok := filterContext.Route.(*GRPCRouteContext)
+				if !ok {
+					errMsg := fmt.Sprintf("GRPCTranscodingFilter %s/%s can only be referenced by a GRPCRoute", filterNs,
+						extFilter.Name)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+					return
+				}
+				grpcTranscoding, err := t.buildGRPCTranscoding(grpcTranscodingFilter,
+					&grpcRoute.Spec.Rules[filterContext.RuleIdx], resources)
+				if err != nil {
+					errMsg := fmt.Sprintf("Unable to translate GRPCTranscodingFilter %s/%s: %v", filterNs,
+						extFilter.Name, err)
+					t.processUnresolvedHTTPFilter(errMsg, filterContext)
+					return
+				}
+				filterContext.HTTPFilterIR.GRPCTranscoding = grpcTranscoding
+				return
+			}
+		}
+	}
+
 	// This list of resources will be empty unless an extension is loaded (and introduces resources)
 	for _, res := range resources.ExtensionRefFilters {
 		if res.GetKind() == string(extFilter.Kind) && res.GetName() == string(extFilter.Name) && res.GetNamespace() == filterNs {
@@ -807,6 +892,48 @@ func (t *Translator) processExtensionRefHTTPFilter(extFilter *v1beta1.LocalObjec
 	t.processUnresolvedHTTPFilter(errMsg, filterContext)
 }
 
+// buildFaultInjection translates the spec of a FaultInjectionFilter into its IR.
+func buildFaultInjection(spec *egv1a1.FaultInjectionFilterSpec) (*ir.FaultInjection, error) {
+	if spec.Delay == nil && spec.Abort == nil {
+		return nil, errors.New("at least one of delay and abort must be set")
+	}
+
+	faultInjection := &ir.FaultInjection{
+		MaxActiveFaults: spec.MaxActiveFaults,
+	}
+
+	if delay := spec.Delay; delay != nil {
+		if (delay.FixedDelay != nil) == delay.HeaderControlled {
+			return nil, errors.New("exactly one of delay.fixedDelay and delay.headerControlled must be set")
+		}
+		faultInjection.Delay = &ir.FaultInjectionDelay{
+			FixedDelay:       delay.FixedDelay,
+			HeaderControlled: delay.HeaderControlled,
+			Percentage:       delay.Percentage,
+		}
+	}
+
+	if abort := spec.Abort; abort != nil {
+		set := 0
+		for _, isSet := range []bool{abort.HTTPStatus != nil, abort.GRPCStatus != nil, abort.HeaderControlled} {
+			if isSet {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, errors.New("exactly one of abort.httpStatus, abort.grpcStatus and abort.headerControlled must be set")
+		}
+		faultInjection.Abort = &ir.FaultInjectionAbort{
+			HTTPStatus:       abort.HTTPStatus,
+			GRPCStatus:       abort.GRPCStatus,
+			HeaderControlled: abort.HeaderControlled,
+			Percentage:       abort.Percentage,
+		}
+	}
+
+	return faultInjection, nil
+}
+
 func (t *Translator) processRequestMirrorFilter(
 	mirrorFilter *v1beta1.HTTPRequestMirrorFilter,
 	filterContext *HTTPFiltersContext,
@@ -817,7 +944,19 @@ func (t *Translator) processRequestMirrorFilter(
 		return
 	}
 
-	mirrorBackend := mirrorFilter.BackendRef
+	t.processRequestMirror(mirrorFilter.BackendRef, nil, filterContext, resources)
+}
+
+// processRequestMirror adds a mirror policy for the provided backend to the
+// filter context, mirroring the provided percentage of the requests. Each
+// mirror backend has its own destination, unless it duplicates the endpoints
+// of a previous mirror of the rule, in which case the requests are mirrored
+// once using the largest of the percentages.
+func (t *Translator) processRequestMirror(
+	mirrorBackend v1beta1.BackendObjectReference,
+	percentage *uint32,
+	filterContext *HTTPFiltersContext,
+	resources *Resources) {
 
 	// Wrap the filter's BackendObjectReference into a BackendRef so we can use existing tooling to check it
 	weight := int32(1)
@@ -835,28 +974,59 @@ func (t *Translator) processRequestMirrorFilter(
 	}
 
 	mirrorEndpoints, _ := t.processDestEndpoints(mirrorBackendRef, filterContext.ParentRef, filterContext.Route, resources)
+	if len(mirrorEndpoints) == 0 {
+		return
+	}
 
-	// Only add missing mirror destinations
-	for _, mirrorEp := range mirrorEndpoints {
-		var found bool
-		if filterContext.Mirror != nil {
-			for _, mirror := range filterContext.Mirror.Endpoints {
-				if mirror != nil {
-					if mirror.Host == mirrorEp.Host && mirror.Port == mirrorEp.Port {
-						found = true
-					}
-				}
-			}
+	destTLS := &destinationTLS{}
+	if !t.processBackendTLS(mirrorBackend, destTLS, filterContext.ParentRef, filterContext.Route, resources) {
+		return
+	}
+
+	// Only add mirrors whose endpoints are not already mirrored
+	for _, mirror := range filterContext.Mirrors {
+		if isSameDestinationEndpoints(mirror.Destination.Endpoints, mirrorEndpoints) {
+			mirror.Percentage = maxMirrorPercentage(mirror.Percentage, percentage)
+			return
 		}
-		if !found {
-			if filterContext.Mirror == nil {
-				filterContext.Mirror = &ir.RouteDestination{
-					Name: fmt.Sprintf("%s-mirror", irRouteDestinationName(filterContext.Route, filterContext.RuleIdx)),
-				}
-			}
-			filterContext.Mirror.Endpoints = append(filterContext.Mirror.Endpoints, mirrorEp)
+	}
+
+	filterContext.Mirrors = append(filterContext.Mirrors, &ir.MirrorPolicy{
+		Destination: &ir.RouteDestination{
+			Name: fmt.Sprintf("%s-mirror-%d", irRouteDestinationName(filterContext.Route, filterContext.RuleIdx),
+				len(filterContext.Mirrors)),
+			Endpoints: mirrorEndpoints,
+			TLS:       destTLS.config,
+			DNS:       getBackendDNSSettings(mirrorBackend, filterNs, resources),
+			StatName: fmt.Sprintf("%s-mirror-%d", irRouteDestinationStatName(filterContext.Route, filterContext.RuleIdx),
+				len(filterContext.Mirrors)),
+		},
+		Percentage: percentage,
+	})
+}
+
+// maxMirrorPercentage returns the largest of the provided mirror percentages,
+// an unset percentage mirroring all the requests.
+func maxMirrorPercentage(a, b *uint32) *uint32 {
+	if a == nil || b == nil {
+		return nil
+	}
+	if *b > *a {
+		return b
+	}
+	return a
+}
+
+func isSameDestinationEndpoints(a, b []*ir.DestinationEndpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Host != b[i].Host || a[i].Port != b[i].Port {
+			return false
 		}
 	}
+	return true
 }
 
 func (t *Translator) processUnresolvedHTTPFilter(errMsg string, filterContext *HTTPFiltersContext) {
@@ -905,3 +1075,29 @@ func (t *Translator) processInvalidHTTPFilter(filterType string, filterContext *
 		StatusCode: 500,
 	}
 }
+
+// buildUpgrades translates the upgrade settings of a ClientTrafficPolicy or
+// an UpgradeFilter into their IR.
+func buildUpgrades(settings *egv1a1.UpgradeSettings) (*ir.Upgrades, error) {
+	if len(settings.Upgrades) == 0 && settings.IdleTimeout == nil {
+		return nil, errors.New("at least one of upgrades and idleTimeout must be set")
+	}
+
+	upgrades := &ir.Upgrades{
+		IdleTimeout: settings.IdleTimeout,
+	}
+	for _, upgrade := range settings.Upgrades {
+		for _, irUpgrade := range upgrades.Types {
+			// The upgrade types are case insensitive.
+			if strings.EqualFold(irUpgrade.Type, string(upgrade.Type)) {
+				return nil, fmt.Errorf("upgrade type %s is set more than once", upgrade.Type)
+			}
+		}
+		upgrades.Types = append(upgrades.Types, ir.HTTPUpgrade{
+			Type:    string(upgrade.Type),
+			Enabled: upgrade.Enabled == nil || *upgrade.Enabled,
+		})
+	}
+
+	return upgrades, nil
+}
@@ -0,0 +1,202 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// buildGRPCTranscoding translates a GRPCTranscodingFilter referenced by the
+// provided GRPCRoute rule into its IR.
+func (t *Translator) buildGRPCTranscoding(filter *egv1a1.GRPCTranscodingFilter, rule *v1alpha2.GRPCRouteRule,
+	resources *Resources) (*ir.GRPCTranscoding, error) {
+	spec := &filter.Spec
+	if spec.JSON == nil {
+		return nil, errors.New("json must be set")
+	}
+
+	ref := spec.JSON.DescriptorSetRef
+	if ref.Group != "" || ref.Kind != KindConfigMap {
+		return nil, fmt.Errorf("descriptorSetRef %s/%s must be a core %s", ref.Group, ref.Kind, KindConfigMap)
+	}
+	configMap := resources.GetConfigMap(filter.Namespace, string(ref.Name))
+	if configMap == nil {
+		return nil, fmt.Errorf("ConfigMap %s/%s does not exist", filter.Namespace, ref.Name)
+	}
+	descriptorSet, found := configMap.BinaryData[egv1a1.GRPCDescriptorSetKey]
+	if !found {
+		var value string
+		value, found = configMap.Data[egv1a1.GRPCDescriptorSetKey]
+		descriptorSet = []byte(value)
+	}
+	if !found {
+		return nil, fmt.Errorf("ConfigMap %s/%s does not contain the %s key", filter.Namespace, ref.Name,
+			egv1a1.GRPCDescriptorSetKey)
+	}
+
+	files, err := parseGRPCDescriptorSet(descriptorSet)
+	if err != nil {
+		return nil, err
+	}
+	services, err := t.grpcTranscodedServices(files, spec.JSON.Services, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ir.GRPCTranscoding{
+		JSON: &ir.GRPCJSONTranscoder{
+			Name:                         fmt.Sprintf("grpctranscodingfilter/%s/%s", filter.Namespace, filter.Name),
+			DescriptorSet:                descriptorSet,
+			Services:                     services,
+			PrintOptions:                 spec.JSON.PrintOptions,
+			IgnoreUnknownQueryParameters: spec.JSON.IgnoreUnknownQueryParameters,
+		},
+	}, nil
+}
+
+// parseGRPCDescriptorSet parses a serialized FileDescriptorSet, which must
+// contain the dependencies of all its files.
+func parseGRPCDescriptorSet(descriptorSet []byte) (*protoregistry.Files, error) {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptorSet, fds); err != nil {
+		return nil, fmt.Errorf("invalid descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid descriptor set: %w", err)
+	}
+	return files, nil
+}
+
+// grpcTranscodedServices returns the services exposed by a gRPC-JSON transcoder.
+// The transcoded requests are matched again against the routes, so every
+// service must have at least one method matched by the methods of the rule.
+// When no service is provided, the services are the ones of the descriptor
+// set having methods matched by the rule.
+func (t *Translator) grpcTranscodedServices(files *protoregistry.Files, services []string, rule *v1alpha2.GRPCRouteRule) ([]string, error) {
+	// Build the path matches of the rule the same way as the routes do.
+	var methodMatches []*ir.HTTPRoute
+	for _, match := range rule.Matches {
+		if match.Method == nil {
+			methodMatches = nil
+			break
+		}
+		irRoute := &ir.HTTPRoute{}
+		switch GRPCMethodMatchTypeDerefOr(match.Method.Type, v1alpha2.GRPCMethodMatchExact) {
+		case v1alpha2.GRPCMethodMatchExact:
+			t.processGRPCRouteMethodExact(match.Method, irRoute)
+		case v1alpha2.GRPCMethodMatchRegularExpression:
+			t.processGRPCRouteMethodRegularExpression(match.Method, irRoute)
+		}
+		methodMatches = append(methodMatches, irRoute)
+	}
+	isMatched := func(service protoreflect.ServiceDescriptor) (bool, error) {
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			path := fmt.Sprintf("/%s/%s", service.FullName(), methods.Get(i).Name())
+			if len(methodMatches) == 0 {
+				return true, nil
+			}
+			for _, methodMatch := range methodMatches {
+				matched, err := grpcMethodPathMatches(methodMatch, path)
+				if err != nil {
+					return false, err
+				}
+				if matched {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+
+	if len(services) == 0 {
+		var err error
+		files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+			fileServices := file.Services()
+			for i := 0; i < fileServices.Len(); i++ {
+				var matched bool
+				if matched, err = isMatched(fileServices.Get(i)); err != nil {
+					return false
+				}
+				if matched {
+					services = append(services, string(fileServices.Get(i).FullName()))
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(services) == 0 {
+			return nil, errors.New("the descriptor set contains no service matched by the rule")
+		}
+		return services, nil
+	}
+
+	for _, name := range services {
+		desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("service %s not found in the descriptor set", name)
+		}
+		service, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+		matched, err := isMatched(service)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			return nil, fmt.Errorf("no method of service %s is matched by the rule", name)
+		}
+	}
+
+	return services, nil
+}
+
+// grpcMethodPathMatches returns true if the path of a gRPC method is matched
+// by the method match of the provided route.
+func grpcMethodPathMatches(irRoute *ir.HTTPRoute, path string) (bool, error) {
+	if pathMatch := irRoute.PathMatch; pathMatch != nil {
+		switch {
+		case pathMatch.Exact != nil:
+			return path == *pathMatch.Exact, nil
+		case pathMatch.Prefix != nil:
+			// Prefixes not ending with "/" are matched as path separated prefixes.
+			prefix := *pathMatch.Prefix
+			if !strings.HasSuffix(prefix, "/") {
+				prefix += "/"
+			}
+			return strings.HasPrefix(path, prefix), nil
+		case pathMatch.SafeRegex != nil:
+			re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", *pathMatch.SafeRegex))
+			if err != nil {
+				return false, fmt.Errorf("invalid method match %s: %w", *pathMatch.SafeRegex, err)
+			}
+			return re.MatchString(path), nil
+		}
+	}
+	for _, headerMatch := range irRoute.HeaderMatches {
+		if headerMatch.Name == ":path" && headerMatch.Suffix != nil {
+			return strings.HasSuffix(path, *headerMatch.Suffix), nil
+		}
+	}
+	return true, nil
+}
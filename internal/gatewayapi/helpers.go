@@ -215,6 +215,15 @@ func ValidateHTTPRouteFilter(filter *v1beta1.HTTPRouteFilter, extGKs ...schema.G
 		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
 			string(filter.ExtensionRef.Kind) == egv1a1.KindRateLimitFilter:
 			return nil
+		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+			string(filter.ExtensionRef.Kind) == egv1a1.KindFaultInjectionFilter:
+			return nil
+		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+			string(filter.ExtensionRef.Kind) == egv1a1.KindUpgradeFilter:
+			return nil
+		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+			string(filter.ExtensionRef.Kind) == egv1a1.KindHTTPRouteFilter:
+			return nil
 		default:
 			for _, gk := range extGKs {
 				if filter.ExtensionRef.Group == v1beta1.Group(gk.Group) &&
@@ -245,6 +254,31 @@ func IsRateLimitHTTPFilter(filter *v1beta1.HTTPRouteFilter) bool {
 		string(filter.ExtensionRef.Kind) == egv1a1.KindRateLimitFilter
 }
 
+// IsFaultInjectionHTTPFilter returns true if the provided filter is a FaultInjectionFilter.
+func IsFaultInjectionHTTPFilter(filter *v1beta1.HTTPRouteFilter) bool {
+	return filter.Type == v1beta1.HTTPRouteFilterExtensionRef &&
+		filter.ExtensionRef != nil &&
+		string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+		string(filter.ExtensionRef.Kind) == egv1a1.KindFaultInjectionFilter
+}
+
+// IsUpgradeHTTPFilter returns true if the provided filter is an UpgradeFilter.
+func IsUpgradeHTTPFilter(filter *v1beta1.HTTPRouteFilter) bool {
+	return filter.Type == v1beta1.HTTPRouteFilterExtensionRef &&
+		filter.ExtensionRef != nil &&
+		string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+		string(filter.ExtensionRef.Kind) == egv1a1.KindUpgradeFilter
+}
+
+// IsEnvoyGatewayHTTPRouteFilter returns true if the provided filter is an
+// Envoy Gateway HTTPRouteFilter.
+func IsEnvoyGatewayHTTPRouteFilter(filter *v1beta1.HTTPRouteFilter) bool {
+	return filter.Type == v1beta1.HTTPRouteFilterExtensionRef &&
+		filter.ExtensionRef != nil &&
+		string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+		string(filter.ExtensionRef.Kind) == egv1a1.KindHTTPRouteFilter
+}
+
 // ValidateGRPCRouteFilter validates the provided filter within GRPCRoute.
 func ValidateGRPCRouteFilter(filter *v1alpha2.GRPCRouteFilter, extGKs ...schema.GroupKind) error {
 	switch {
@@ -264,6 +298,9 @@ func ValidateGRPCRouteFilter(filter *v1alpha2.GRPCRouteFilter, extGKs ...schema.
 		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
 			string(filter.ExtensionRef.Kind) == egv1a1.KindRateLimitFilter:
 			return nil
+		case string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+			string(filter.ExtensionRef.Kind) == egv1a1.KindGRPCTranscodingFilter:
+			return nil
 		default:
 			for _, gk := range extGKs {
 				if filter.ExtensionRef.Group == v1beta1.Group(gk.Group) &&
@@ -294,6 +331,14 @@ func IsRateLimitGRPCFilter(filter *v1alpha2.GRPCRouteFilter) bool {
 		string(filter.ExtensionRef.Kind) == egv1a1.KindRateLimitFilter
 }
 
+// IsGRPCTranscodingGRPCFilter returns true if the provided filter is a GRPCTranscodingFilter.
+func IsGRPCTranscodingGRPCFilter(filter *v1alpha2.GRPCRouteFilter) bool {
+	return filter.Type == v1alpha2.GRPCRouteFilterExtensionRef &&
+		filter.ExtensionRef != nil &&
+		string(filter.ExtensionRef.Group) == egv1a1.GroupVersion.Group &&
+		string(filter.ExtensionRef.Kind) == egv1a1.KindGRPCTranscodingFilter
+}
+
 // GatewayOwnerLabels returns the Gateway Owner labels using
 // the provided namespace and name as the values.
 func GatewayOwnerLabels(namespace, name string) map[string]string {
@@ -424,6 +469,15 @@ func irHTTPListenerName(listener *ListenerContext) string {
 	return fmt.Sprintf("%s/%s/%s", listener.gateway.Namespace, listener.gateway.Name, listener.Name)
 }
 
+// irHTTPListenerStatPrefix returns the prefix of the statistics of the HTTP
+// connection manager of the listener. The HTTP listeners of a Gateway on the
+// same port share their statistics, like they share their HTTP connection
+// manager.
+func irHTTPListenerStatPrefix(listener *ListenerContext) string {
+	return irStatName(fmt.Sprintf("%s/%s/%s-%d", listener.gateway.Namespace, listener.gateway.Name,
+		strings.ToLower(string(listener.Protocol)), listener.Port))
+}
+
 func irTLSListenerName(listener *ListenerContext, tlsRoute *TLSRouteContext) string {
 	return fmt.Sprintf("%s/%s/%s/%s", listener.gateway.Namespace, listener.gateway.Name, listener.Name, tlsRoute.Name)
 }
@@ -440,10 +494,56 @@ func irRouteName(route RouteContext, ruleIdx, matchIdx int) string {
 	return fmt.Sprintf("%s/%s/%s/rule/%d/match/%d", strings.ToLower(string(GetRouteType(route))), route.GetNamespace(), route.GetName(), ruleIdx, matchIdx)
 }
 
+// irRoutesForRoute returns the IR routes generated for the provided route
+// on all the Listeners it is attached to.
+func irRoutesForRoute(route RouteContext, xdsIR XdsIRMap) []*ir.HTTPRoute {
+	prefix := fmt.Sprintf("%s/%s/%s/", strings.ToLower(string(GetRouteType(route))), route.GetNamespace(), route.GetName())
+
+	var irRoutes []*ir.HTTPRoute
+	for _, parentRef := range GetParentReferences(route) {
+		for _, listener := range GetRouteParentContext(route, parentRef).listeners {
+			gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+			if !ok {
+				continue
+			}
+			irListener := gwXdsIR.GetHTTPListener(irHTTPListenerName(listener))
+			if irListener == nil {
+				continue
+			}
+			for _, irRoute := range irListener.Routes {
+				if strings.HasPrefix(irRoute.Name, prefix) {
+					irRoutes = append(irRoutes, irRoute)
+				}
+			}
+		}
+	}
+	return irRoutes
+}
+
 func irRouteDestinationName(route RouteContext, ruleIdx int) string {
 	return fmt.Sprintf("%s/%s/%s/rule/%d", strings.ToLower(string(GetRouteType(route))), route.GetNamespace(), route.GetName(), ruleIdx)
 }
 
+// irRouteDestinationStatName returns the name used in the statistics of the
+// cluster of the route rule in place of its name. The routes without rules,
+// such as TCPRoutes, have no rule index.
+func irRouteDestinationStatName(route RouteContext, ruleIdx int) string {
+	if ruleIdx < 0 {
+		return irStatName(fmt.Sprintf("%s/%s/%s", strings.ToLower(string(GetRouteType(route))), route.GetNamespace(), route.GetName()))
+	}
+	return irStatName(irRouteDestinationName(route, ruleIdx))
+}
+
+func irVirtualClusterName(route RouteContext, name string) string {
+	return irStatName(fmt.Sprintf("%s/%s/%s/%s", strings.ToLower(string(GetRouteType(route))), route.GetNamespace(), route.GetName(), name))
+}
+
+// irStatName removes the dots from the name since dots are special chars
+// used in stats tag extraction in Envoy.
+func irStatName(name string) string {
+	return strings.ReplaceAll(name, ".", "_")
+}
+
 func irTLSConfigs(tlsSecrets []*v1.Secret) []*ir.TLSListenerConfig {
 	if len(tlsSecrets) == 0 {
 		return nil
@@ -464,6 +564,14 @@ func irTLSListenerConfigName(secret *v1.Secret) string {
 	return fmt.Sprintf("%s-%s", secret.Namespace, secret.Name)
 }
 
+func irTLSCACertName(namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, name, caCertKey)
+}
+
+func irBackendTLSSecretName(kind, namespace, name, key string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", strings.ToLower(kind), namespace, name, key)
+}
+
 func protocolSliceToStringSlice(protocols []v1beta1.ProtocolType) []string {
 	var protocolStrings []string
 	for _, protocol := range protocols {
@@ -0,0 +1,262 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// headerTemplateRegex matches the header values whose "%" characters are
+// either escaped as "%%" or enclose an Envoy variable, such as "%START_TIME%",
+// "%REQ(x-request-id)%" or "%UPSTREAM_METADATA([\"ns\", \"key\"])%".
+var headerTemplateRegex = regexp.MustCompile(`^(?:[^%]|%%|%[A-Z][A-Z0-9_]*(?:\([^)]*\))?(?::[0-9]+)?%)*$`)
+
+// processHTTPRouteFilter merges the URL rewrite, the templated headers, the
+// request mirror and the virtual clusters of the provided HTTPRouteFilter into
+// the filter context. The
+// filter context is unchanged when an error is returned.
+func (t *Translator) processHTTPRouteFilter(filter *egv1a1.HTTPRouteFilter, filterContext *HTTPFiltersContext,
+	resources *Resources) error {
+	spec := &filter.Spec
+	if spec.URLRewrite == nil && spec.RequestHeaderModifier == nil && spec.ResponseHeaderModifier == nil &&
+		spec.RequestMirror == nil && len(spec.VirtualClusters) == 0 {
+		return errors.New("at least one of urlRewrite, requestHeaderModifier, responseHeaderModifier, requestMirror " +
+			"and virtualClusters must be set")
+	}
+	if spec.RequestMirror != nil && spec.RequestMirror.Percentage != nil && *spec.RequestMirror.Percentage > 100 {
+		return errors.New("requestMirror percentage must be between 0 and 100")
+	}
+
+	var urlRewrite *ir.URLRewrite
+	if spec.URLRewrite != nil {
+		if filterContext.URLRewrite != nil {
+			return errors.New("cannot configure multiple urlRewrite filters for a single HTTPRouteRule")
+		}
+		var err error
+		if urlRewrite, err = buildHTTPRouteFilterURLRewrite(spec.URLRewrite); err != nil {
+			return err
+		}
+	}
+
+	addRequestHeaders, err := buildHeaderTemplates(spec.RequestHeaderModifier, filterContext.AddRequestHeaders)
+	if err != nil {
+		return fmt.Errorf("requestHeaderModifier: %w", err)
+	}
+	addResponseHeaders, err := buildHeaderTemplates(spec.ResponseHeaderModifier, filterContext.AddResponseHeaders)
+	if err != nil {
+		return fmt.Errorf("responseHeaderModifier: %w", err)
+	}
+
+	virtualClusters, err := buildVirtualClusters(spec.VirtualClusters, filterContext)
+	if err != nil {
+		return fmt.Errorf("virtualClusters: %w", err)
+	}
+
+	if urlRewrite != nil {
+		filterContext.URLRewrite = urlRewrite
+	}
+	filterContext.AddRequestHeaders = append(filterContext.AddRequestHeaders, addRequestHeaders...)
+	filterContext.AddResponseHeaders = append(filterContext.AddResponseHeaders, addResponseHeaders...)
+	filterContext.VirtualClusters = append(filterContext.VirtualClusters, virtualClusters...)
+
+	// The request mirror reports its invalid backends in the route status,
+	// like the RequestMirror filters of the Gateway API.
+	if spec.RequestMirror != nil {
+		t.processRequestMirror(spec.RequestMirror.BackendRef, spec.RequestMirror.Percentage, filterContext, resources)
+	}
+
+	return nil
+}
+
+// buildHTTPRouteFilterURLRewrite translates the URL rewrite of an
+// HTTPRouteFilter into its IR.
+func buildHTTPRouteFilterURLRewrite(rewrite *egv1a1.HTTPURLRewriteFilter) (*ir.URLRewrite, error) {
+	if rewrite.Path == nil && rewrite.Hostname == nil {
+		return nil, errors.New("at least one of path and hostname must be set in urlRewrite")
+	}
+
+	urlRewrite := &ir.URLRewrite{}
+	if rewrite.Path != nil {
+		regexMatch := rewrite.Path.ReplaceRegexMatch
+		if regexMatch.Pattern == "" {
+			return nil, errors.New("replaceRegexMatch pattern must be set")
+		}
+		if _, err := regexp.Compile(regexMatch.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid replaceRegexMatch pattern %s: %w", regexMatch.Pattern, err)
+		}
+		urlRewrite.Path = &ir.HTTPPathModifier{
+			RegexMatchReplace: &ir.RegexMatchReplace{
+				Pattern:      regexMatch.Pattern,
+				Substitution: regexMatch.Substitution,
+			},
+		}
+	}
+
+	if rewrite.Hostname != nil {
+		switch rewrite.Hostname.Type {
+		case egv1a1.HTTPHostnameRewriteTypeHeader:
+			if rewrite.Hostname.Header == nil || *rewrite.Hostname.Header == "" {
+				return nil, errors.New("header must be set when the hostname rewrite type is Header")
+			}
+			header := strings.ToLower(string(*rewrite.Hostname.Header))
+			urlRewrite.HostnameHeader = &header
+		case egv1a1.HTTPHostnameRewriteTypeBackend:
+			if rewrite.Hostname.Header != nil {
+				return nil, errors.New("header cannot be set when the hostname rewrite type is Backend")
+			}
+			urlRewrite.BackendHostname = true
+		default:
+			return nil, fmt.Errorf("hostname rewrite type %s is invalid, only Header and Backend are supported",
+				rewrite.Hostname.Type)
+		}
+	}
+
+	return urlRewrite, nil
+}
+
+// buildHeaderTemplates translates the templated headers of an HTTPRouteFilter
+// into the headers to add, which cannot duplicate the existing ones.
+func buildHeaderTemplates(modifier *egv1a1.HTTPHeaderTemplateFilter, existing []ir.AddHeader) ([]ir.AddHeader, error) {
+	if modifier == nil {
+		return nil, nil
+	}
+	if len(modifier.Set) == 0 && len(modifier.Add) == 0 {
+		return nil, errors.New("at least one of set and add must be set")
+	}
+
+	var headers []ir.AddHeader
+	isDuplicate := func(name string) bool {
+		for _, h := range existing {
+			if strings.EqualFold(h.Name, name) {
+				return true
+			}
+		}
+		for _, h := range headers {
+			if strings.EqualFold(h.Name, name) {
+				return true
+			}
+		}
+		return false
+	}
+	build := func(templates []egv1a1.HTTPHeaderTemplate, appendValue bool) error {
+		for _, template := range templates {
+			name := string(template.Name)
+			switch {
+			case name == "":
+				return errors.New("cannot set a header with an empty name")
+			// Per Gateway API specification on HTTPHeaderName, : and / are invalid characters in header names
+			case strings.ContainsAny(name, "/:"):
+				return fmt.Errorf("cannot set headers with a '/' or ':' character in them. Header: %q", name)
+			case isDuplicate(name):
+				return fmt.Errorf("header %q is already modified by the rule", name)
+			case !headerTemplateRegex.MatchString(template.Value):
+				return fmt.Errorf("header %q has an invalid value %q: '%%' must enclose an Envoy variable or be escaped as '%%%%'",
+					name, template.Value)
+			}
+			headers = append(headers, ir.AddHeader{
+				Name:   name,
+				Value:  template.Value,
+				Append: appendValue,
+			})
+		}
+		return nil
+	}
+
+	if err := build(modifier.Set, false); err != nil {
+		return nil, err
+	}
+	if err := build(modifier.Add, true); err != nil {
+		return nil, err
+	}
+
+	return headers, nil
+}
+
+// buildVirtualClusters translates the virtual clusters of an HTTPRouteFilter
+// into their IR, which cannot duplicate the virtual clusters of the rule.
+// The path matches are translated into regular expressions on the :path
+// header, which includes the query string of the requests.
+func buildVirtualClusters(virtualClusters []egv1a1.VirtualCluster, filterContext *HTTPFiltersContext) ([]*ir.VirtualCluster, error) {
+	var irVirtualClusters []*ir.VirtualCluster
+	isDuplicate := func(name string) bool {
+		for _, vc := range filterContext.VirtualClusters {
+			if vc.Name == name {
+				return true
+			}
+		}
+		for _, vc := range irVirtualClusters {
+			if vc.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, virtualCluster := range virtualClusters {
+		name := irVirtualClusterName(filterContext.Route, virtualCluster.Name)
+		switch {
+		case virtualCluster.Name == "":
+			return nil, errors.New("cannot configure a virtual cluster with an empty name")
+		case isDuplicate(name):
+			return nil, fmt.Errorf("virtual cluster %q is already configured in the rule", virtualCluster.Name)
+		case virtualCluster.Method == nil && virtualCluster.Path == nil:
+			return nil, fmt.Errorf("at least one of method and path must be set in virtual cluster %q", virtualCluster.Name)
+		}
+
+		irVirtualCluster := &ir.VirtualCluster{Name: name}
+		if virtualCluster.Path != nil {
+			pathRegex, err := buildVirtualClusterPathRegex(virtualCluster.Path)
+			if err != nil {
+				return nil, fmt.Errorf("virtual cluster %q: %w", virtualCluster.Name, err)
+			}
+			irVirtualCluster.HeaderMatches = append(irVirtualCluster.HeaderMatches, &ir.StringMatch{
+				Name:      ":path",
+				SafeRegex: StringPtr(pathRegex),
+			})
+		}
+		if virtualCluster.Method != nil {
+			irVirtualCluster.HeaderMatches = append(irVirtualCluster.HeaderMatches, &ir.StringMatch{
+				Name:  ":method",
+				Exact: StringPtr(string(*virtualCluster.Method)),
+			})
+		}
+		irVirtualClusters = append(irVirtualClusters, irVirtualCluster)
+	}
+
+	return irVirtualClusters, nil
+}
+
+// buildVirtualClusterPathRegex returns the regular expression matching the
+// :path header of the requests matched by the path match.
+func buildVirtualClusterPathRegex(path *v1beta1.HTTPPathMatch) (string, error) {
+	value := "/"
+	if path.Value != nil {
+		value = *path.Value
+	}
+
+	switch PathMatchTypeDerefOr(path.Type, v1beta1.PathMatchPathPrefix) {
+	case v1beta1.PathMatchExact:
+		return regexp.QuoteMeta(value) + `(\?.*)?`, nil
+	case v1beta1.PathMatchPathPrefix:
+		// The prefix matches the path elements, like the PathPrefix matches of the routes.
+		return regexp.QuoteMeta(strings.TrimSuffix(value, "/")) + `([/?].*)?`, nil
+	case v1beta1.PathMatchRegularExpression:
+		if _, err := regexp.Compile(value); err != nil {
+			return "", fmt.Errorf("invalid path regular expression %s: %w", value, err)
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("path match type %s is invalid", *path.Type)
+	}
+}
@@ -19,6 +19,16 @@ import (
 
 var _ ListenersTranslator = (*Translator)(nil)
 
+const (
+	defaultOpenTelemetryTracingPort = 4317
+	defaultZipkinTracingPort        = 9411
+	defaultDatadogTracingPort       = 8126
+	defaultZipkinCollectorEndpoint  = "/api/v2/spans"
+
+	defaultALSLogName                  = "envoy-gateway"
+	defaultAccessLogSamplingRuntimeKey = "access_log.sampling"
+)
+
 type ListenersTranslator interface {
 	ProcessListeners(gateways []*GatewayContext, xdsIR XdsIRMap, infraIR InfraIRMap, resources *Resources)
 }
@@ -104,11 +114,14 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR XdsIRMap
 			containerPort := servicePortToContainerPort(servicePort.port)
 			switch listener.Protocol {
 			case v1beta1.HTTPProtocolType, v1beta1.HTTPSProtocolType:
+				ipFamily := getEnvoyIPFamily(gwInfraIR.Proxy.Config)
 				irListener := &ir.HTTPListener{
-					Name:    irHTTPListenerName(listener),
-					Address: "0.0.0.0",
-					Port:    uint32(containerPort),
-					TLS:     irTLSConfigs(listener.tlsSecrets),
+					Name:       irHTTPListenerName(listener),
+					Address:    irListenerAddress(ipFamily),
+					Port:       uint32(containerPort),
+					IPFamily:   ipFamily,
+					TLS:        irTLSConfigs(listener.tlsSecrets),
+					StatPrefix: irHTTPListenerStatPrefix(listener),
 				}
 				if listener.Hostname != nil {
 					irListener.Hostnames = append(irListener.Hostnames, string(*listener.Hostname))
@@ -172,6 +185,7 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 	irAccessLog := &ir.AccessLog{}
 	// translate the access log configuration to the IR
 	for _, accessLog := range envoyproxy.Spec.Telemetry.AccessLog.Settings {
+		filter := processAccessLogFilter(accessLog.Filter)
 		for _, sink := range accessLog.Sinks {
 			switch sink.Type {
 			case configv1a1.ProxyAccessLogSinkTypeFile:
@@ -184,6 +198,7 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 					al := &ir.TextAccessLog{
 						Format: accessLog.Format.Text,
 						Path:   sink.File.Path,
+						Filter: filter,
 					}
 					irAccessLog.Text = append(irAccessLog.Text, al)
 				case configv1a1.ProxyAccessLogFormatTypeJSON:
@@ -193,8 +208,9 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 					}
 
 					al := &ir.JSONAccessLog{
-						JSON: accessLog.Format.JSON,
-						Path: sink.File.Path,
+						JSON:   accessLog.Format.JSON,
+						Path:   sink.File.Path,
+						Filter: filter,
 					}
 					irAccessLog.JSON = append(irAccessLog.JSON, al)
 				}
@@ -207,6 +223,7 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 					Port:      uint32(sink.OpenTelemetry.Port),
 					Host:      sink.OpenTelemetry.Host,
 					Resources: sink.OpenTelemetry.Resources,
+					Filter:    filter,
 				}
 
 				switch accessLog.Format.Type {
@@ -217,6 +234,33 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 				}
 
 				irAccessLog.OpenTelemetry = append(irAccessLog.OpenTelemetry, al)
+			case configv1a1.ProxyAccessLogSinkTypeALS:
+				if sink.ALS == nil {
+					continue
+				}
+
+				al := &ir.ALSAccessLog{
+					LogName: defaultALSLogName,
+					Host:    sink.ALS.Host,
+					Port:    uint32(sink.ALS.Port),
+					Type:    configv1a1.ALSEnvoyProxyAccessLogTypeHTTP,
+					Filter:  filter,
+				}
+				if sink.ALS.LogName != nil {
+					al.LogName = *sink.ALS.LogName
+				}
+				if sink.ALS.Type != nil {
+					al.Type = *sink.ALS.Type
+				}
+				if sink.ALS.HTTP != nil {
+					al.HTTP = &ir.ALSAccessLogHTTP{
+						RequestHeaders:   sink.ALS.HTTP.RequestHeaders,
+						ResponseHeaders:  sink.ALS.HTTP.ResponseHeaders,
+						ResponseTrailers: sink.ALS.HTTP.ResponseTrailers,
+					}
+				}
+
+				irAccessLog.ALS = append(irAccessLog.ALS, al)
 			}
 		}
 	}
@@ -224,13 +268,110 @@ func processAccessLog(envoyproxy *configv1a1.EnvoyProxy) *ir.AccessLog {
 	return irAccessLog
 }
 
+func processAccessLogFilter(filter *configv1a1.ProxyAccessLogFilter) *ir.AccessLogFilter {
+	if filter == nil {
+		return nil
+	}
+
+	irFilter := &ir.AccessLogFilter{
+		MinDuration:    filter.MinDuration,
+		ResponseFlags:  filter.ResponseFlags,
+		RequestHeaders: filter.RequestHeaders,
+		NotHealthCheck: filter.NotHealthCheck,
+	}
+	for _, codes := range filter.StatusCodes {
+		irFilter.StatusCodes = append(irFilter.StatusCodes, ir.StatusCodeRange{
+			Start: uint32(codes.Start),
+			End:   uint32(codes.End),
+		})
+	}
+	if filter.Sampling != nil {
+		irFilter.Sampling = &ir.AccessLogSampling{
+			Percent:    filter.Sampling.Percent,
+			RuntimeKey: defaultAccessLogSamplingRuntimeKey,
+		}
+		if filter.Sampling.RuntimeKey != nil {
+			irFilter.Sampling.RuntimeKey = *filter.Sampling.RuntimeKey
+		}
+	}
+
+	return irFilter
+}
+
 func processTracing(gw *v1beta1.Gateway, envoyproxy *configv1a1.EnvoyProxy) *ir.Tracing {
 	if envoyproxy == nil || envoyproxy.Spec.Telemetry.Tracing == nil {
 		return nil
 	}
 
-	return &ir.Tracing{
+	tracing := envoyproxy.Spec.Telemetry.Tracing
+	irTracing := &ir.Tracing{
 		ServiceName:  naming.ServiceName(types.NamespacedName{Name: gw.Name, Namespace: gw.Namespace}),
-		ProxyTracing: *envoyproxy.Spec.Telemetry.Tracing,
+		SamplingRate: 100,
+		CustomTags:   tracing.CustomTags,
+	}
+	if tracing.SamplingRate != nil {
+		irTracing.SamplingRate = *tracing.SamplingRate
+	}
+
+	provider := tracing.Provider
+	port := uint32(provider.Port)
+	switch provider.Type {
+	case configv1a1.TracingProviderTypeZipkin:
+		if port == 0 {
+			port = defaultZipkinTracingPort
+		}
+		irTracing.Zipkin = &ir.ZipkinTracing{
+			Host:              provider.Host,
+			Port:              port,
+			CollectorEndpoint: defaultZipkinCollectorEndpoint,
+			Encoding:          configv1a1.ZipkinEncodingJSON,
+		}
+		if zipkin := provider.Zipkin; zipkin != nil {
+			if zipkin.CollectorEndpoint != nil {
+				irTracing.Zipkin.CollectorEndpoint = *zipkin.CollectorEndpoint
+			}
+			if zipkin.Encoding != nil {
+				irTracing.Zipkin.Encoding = *zipkin.Encoding
+			}
+			if zipkin.Enable128BitTraceID != nil {
+				irTracing.Zipkin.Enable128BitTraceID = *zipkin.Enable128BitTraceID
+			}
+		}
+	case configv1a1.TracingProviderTypeDatadog:
+		if port == 0 {
+			port = defaultDatadogTracingPort
+		}
+		irTracing.Datadog = &ir.DatadogTracing{
+			Host: provider.Host,
+			Port: port,
+		}
+	default:
+		if port == 0 {
+			port = defaultOpenTelemetryTracingPort
+		}
+		irTracing.OpenTelemetry = &ir.OpenTelemetryTracing{
+			Host: provider.Host,
+			Port: port,
+		}
+	}
+
+	return irTracing
+}
+
+// getEnvoyIPFamily returns the IP family configured in the EnvoyProxy, or nil if unset.
+func getEnvoyIPFamily(envoyproxy *configv1a1.EnvoyProxy) *configv1a1.IPFamily {
+	if envoyproxy == nil {
+		return nil
+	}
+	return envoyproxy.Spec.IPFamily
+}
+
+// irListenerAddress returns the address the listeners of the IP family bind to.
+// Dual-stack listeners bind to the IPv6 wildcard address and also accept IPv4
+// connections.
+func irListenerAddress(ipFamily *configv1a1.IPFamily) string {
+	if ipFamily != nil && (*ipFamily == configv1a1.IPv6 || *ipFamily == configv1a1.DualStack) {
+		return "::"
 	}
+	return "0.0.0.0"
 }
@@ -17,6 +17,7 @@ import (
 )
 
 func TestProcessTracing(t *testing.T) {
+	samplingRate := uint32(50)
 	cases := []struct {
 		gw    v1beta1.Gateway
 		proxy *egcfgv1a1.EnvoyProxy
@@ -40,7 +41,69 @@ func TestProcessTracing(t *testing.T) {
 			},
 			expected: &ir.Tracing{
 				ServiceName:  "fake-gw.fake-ns",
-				ProxyTracing: egcfgv1a1.ProxyTracing{},
+				SamplingRate: 100,
+				OpenTelemetry: &ir.OpenTelemetryTracing{
+					Port: 4317,
+				},
+			},
+		},
+		{
+			gw: v1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-gw",
+					Namespace: "fake-ns",
+				},
+			},
+			proxy: &egcfgv1a1.EnvoyProxy{
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Tracing: &egcfgv1a1.ProxyTracing{
+							Provider: egcfgv1a1.TracingProvider{
+								Type: egcfgv1a1.TracingProviderTypeZipkin,
+								Host: "zipkin.monitoring.svc.cluster.local",
+							},
+						},
+					},
+				},
+			},
+			expected: &ir.Tracing{
+				ServiceName:  "fake-gw.fake-ns",
+				SamplingRate: 100,
+				Zipkin: &ir.ZipkinTracing{
+					Host:              "zipkin.monitoring.svc.cluster.local",
+					Port:              9411,
+					CollectorEndpoint: "/api/v2/spans",
+					Encoding:          egcfgv1a1.ZipkinEncodingJSON,
+				},
+			},
+		},
+		{
+			gw: v1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-gw",
+					Namespace: "fake-ns",
+				},
+			},
+			proxy: &egcfgv1a1.EnvoyProxy{
+				Spec: egcfgv1a1.EnvoyProxySpec{
+					Telemetry: egcfgv1a1.ProxyTelemetry{
+						Tracing: &egcfgv1a1.ProxyTracing{
+							SamplingRate: &samplingRate,
+							Provider: egcfgv1a1.TracingProvider{
+								Type: egcfgv1a1.TracingProviderTypeDatadog,
+								Host: "datadog-agent.monitoring.svc.cluster.local",
+							},
+						},
+					},
+				},
+			},
+			expected: &ir.Tracing{
+				ServiceName:  "fake-gw.fake-ns",
+				SamplingRate: 50,
+				Datadog: &ir.DatadogTracing{
+					Host: "datadog-agent.monitoring.svc.cluster.local",
+					Port: 8126,
+				},
 			},
 		},
 	}
@@ -0,0 +1,308 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+)
+
+// policyObject is a policy attached to a Gateway, one of its Listeners or a route.
+type policyObject[P any] interface {
+	metav1.Object
+	DeepCopy() P
+}
+
+// policyTarget is the resource a policy is attached to.
+type policyTarget struct {
+	// gateway is the Gateway targeted by the policy, as a whole or through
+	// one of its Listeners, if any.
+	gateway *GatewayContext
+	// listeners are the Listeners of the Gateway the policy applies to: the
+	// targeted Listener, or the Listeners without a more specific policy.
+	listeners []*ListenerContext
+	// route is the route targeted by the policy, if any.
+	route RouteContext
+}
+
+// policyTargetResolver attaches the policies of a kind to the resources they target.
+// The policies targeting a Listener take precedence over the ones targeting the whole
+// Gateway, and the policies targeting a route are attached last, so they replace the
+// ones of the Gateway on the requests of the route. When several policies target the
+// same resource, the oldest one is attached and the others are marked as Conflicted.
+type policyTargetResolver[P policyObject[P]] struct {
+	// kind is the kind of the policies.
+	kind string
+	// routeKinds are the kinds of the routes the policies can target.
+	routeKinds []string
+	// targetRef returns the target reference of a policy.
+	targetRef func(policy P) *egv1a1.PolicyTargetReferenceWithSectionName
+	// setCondition sets a condition in the status of a policy.
+	setCondition func(policy P, conditionType gwv1a2.PolicyConditionType, status metav1.ConditionStatus,
+		reason gwv1a2.PolicyConditionReason, message string)
+	// validate, if set, ensures the settings of a policy are consistent with the
+	// kind of resource it targets, before its target is resolved.
+	validate func(policy P) error
+}
+
+// attach attaches the policies to the resources they target using the provided
+// function, and returns the policies with their status set. The policies are
+// invalid when the function returns an error.
+func (r *policyTargetResolver[P]) attach(policies []P, gateways []*GatewayContext, routes []RouteContext,
+	apply func(policy P, target *policyTarget) error) []P {
+	// Sort based on timestamp, so that the oldest policy wins
+	// when several policies target the same resource.
+	sort.Slice(policies, func(i, j int) bool {
+		ti, tj := policies[i].GetCreationTimestamp(), policies[j].GetCreationTimestamp()
+		if ti.Equal(&tj) {
+			return policies[i].GetNamespace()+"/"+policies[i].GetName() <
+				policies[j].GetNamespace()+"/"+policies[j].GetName()
+		}
+		return ti.Before(&tj)
+	})
+
+	var listenerPolicies, gatewayPolicies, routePolicies []P
+	for _, policy := range policies {
+		targetRef := r.targetRef(policy)
+		switch {
+		case targetRef.Kind == KindGateway && targetRef.SectionName != nil:
+			listenerPolicies = append(listenerPolicies, policy)
+		case targetRef.Kind == KindGateway:
+			gatewayPolicies = append(gatewayPolicies, policy)
+		default:
+			routePolicies = append(routePolicies, policy)
+		}
+	}
+
+	var res []P
+
+	// Listeners that a policy has already been attached to using a sectionName.
+	listenersWithPolicy := make(map[*ListenerContext]bool)
+	for _, currPolicy := range listenerPolicies {
+		policy := currPolicy.DeepCopy()
+		res = append(res, policy)
+
+		gateway, _ := r.resolveTargetRef(policy, gateways, routes)
+		if gateway == nil {
+			continue
+		}
+
+		sectionName := *r.targetRef(policy).SectionName
+		var listener *ListenerContext
+		for _, l := range gateway.listeners {
+			if l.Name == sectionName {
+				listener = l
+				break
+			}
+		}
+		if listener == nil {
+			message := fmt.Sprintf("No section name %s found for Gateway %s/%s.",
+				sectionName, gateway.Namespace, gateway.Name)
+
+			r.setCondition(policy,
+				gwv1a2.PolicyConditionAccepted,
+				metav1.ConditionFalse,
+				gwv1a2.PolicyReasonTargetNotFound,
+				message,
+			)
+			continue
+		}
+
+		if listenersWithPolicy[listener] {
+			r.setConflicted(policy, fmt.Sprintf("Listener %s of Gateway %s/%s", listener.Name, gateway.Namespace, gateway.Name))
+			continue
+		}
+		listenersWithPolicy[listener] = true
+
+		r.apply(policy, &policyTarget{gateway: gateway, listeners: []*ListenerContext{listener}}, apply)
+	}
+
+	// Gateways that a policy has already been attached to as a whole.
+	gatewaysWithPolicy := make(map[types.NamespacedName]bool)
+	for _, currPolicy := range gatewayPolicies {
+		policy := currPolicy.DeepCopy()
+		res = append(res, policy)
+
+		gateway, _ := r.resolveTargetRef(policy, gateways, routes)
+		if gateway == nil {
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}
+		if gatewaysWithPolicy[key] {
+			r.setConflicted(policy, fmt.Sprintf("Gateway %s/%s", gateway.Namespace, gateway.Name))
+			continue
+		}
+		gatewaysWithPolicy[key] = true
+
+		// Only apply the policy to the Listeners that do not have
+		// a more specific policy attached to them.
+		var listeners []*ListenerContext
+		for _, listener := range gateway.listeners {
+			if !listenersWithPolicy[listener] {
+				listeners = append(listeners, listener)
+			}
+		}
+		r.apply(policy, &policyTarget{gateway: gateway, listeners: listeners}, apply)
+	}
+
+	// Routes that a policy has already been attached to, keyed by kind/namespace/name.
+	routesWithPolicy := make(map[string]bool)
+	for _, currPolicy := range routePolicies {
+		policy := currPolicy.DeepCopy()
+		res = append(res, policy)
+
+		_, route := r.resolveTargetRef(policy, gateways, routes)
+		if route == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", GetRouteType(route), route.GetNamespace(), route.GetName())
+		if routesWithPolicy[key] {
+			r.setConflicted(policy, fmt.Sprintf("%s %s/%s", GetRouteType(route), route.GetNamespace(), route.GetName()))
+			continue
+		}
+		routesWithPolicy[key] = true
+
+		r.apply(policy, &policyTarget{route: route}, apply)
+	}
+
+	return res
+}
+
+// resolveTargetRef returns the Gateway or route targeted by the policy, or nil for
+// both after setting the policy status if the target is invalid or cannot be found.
+func (r *policyTargetResolver[P]) resolveTargetRef(policy P, gateways []*GatewayContext,
+	routes []RouteContext) (*GatewayContext, RouteContext) {
+	targetRef := r.targetRef(policy)
+	targetNs := targetRef.Namespace
+	// If empty, default to namespace of policy
+	if targetNs == nil {
+		targetNs = NamespacePtr(policy.GetNamespace())
+	}
+
+	// Ensure policy can only target a Gateway or one of the supported routes
+	kind := string(targetRef.Kind)
+	kinds := append([]string{KindGateway}, r.routeKinds...)
+	if targetRef.Group != gwv1b1.GroupName || !slices.Contains(kinds, kind) {
+		message := fmt.Sprintf("TargetRef.Group:%s TargetRef.Kind:%s, only TargetRef.Group:%s and TargetRef.Kind:%s is supported.",
+			targetRef.Group, kind, gwv1b1.GroupName, joinKinds(kinds))
+
+		r.setCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonInvalid,
+			message,
+		)
+		return nil, nil
+	}
+
+	// Ensure Policy and target resource are in the same namespace
+	if policy.GetNamespace() != string(*targetNs) {
+		message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, %s can only target a resource in the same namespace.",
+			policy.GetNamespace(), *targetNs, r.kind)
+
+		r.setCondition(policy,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			gwv1a2.PolicyReasonInvalid,
+			message,
+		)
+		return nil, nil
+	}
+
+	if kind != KindGateway && targetRef.SectionName != nil {
+		r.setInvalid(policy, fmt.Errorf("TargetRef.SectionName is not supported when targeting a %s", kind))
+		return nil, nil
+	}
+
+	if r.validate != nil {
+		if err := r.validate(policy); err != nil {
+			r.setInvalid(policy, err)
+			return nil, nil
+		}
+	}
+
+	if kind == KindGateway {
+		for _, gateway := range gateways {
+			if gateway.Namespace == string(*targetNs) && gateway.Name == string(targetRef.Name) {
+				return gateway, nil
+			}
+		}
+	} else {
+		for _, route := range routes {
+			if string(GetRouteType(route)) == kind &&
+				route.GetNamespace() == string(*targetNs) && route.GetName() == string(targetRef.Name) {
+				return nil, route
+			}
+		}
+	}
+
+	message := fmt.Sprintf("%s:%s not found.", kind, targetRef.Name)
+
+	r.setCondition(policy,
+		gwv1a2.PolicyConditionAccepted,
+		metav1.ConditionFalse,
+		gwv1a2.PolicyReasonTargetNotFound,
+		message,
+	)
+	return nil, nil
+}
+
+// apply attaches the policy to its target using the provided function,
+// and sets the policy status accordingly.
+func (r *policyTargetResolver[P]) apply(policy P, target *policyTarget, apply func(policy P, target *policyTarget) error) {
+	if err := apply(policy, target); err != nil {
+		r.setInvalid(policy, err)
+		return
+	}
+
+	r.setCondition(policy,
+		gwv1a2.PolicyConditionAccepted,
+		metav1.ConditionTrue,
+		gwv1a2.PolicyReasonAccepted,
+		fmt.Sprintf("%s has been accepted.", r.kind),
+	)
+}
+
+func (r *policyTargetResolver[P]) setInvalid(policy P, err error) {
+	r.setCondition(policy,
+		gwv1a2.PolicyConditionAccepted,
+		metav1.ConditionFalse,
+		gwv1a2.PolicyReasonInvalid,
+		err.Error(),
+	)
+}
+
+// setConflicted marks the policy as conflicted, since another policy
+// has already been attached to the described target.
+func (r *policyTargetResolver[P]) setConflicted(policy P, target string) {
+	message := fmt.Sprintf("Unable to target %s, another %s has already attached to it.", target, r.kind)
+
+	r.setCondition(policy,
+		gwv1a2.PolicyConditionAccepted,
+		metav1.ConditionFalse,
+		gwv1a2.PolicyReasonConflicted,
+		message,
+	)
+}
+
+// joinKinds returns the kinds separated by commas, the last one by "or".
+func joinKinds(kinds []string) string {
+	if len(kinds) == 1 {
+		return kinds[0]
+	}
+	return strings.Join(kinds[:len(kinds)-1], ", ") + " or " + kinds[len(kinds)-1]
+}
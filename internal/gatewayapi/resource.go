@@ -27,41 +27,65 @@ type InfraIRMap map[string]*ir.Infra
 type Resources struct {
 	// This field is only used for marshalling/unmarshalling purposes and is not used by
 	// the translator
-	GatewayClass          *v1beta1.GatewayClass          `json:"gatewayClass,omitempty" yaml:"gatewayClass,omitempty"`
-	Gateways              []*v1beta1.Gateway             `json:"gateways,omitempty" yaml:"gateways,omitempty"`
-	HTTPRoutes            []*v1beta1.HTTPRoute           `json:"httpRoutes,omitempty" yaml:"httpRoutes,omitempty"`
-	GRPCRoutes            []*v1alpha2.GRPCRoute          `json:"grpcRoutes,omitempty" yaml:"grpcRoutes,omitempty"`
-	TLSRoutes             []*v1alpha2.TLSRoute           `json:"tlsRoutes,omitempty" yaml:"tlsRoutes,omitempty"`
-	TCPRoutes             []*v1alpha2.TCPRoute           `json:"tcpRoutes,omitempty" yaml:"tcpRoutes,omitempty"`
-	UDPRoutes             []*v1alpha2.UDPRoute           `json:"udpRoutes,omitempty" yaml:"udpRoutes,omitempty"`
-	ReferenceGrants       []*v1alpha2.ReferenceGrant     `json:"referenceGrants,omitempty" yaml:"referenceGrants,omitempty"`
-	Namespaces            []*v1.Namespace                `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
-	Services              []*v1.Service                  `json:"services,omitempty" yaml:"services,omitempty"`
-	ServiceImports        []*mcsapi.ServiceImport        `json:"serviceImports,omitempty" yaml:"serviceImports,omitempty"`
-	EndpointSlices        []*discoveryv1.EndpointSlice   `json:"endpointSlices,omitempty" yaml:"endpointSlices,omitempty"`
-	Secrets               []*v1.Secret                   `json:"secrets,omitempty" yaml:"secrets,omitempty"`
-	AuthenticationFilters []*egv1a1.AuthenticationFilter `json:"authenticationFilters,omitempty" yaml:"authenticationFilters,omitempty"`
-	RateLimitFilters      []*egv1a1.RateLimitFilter      `json:"rateLimitFilters,omitempty" yaml:"rateLimitFilters,omitempty"`
-	EnvoyProxy            *egcfgv1a1.EnvoyProxy          `json:"envoyProxy,omitempty" yaml:"envoyProxy,omitempty"`
-	ExtensionRefFilters   []unstructured.Unstructured    `json:"extensionRefFilters,omitempty" yaml:"extensionRefFilters,omitempty"`
-	EnvoyPatchPolicies    []*egv1a1.EnvoyPatchPolicy     `json:"envoyPatchPolicies,omitempty" yaml:"envoyPatchPolicies,omitempty"`
+	GatewayClass           *v1beta1.GatewayClass           `json:"gatewayClass,omitempty" yaml:"gatewayClass,omitempty"`
+	Gateways               []*v1beta1.Gateway              `json:"gateways,omitempty" yaml:"gateways,omitempty"`
+	HTTPRoutes             []*v1beta1.HTTPRoute            `json:"httpRoutes,omitempty" yaml:"httpRoutes,omitempty"`
+	GRPCRoutes             []*v1alpha2.GRPCRoute           `json:"grpcRoutes,omitempty" yaml:"grpcRoutes,omitempty"`
+	TLSRoutes              []*v1alpha2.TLSRoute            `json:"tlsRoutes,omitempty" yaml:"tlsRoutes,omitempty"`
+	TCPRoutes              []*v1alpha2.TCPRoute            `json:"tcpRoutes,omitempty" yaml:"tcpRoutes,omitempty"`
+	UDPRoutes              []*v1alpha2.UDPRoute            `json:"udpRoutes,omitempty" yaml:"udpRoutes,omitempty"`
+	ReferenceGrants        []*v1alpha2.ReferenceGrant      `json:"referenceGrants,omitempty" yaml:"referenceGrants,omitempty"`
+	Namespaces             []*v1.Namespace                 `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	Services               []*v1.Service                   `json:"services,omitempty" yaml:"services,omitempty"`
+	ServiceImports         []*mcsapi.ServiceImport         `json:"serviceImports,omitempty" yaml:"serviceImports,omitempty"`
+	Backends               []*egv1a1.Backend               `json:"backends,omitempty" yaml:"backends,omitempty"`
+	EndpointSlices         []*discoveryv1.EndpointSlice    `json:"endpointSlices,omitempty" yaml:"endpointSlices,omitempty"`
+	Secrets                []*v1.Secret                    `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+	ConfigMaps             []*v1.ConfigMap                 `json:"configMaps,omitempty" yaml:"configMaps,omitempty"`
+	AuthenticationFilters  []*egv1a1.AuthenticationFilter  `json:"authenticationFilters,omitempty" yaml:"authenticationFilters,omitempty"`
+	RateLimitFilters       []*egv1a1.RateLimitFilter       `json:"rateLimitFilters,omitempty" yaml:"rateLimitFilters,omitempty"`
+	FaultInjectionFilters  []*egv1a1.FaultInjectionFilter  `json:"faultInjectionFilters,omitempty" yaml:"faultInjectionFilters,omitempty"`
+	GRPCTranscodingFilters []*egv1a1.GRPCTranscodingFilter `json:"grpcTranscodingFilters,omitempty" yaml:"grpcTranscodingFilters,omitempty"`
+	UpgradeFilters         []*egv1a1.UpgradeFilter         `json:"upgradeFilters,omitempty" yaml:"upgradeFilters,omitempty"`
+	HTTPRouteFilters       []*egv1a1.HTTPRouteFilter       `json:"httpRouteFilters,omitempty" yaml:"httpRouteFilters,omitempty"`
+	EnvoyProxy             *egcfgv1a1.EnvoyProxy           `json:"envoyProxy,omitempty" yaml:"envoyProxy,omitempty"`
+	ExtensionRefFilters    []unstructured.Unstructured     `json:"extensionRefFilters,omitempty" yaml:"extensionRefFilters,omitempty"`
+	EnvoyPatchPolicies     []*egv1a1.EnvoyPatchPolicy      `json:"envoyPatchPolicies,omitempty" yaml:"envoyPatchPolicies,omitempty"`
+	ClientTrafficPolicies  []*egv1a1.ClientTrafficPolicy   `json:"clientTrafficPolicies,omitempty" yaml:"clientTrafficPolicies,omitempty"`
+	BackendTLSPolicies     []*egv1a1.BackendTLSPolicy      `json:"backendTLSPolicies,omitempty" yaml:"backendTLSPolicies,omitempty"`
+	CompressionPolicies    []*egv1a1.CompressionPolicy     `json:"compressionPolicies,omitempty" yaml:"compressionPolicies,omitempty"`
+	EnvoyExtensionPolicies []*egv1a1.EnvoyExtensionPolicy  `json:"envoyExtensionPolicies,omitempty" yaml:"envoyExtensionPolicies,omitempty"`
+	TracingPolicies        []*egv1a1.TracingPolicy         `json:"tracingPolicies,omitempty" yaml:"tracingPolicies,omitempty"`
+	AccessLoggingPolicies  []*egv1a1.AccessLoggingPolicy   `json:"accessLoggingPolicies,omitempty" yaml:"accessLoggingPolicies,omitempty"`
 }
 
 func NewResources() *Resources {
 	return &Resources{
-		Gateways:              []*v1beta1.Gateway{},
-		HTTPRoutes:            []*v1beta1.HTTPRoute{},
-		GRPCRoutes:            []*v1alpha2.GRPCRoute{},
-		TLSRoutes:             []*v1alpha2.TLSRoute{},
-		Services:              []*v1.Service{},
-		EndpointSlices:        []*discoveryv1.EndpointSlice{},
-		Secrets:               []*v1.Secret{},
-		ReferenceGrants:       []*v1alpha2.ReferenceGrant{},
-		Namespaces:            []*v1.Namespace{},
-		RateLimitFilters:      []*egv1a1.RateLimitFilter{},
-		AuthenticationFilters: []*egv1a1.AuthenticationFilter{},
-		ExtensionRefFilters:   []unstructured.Unstructured{},
-		EnvoyPatchPolicies:    []*egv1a1.EnvoyPatchPolicy{},
+		Gateways:               []*v1beta1.Gateway{},
+		HTTPRoutes:             []*v1beta1.HTTPRoute{},
+		GRPCRoutes:             []*v1alpha2.GRPCRoute{},
+		TLSRoutes:              []*v1alpha2.TLSRoute{},
+		Services:               []*v1.Service{},
+		Backends:               []*egv1a1.Backend{},
+		EndpointSlices:         []*discoveryv1.EndpointSlice{},
+		Secrets:                []*v1.Secret{},
+		ConfigMaps:             []*v1.ConfigMap{},
+		ReferenceGrants:        []*v1alpha2.ReferenceGrant{},
+		Namespaces:             []*v1.Namespace{},
+		RateLimitFilters:       []*egv1a1.RateLimitFilter{},
+		AuthenticationFilters:  []*egv1a1.AuthenticationFilter{},
+		FaultInjectionFilters:  []*egv1a1.FaultInjectionFilter{},
+		GRPCTranscodingFilters: []*egv1a1.GRPCTranscodingFilter{},
+		UpgradeFilters:         []*egv1a1.UpgradeFilter{},
+		HTTPRouteFilters:       []*egv1a1.HTTPRouteFilter{},
+		ExtensionRefFilters:    []unstructured.Unstructured{},
+		EnvoyPatchPolicies:     []*egv1a1.EnvoyPatchPolicy{},
+		ClientTrafficPolicies:  []*egv1a1.ClientTrafficPolicy{},
+		BackendTLSPolicies:     []*egv1a1.BackendTLSPolicy{},
+		CompressionPolicies:    []*egv1a1.CompressionPolicy{},
+		EnvoyExtensionPolicies: []*egv1a1.EnvoyExtensionPolicy{},
+		TracingPolicies:        []*egv1a1.TracingPolicy{},
+		AccessLoggingPolicies:  []*egv1a1.AccessLoggingPolicy{},
 	}
 }
 
@@ -95,6 +119,16 @@ func (r *Resources) GetServiceImport(namespace, name string) *mcsapi.ServiceImpo
 	return nil
 }
 
+func (r *Resources) GetBackend(namespace, name string) *egv1a1.Backend {
+	for _, backend := range r.Backends {
+		if backend.Namespace == namespace && backend.Name == name {
+			return backend
+		}
+	}
+
+	return nil
+}
+
 func (r *Resources) GetSecret(namespace, name string) *v1.Secret {
 	for _, secret := range r.Secrets {
 		if secret.Namespace == namespace && secret.Name == name {
@@ -104,3 +138,13 @@ func (r *Resources) GetSecret(namespace, name string) *v1.Secret {
 
 	return nil
 }
+
+func (r *Resources) GetConfigMap(namespace, name string) *v1.ConfigMap {
+	for _, configMap := range r.ConfigMaps {
+		if configMap.Namespace == namespace && configMap.Name == name {
+			return configMap
+		}
+	}
+
+	return nil
+}
@@ -7,12 +7,18 @@ package gatewayapi
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
+	"golang.org/x/exp/slices"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
@@ -144,8 +150,15 @@ func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRe
 		// a unique Xds IR HTTPRoute per match.
 		var ruleRoutes = t.processHTTPRouteRule(httpRoute, ruleIdx, httpFiltersContext, rule)
 
+		// The backends of a rule share a single cluster, and so
+		// a single upstream TLS configuration.
+		destTLS := &destinationTLS{}
 		for _, backendRef := range rule.BackendRefs {
 			endpoints, backendWeight := t.processDestEndpoints(backendRef.BackendRef, parentRef, httpRoute, resources)
+			dns := getBackendDNSSettings(backendRef.BackendObjectReference, httpRoute.GetNamespace(), resources)
+			if len(endpoints) > 0 && !t.processBackendTLS(backendRef.BackendObjectReference, destTLS, parentRef, httpRoute, resources) {
+				endpoints = nil
+			}
 			for _, route := range ruleRoutes {
 				// If the route already has a direct response or redirect configured, then it was from a filter so skip
 				// processing any destinations for this route.
@@ -153,9 +166,14 @@ func (t *Translator) processHTTPRouteRules(httpRoute *HTTPRouteContext, parentRe
 					if len(endpoints) > 0 {
 						if route.Destination == nil {
 							route.Destination = &ir.RouteDestination{
-								Name: irRouteDestinationName(httpRoute, ruleIdx),
+								Name:     irRouteDestinationName(httpRoute, ruleIdx),
+								TLS:      destTLS.config,
+								StatName: irRouteDestinationStatName(httpRoute, ruleIdx),
 							}
 						}
+						if route.Destination.DNS == nil {
+							route.Destination.DNS = dns
+						}
 						route.Destination.Endpoints = append(route.Destination.Endpoints, endpoints...)
 						route.BackendWeights.Valid += backendWeight
 
@@ -286,8 +304,8 @@ func applyHTTPFiltersContextToIRRoute(httpFiltersContext *HTTPFiltersContext, ir
 	if len(httpFiltersContext.RemoveResponseHeaders) > 0 {
 		irRoute.RemoveResponseHeaders = httpFiltersContext.RemoveResponseHeaders
 	}
-	if httpFiltersContext.Mirror != nil {
-		irRoute.Mirror = httpFiltersContext.Mirror
+	if len(httpFiltersContext.Mirrors) > 0 {
+		irRoute.Mirrors = httpFiltersContext.Mirrors
 	}
 	if httpFiltersContext.RequestAuthentication != nil {
 		irRoute.RequestAuthentication = httpFiltersContext.RequestAuthentication
@@ -295,6 +313,18 @@ func applyHTTPFiltersContextToIRRoute(httpFiltersContext *HTTPFiltersContext, ir
 	if httpFiltersContext.RateLimit != nil {
 		irRoute.RateLimit = httpFiltersContext.RateLimit
 	}
+	if httpFiltersContext.FaultInjection != nil {
+		irRoute.FaultInjection = httpFiltersContext.FaultInjection
+	}
+	if httpFiltersContext.GRPCTranscoding != nil {
+		irRoute.GRPCTranscoding = httpFiltersContext.GRPCTranscoding
+	}
+	if httpFiltersContext.Upgrades != nil {
+		irRoute.Upgrades = httpFiltersContext.Upgrades
+	}
+	if len(httpFiltersContext.VirtualClusters) > 0 {
+		irRoute.VirtualClusters = httpFiltersContext.VirtualClusters
+	}
 	if len(httpFiltersContext.ExtensionRefs) > 0 {
 		irRoute.ExtensionRefs = httpFiltersContext.ExtensionRefs
 	}
@@ -351,15 +381,22 @@ func (t *Translator) processGRPCRouteRules(grpcRoute *GRPCRouteContext, parentRe
 
 	// compute matches, filters, backends
 	for ruleIdx, rule := range grpcRoute.Spec.Rules {
-		httpFiltersContext := t.ProcessGRPCFilters(parentRef, grpcRoute, rule.Filters, resources)
+		httpFiltersContext := t.ProcessGRPCFilters(parentRef, grpcRoute, rule.Filters, ruleIdx, resources)
 
 		// A rule is matched if any one of its matches
 		// is satisfied (i.e. a logical "OR"), so generate
 		// a unique Xds IR HTTPRoute per match.
 		var ruleRoutes = t.processGRPCRouteRule(grpcRoute, ruleIdx, httpFiltersContext, rule)
 
+		// The backends of a rule share a single cluster, and so
+		// a single upstream TLS configuration.
+		destTLS := &destinationTLS{}
 		for _, backendRef := range rule.BackendRefs {
 			endpoints, backendWeight := t.processDestEndpoints(backendRef.BackendRef, parentRef, grpcRoute, resources)
+			dns := getBackendDNSSettings(backendRef.BackendObjectReference, grpcRoute.GetNamespace(), resources)
+			if len(endpoints) > 0 && !t.processBackendTLS(backendRef.BackendObjectReference, destTLS, parentRef, grpcRoute, resources) {
+				endpoints = nil
+			}
 			for _, route := range ruleRoutes {
 				// If the route already has a direct response or redirect configured, then it was from a filter so skip
 				// processing any destinations for this route.
@@ -367,9 +404,14 @@ func (t *Translator) processGRPCRouteRules(grpcRoute *GRPCRouteContext, parentRe
 					if len(endpoints) > 0 {
 						if route.Destination == nil {
 							route.Destination = &ir.RouteDestination{
-								Name: irRouteDestinationName(grpcRoute, ruleIdx),
+								Name:     irRouteDestinationName(grpcRoute, ruleIdx),
+								TLS:      destTLS.config,
+								StatName: irRouteDestinationStatName(grpcRoute, ruleIdx),
 							}
 						}
+						if route.Destination.DNS == nil {
+							route.Destination.DNS = dns
+						}
 						route.Destination.Endpoints = append(route.Destination.Endpoints, endpoints...)
 						route.BackendWeights.Valid += backendWeight
 
@@ -533,9 +575,13 @@ func (t *Translator) processHTTPRouteParentRefListener(route RouteContext, route
 					Redirect:              routeRoute.Redirect,
 					DirectResponse:        routeRoute.DirectResponse,
 					URLRewrite:            routeRoute.URLRewrite,
-					Mirror:                routeRoute.Mirror,
+					Mirrors:               routeRoute.Mirrors,
 					RequestAuthentication: routeRoute.RequestAuthentication,
 					RateLimit:             routeRoute.RateLimit,
+					FaultInjection:        routeRoute.FaultInjection,
+					GRPCTranscoding:       routeRoute.GRPCTranscoding,
+					Upgrades:              routeRoute.Upgrades,
+					VirtualClusters:       routeRoute.VirtualClusters,
 					ExtensionRefs:         routeRoute.ExtensionRefs,
 				}
 				// Don't bother copying over the weights unless the route has invalid backends.
@@ -552,6 +598,9 @@ func (t *Translator) processHTTPRouteParentRefListener(route RouteContext, route
 			if GetRouteType(route) == KindGRPCRoute {
 				irListener.IsHTTP2 = true
 			}
+			if irListener.LocalReply != nil {
+				setDirectResponseBodies(irListener.LocalReply, perHostRoutes)
+			}
 			irListener.Routes = append(irListener.Routes, perHostRoutes...)
 		}
 		// Theoretically there should only be one parent ref per
@@ -600,6 +649,7 @@ func (t *Translator) processTLSRouteParentRefs(tlsRoute *TLSRouteContext, resour
 		// any conditions that come out of it have to go on each RouteParentStatus,
 		// not on the Route as a whole.
 		var destEndpoints []*ir.DestinationEndpoint
+		var destDNS *ir.DNSSettings
 
 		// compute backends
 		for _, rule := range tlsRoute.Spec.Rules {
@@ -607,6 +657,9 @@ func (t *Translator) processTLSRouteParentRefs(tlsRoute *TLSRouteContext, resour
 				backendRef := backendRef
 				endpoints, _ := t.processDestEndpoints(backendRef, parentRef, tlsRoute, resources)
 				destEndpoints = append(destEndpoints, endpoints...)
+				if len(endpoints) > 0 && destDNS == nil {
+					destDNS = getBackendDNSSettings(backendRef.BackendObjectReference, tlsRoute.GetNamespace(), resources)
+				}
 			}
 
 			// TODO handle:
@@ -644,16 +697,20 @@ func (t *Translator) processTLSRouteParentRefs(tlsRoute *TLSRouteContext, resour
 			containerPort := servicePortToContainerPort(int32(listener.Port))
 			// Create the TCP Listener while parsing the TLSRoute since
 			// the listener directly links to a routeDestination.
+			ipFamily := getEnvoyIPFamily(resources.EnvoyProxy)
 			irListener := &ir.TCPListener{
-				Name:    irTLSListenerName(listener, tlsRoute),
-				Address: "0.0.0.0",
-				Port:    uint32(containerPort),
+				Name:     irTLSListenerName(listener, tlsRoute),
+				Address:  irListenerAddress(ipFamily),
+				Port:     uint32(containerPort),
+				IPFamily: ipFamily,
 				TLS: &ir.TLS{Passthrough: &ir.TLSInspectorConfig{
 					SNIs: hosts,
 				}},
 				Destination: &ir.RouteDestination{
 					Name:      irRouteDestinationName(tlsRoute, -1 /*rule index*/),
 					Endpoints: destEndpoints,
+					DNS:       destDNS,
+					StatName:  irRouteDestinationStatName(tlsRoute, -1 /*rule index*/),
 				},
 			}
 			gwXdsIR := xdsIR[irKey]
@@ -782,13 +839,17 @@ func (t *Translator) processUDPRouteParentRefs(udpRoute *UDPRouteContext, resour
 			containerPort := servicePortToContainerPort(int32(listener.Port))
 			// Create the UDP Listener while parsing the UDPRoute since
 			// the listener directly links to a routeDestination.
+			ipFamily := getEnvoyIPFamily(resources.EnvoyProxy)
 			irListener := &ir.UDPListener{
-				Name:    irUDPListenerName(listener, udpRoute),
-				Address: "0.0.0.0",
-				Port:    uint32(containerPort),
+				Name:     irUDPListenerName(listener, udpRoute),
+				Address:  irListenerAddress(ipFamily),
+				Port:     uint32(containerPort),
+				IPFamily: ipFamily,
 				Destination: &ir.RouteDestination{
 					Name:      irRouteDestinationName(udpRoute, -1 /*rule index*/),
 					Endpoints: destEndpoints,
+					DNS:       getBackendDNSSettings(backendRef.BackendObjectReference, udpRoute.GetNamespace(), resources),
+					StatName:  irRouteDestinationStatName(udpRoute, -1 /*rule index*/),
 				},
 			}
 			gwXdsIR := xdsIR[irKey]
@@ -917,13 +978,17 @@ func (t *Translator) processTCPRouteParentRefs(tcpRoute *TCPRouteContext, resour
 			containerPort := servicePortToContainerPort(int32(listener.Port))
 			// Create the TCP Listener while parsing the TCPRoute since
 			// the listener directly links to a routeDestination.
+			ipFamily := getEnvoyIPFamily(resources.EnvoyProxy)
 			irListener := &ir.TCPListener{
-				Name:    irTCPListenerName(listener, tcpRoute),
-				Address: "0.0.0.0",
-				Port:    uint32(containerPort),
+				Name:     irTCPListenerName(listener, tcpRoute),
+				Address:  irListenerAddress(ipFamily),
+				Port:     uint32(containerPort),
+				IPFamily: ipFamily,
 				Destination: &ir.RouteDestination{
 					Name:      irRouteDestinationName(tcpRoute, -1 /*rule index*/),
 					Endpoints: destEndpoints,
+					DNS:       getBackendDNSSettings(backendRef.BackendObjectReference, tcpRoute.GetNamespace(), resources),
+					StatName:  irRouteDestinationStatName(tcpRoute, -1 /*rule index*/),
 				},
 				TLS: &ir.TLS{Terminate: irTLSConfigs(listener.tlsSecrets)},
 			}
@@ -980,25 +1045,60 @@ func (t *Translator) processDestEndpoints(backendRef v1beta1.BackendRef,
 		return nil, weight
 	}
 
-	var backendIps []string
+	var backendAddrs []*ir.DestinationEndpoint
 	switch KindDerefOr(backendRef.Kind, KindService) {
 	case KindServiceImport:
-		backendIps = resources.GetServiceImport(backendNamespace, string(backendRef.Name)).Spec.IPs
+		ips := resources.GetServiceImport(backendNamespace, string(backendRef.Name)).Spec.IPs
+		// Fall back to all the IPs if none of them belongs to the configured IP family
+		if ipFamily := getEnvoyIPFamily(resources.EnvoyProxy); ipFamily != nil {
+			if filtered := filterIPFamilyAddresses(ips, ipFamily); len(filtered) != 0 {
+				ips = filtered
+			}
+		}
+		for _, ip := range ips {
+			backendAddrs = append(backendAddrs, ir.NewDestEndpoint(ip, uint32(*backendRef.Port)))
+		}
 	case KindService:
-		backendIps = []string{resources.GetService(backendNamespace, string(backendRef.Name)).Spec.ClusterIP}
+		service := resources.GetService(backendNamespace, string(backendRef.Name))
+		ipFamily := getEnvoyIPFamily(resources.EnvoyProxy)
+		switch {
+		case service.Spec.Type == v1.ServiceTypeExternalName:
+			// ExternalName Services are routed to the FQDN they alias
+			backendAddrs = append(backendAddrs, ir.NewDestEndpoint(service.Spec.ExternalName, uint32(*backendRef.Port)))
+		case service.Spec.ClusterIP == v1.ClusterIPNone:
+			// Headless Services have no cluster IP, they are routed to their endpoints
+			backendAddrs = getServiceEndpoints(service, int32(*backendRef.Port), ipFamily, resources)
+		case ipFamily != nil && hasServiceEndpointSlices(service, resources):
+			// The Services are routed to their endpoints of the IP family
+			backendAddrs = getServiceEndpoints(service, int32(*backendRef.Port), ipFamily, resources)
+		default:
+			for _, ip := range getServiceClusterIPs(service, ipFamily) {
+				backendAddrs = append(backendAddrs, ir.NewDestEndpoint(ip, uint32(*backendRef.Port)))
+			}
+		}
+	case egv1a1.KindBackend:
+		for _, endpoint := range resources.GetBackend(backendNamespace, string(backendRef.Name)).Spec.Endpoints {
+			var host string
+			if endpoint.FQDN != nil {
+				host = *endpoint.FQDN
+			} else {
+				host = *endpoint.IP
+			}
+			backendAddrs = append(backendAddrs, ir.NewDestEndpoint(host, uint32(endpoint.Port)))
+		}
 	}
 
-	for _, ip := range backendIps {
+	for _, addr := range backendAddrs {
 		var ep *ir.DestinationEndpoint
 		// Weights are not relevant for TCP and UDP Routes
 		if routeType == KindTCPRoute || routeType == KindUDPRoute {
 			ep = ir.NewDestEndpoint(
-				ip,
-				uint32(*backendRef.Port))
+				addr.Host,
+				addr.Port)
 		} else {
 			ep = ir.NewDestEndpointWithWeight(
-				ip,
-				uint32(*backendRef.Port),
+				addr.Host,
+				addr.Port,
 				weight)
 		}
 		endpoints = append(endpoints, ep)
@@ -1006,6 +1106,132 @@ func (t *Translator) processDestEndpoints(backendRef v1beta1.BackendRef,
 	return endpoints, weight
 }
 
+// getServiceClusterIPs returns the cluster IPs of the Service that belong to the IP family.
+// The primary cluster IP is returned if the IP family is unset, or if the Service has no
+// cluster IP of the IP family.
+func getServiceClusterIPs(service *v1.Service, ipFamily *egcfgv1a1.IPFamily) []string {
+	if ipFamily == nil || len(service.Spec.ClusterIPs) == 0 {
+		return []string{service.Spec.ClusterIP}
+	}
+	if ips := filterIPFamilyAddresses(service.Spec.ClusterIPs, ipFamily); len(ips) != 0 {
+		return ips
+	}
+	return []string{service.Spec.ClusterIP}
+}
+
+// filterIPFamilyAddresses returns the addresses that belong to the IP family.
+func filterIPFamilyAddresses(addresses []string, ipFamily *egcfgv1a1.IPFamily) []string {
+	var filtered []string
+	for _, address := range addresses {
+		if ipFamilyContains(ipFamily, address) {
+			filtered = append(filtered, address)
+		}
+	}
+	return filtered
+}
+
+// hasServiceEndpointSlices returns true if the Service has EndpointSlices.
+func hasServiceEndpointSlices(service *v1.Service, resources *Resources) bool {
+	for _, endpointSlice := range resources.EndpointSlices {
+		if endpointSlice.Namespace == service.Namespace &&
+			endpointSlice.Labels[discoveryv1.LabelServiceName] == service.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// getServiceEndpoints returns the ready endpoints of the Service port, taken from the
+// EndpointSlices of the Service whose address type belongs to the IP family.
+func getServiceEndpoints(service *v1.Service, port int32, ipFamily *egcfgv1a1.IPFamily, resources *Resources) []*ir.DestinationEndpoint {
+	var portName string
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Port == port {
+			portName = servicePort.Name
+			break
+		}
+	}
+
+	var endpoints []*ir.DestinationEndpoint
+	for _, endpointSlice := range resources.EndpointSlices {
+		if endpointSlice.Namespace != service.Namespace ||
+			endpointSlice.Labels[discoveryv1.LabelServiceName] != service.Name ||
+			!ipFamilyContainsAddressType(ipFamily, endpointSlice.AddressType) {
+			continue
+		}
+
+		var endpointPort *int32
+		for _, slicePort := range endpointSlice.Ports {
+			if slicePort.Port == nil {
+				continue
+			}
+			if (slicePort.Name == nil && portName == "") || (slicePort.Name != nil && *slicePort.Name == portName) {
+				endpointPort = slicePort.Port
+				break
+			}
+		}
+		if endpointPort == nil {
+			continue
+		}
+
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			for _, address := range endpoint.Addresses {
+				endpoints = append(endpoints, ir.NewDestEndpoint(address, uint32(*endpointPort)))
+			}
+		}
+	}
+	return endpoints
+}
+
+// ipFamilyContains returns true if the IP address belongs to the IP family.
+// IPv4 is used if the IP family is unset.
+func ipFamilyContains(ipFamily *egcfgv1a1.IPFamily, address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	if ip.To4() != nil {
+		return ipFamilyContainsAddressType(ipFamily, discoveryv1.AddressTypeIPv4)
+	}
+	return ipFamilyContainsAddressType(ipFamily, discoveryv1.AddressTypeIPv6)
+}
+
+// ipFamilyContainsAddressType returns true if the EndpointSlice address type belongs
+// to the IP family. IPv4 is used if the IP family is unset.
+func ipFamilyContainsAddressType(ipFamily *egcfgv1a1.IPFamily, addressType discoveryv1.AddressType) bool {
+	if ipFamily == nil {
+		return addressType == discoveryv1.AddressTypeIPv4
+	}
+	switch *ipFamily {
+	case egcfgv1a1.IPv4:
+		return addressType == discoveryv1.AddressTypeIPv4
+	case egcfgv1a1.IPv6:
+		return addressType == discoveryv1.AddressTypeIPv6
+	case egcfgv1a1.DualStack:
+		return addressType == discoveryv1.AddressTypeIPv4 || addressType == discoveryv1.AddressTypeIPv6
+	}
+	return false
+}
+
+// getBackendDNSSettings returns the DNS settings of the Backend referenced by the
+// backendRef, or nil if the backendRef is not a Backend or it has no DNS settings.
+func getBackendDNSSettings(backendRef v1beta1.BackendObjectReference, routeNamespace string, resources *Resources) *ir.DNSSettings {
+	if KindDerefOr(backendRef.Kind, KindService) != egv1a1.KindBackend {
+		return nil
+	}
+	backend := resources.GetBackend(NamespaceDerefOr(backendRef.Namespace, routeNamespace), string(backendRef.Name))
+	if backend == nil || backend.Spec.DNS == nil {
+		return nil
+	}
+	return &ir.DNSSettings{
+		RefreshRate:   backend.Spec.DNS.RefreshRate,
+		RespectDNSTTL: backend.Spec.DNS.RespectDNSTTL,
+	}
+}
+
 // processAllowedListenersForParentRefs finds out if the route attaches to one of our
 // Gateways' listeners, and if so, gets the list of listeners that allow it to
 // attach for each parentRef.
@@ -1075,3 +1301,27 @@ func (t *Translator) processAllowedListenersForParentRefs(routeContext RouteCont
 	}
 	return relevantRoute
 }
+
+// setDirectResponseBodies sets the body of the direct responses without a body, such as
+// the ones returned for routes without valid backends, to the body of the first local reply
+// mapping matching their status code. Mappings matching response flags are skipped, since
+// direct responses have none.
+func setDirectResponseBodies(localReply *ir.LocalReply, routes []*ir.HTTPRoute) {
+	for _, route := range routes {
+		if route.DirectResponse == nil || route.DirectResponse.Body != nil {
+			continue
+		}
+		for _, mapping := range localReply.Mappings {
+			if len(mapping.ResponseFlags) > 0 || !slices.Contains(mapping.StatusCodes, route.DirectResponse.StatusCode) {
+				continue
+			}
+			if mapping.Body != nil {
+				// The direct response may be shared with the routes of other Listeners.
+				directResponse := route.DirectResponse.DeepCopy()
+				directResponse.Body = mapping.Body
+				route.DirectResponse = directResponse
+			}
+			break
+		}
+	}
+}
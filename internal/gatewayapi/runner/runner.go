@@ -141,6 +141,30 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 				key := utils.NamespacedName(udpRoute)
 				r.ProviderResources.UDPRouteStatuses.Store(key, &udpRoute.Status)
 			}
+			for _, clientTrafficPolicy := range result.ClientTrafficPolicies {
+				key := utils.NamespacedName(clientTrafficPolicy)
+				r.ProviderResources.ClientTrafficPolicyStatuses.Store(key, &clientTrafficPolicy.Status)
+			}
+			for _, backendTLSPolicy := range result.BackendTLSPolicies {
+				key := utils.NamespacedName(backendTLSPolicy)
+				r.ProviderResources.BackendTLSPolicyStatuses.Store(key, &backendTLSPolicy.Status)
+			}
+			for _, compressionPolicy := range result.CompressionPolicies {
+				key := utils.NamespacedName(compressionPolicy)
+				r.ProviderResources.CompressionPolicyStatuses.Store(key, &compressionPolicy.Status)
+			}
+			for _, envoyExtensionPolicy := range result.EnvoyExtensionPolicies {
+				key := utils.NamespacedName(envoyExtensionPolicy)
+				r.ProviderResources.EnvoyExtensionPolicyStatuses.Store(key, &envoyExtensionPolicy.Status)
+			}
+			for _, tracingPolicy := range result.TracingPolicies {
+				key := utils.NamespacedName(tracingPolicy)
+				r.ProviderResources.TracingPolicyStatuses.Store(key, &tracingPolicy.Status)
+			}
+			for _, accessLoggingPolicy := range result.AccessLoggingPolicies {
+				key := utils.NamespacedName(accessLoggingPolicy)
+				r.ProviderResources.AccessLoggingPolicyStatuses.Store(key, &accessLoggingPolicy.Status)
+			}
 		},
 	)
 	r.Logger.Info("shutting down")
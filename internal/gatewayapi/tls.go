@@ -6,6 +6,7 @@
 package gatewayapi
 
 import (
+	"bytes"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
@@ -14,6 +15,10 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
+// caCertKey is the key of the CA certificate bundle within
+// a Secret or ConfigMap.
+const caCertKey = "ca.crt"
+
 // validateTLSSecretData ensures the cert and key provided in a secret
 // is not malformed and can be properly parsed
 func validateTLSSecretsData(secrets []*corev1.Secret, host *v1beta1.Hostname) error {
@@ -96,3 +101,87 @@ func verifyHostname(cert *x509.Certificate, host *v1beta1.Hostname) ([]string, e
 
 	return nil, x509.HostnameError{Certificate: cert, Host: string(*host)}
 }
+
+// validateCertificates ensures the provided data contains at least one
+// PEM encoded certificate, and that all the certificates can be parsed.
+func validateCertificates(data []byte) error {
+	var found bool
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("unable to parse certificate: %w", err)
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("unable to decode pem data")
+	}
+	return nil
+}
+
+// getCACertificate concatenates the CA certificate bundles found in the
+// referenced Secrets and ConfigMaps.
+func (t *Translator) getCACertificate(from crossNamespaceFrom, caCertRefs []v1beta1.SecretObjectReference,
+	resources *Resources) ([]byte, error) {
+	var caCertificate []byte
+	for _, caCertRef := range caCertRefs {
+		group := GroupDerefOr(caCertRef.Group, "")
+		kind := KindDerefOr(caCertRef.Kind, KindSecret)
+		namespace := NamespaceDerefOr(caCertRef.Namespace, from.namespace)
+
+		if group != "" || (kind != KindSecret && kind != KindConfigMap) {
+			return nil, fmt.Errorf("CA certificate ref %s/%s must be a core %s or %s", group, kind, KindSecret, KindConfigMap)
+		}
+
+		if namespace != from.namespace {
+			if !t.validateCrossNamespaceRef(
+				from,
+				crossNamespaceTo{
+					group:     "",
+					kind:      kind,
+					namespace: namespace,
+					name:      string(caCertRef.Name),
+				},
+				resources.ReferenceGrants,
+			) {
+				return nil, fmt.Errorf("CA certificate ref to %s %s/%s not permitted by any ReferenceGrant",
+					kind, namespace, caCertRef.Name)
+			}
+		}
+
+		var data []byte
+		switch kind {
+		case KindSecret:
+			secret := resources.GetSecret(namespace, string(caCertRef.Name))
+			if secret == nil {
+				return nil, fmt.Errorf("Secret %s/%s does not exist", namespace, caCertRef.Name)
+			}
+			data = secret.Data[caCertKey]
+		case KindConfigMap:
+			configMap := resources.GetConfigMap(namespace, string(caCertRef.Name))
+			if configMap == nil {
+				return nil, fmt.Errorf("ConfigMap %s/%s does not exist", namespace, caCertRef.Name)
+			}
+			data = []byte(configMap.Data[caCertKey])
+		}
+
+		if err := validateCertificates(data); err != nil {
+			return nil, fmt.Errorf("%s %s/%s must contain a valid CA certificate in %s: %w", kind, namespace, caCertRef.Name, caCertKey, err)
+		}
+		caCertificate = append(caCertificate, data...)
+		// Terminate each bundle, otherwise the first certificate of the next bundle
+		// is glued to the last line of this one.
+		if !bytes.HasSuffix(caCertificate, []byte("\n")) {
+			caCertificate = append(caCertificate, '\n')
+		}
+	}
+
+	return caCertificate, nil
+}
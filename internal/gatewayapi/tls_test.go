@@ -6,6 +6,8 @@
 package gatewayapi
 
 import (
+	"bytes"
+	"encoding/pem"
 	"errors"
 	"os"
 	"path/filepath"
@@ -202,3 +204,50 @@ func TestValidateTLSSecretsData(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCACertificate(t *testing.T) {
+	rsaCert, err := os.ReadFile(filepath.Join("testdata", "tls", "rsa-cert.pem"))
+	require.NoError(t, err)
+	ecdsaCert, err := os.ReadFile(filepath.Join("testdata", "tls", "ecdsa-p256-cert.pem"))
+	require.NoError(t, err)
+
+	resources := &Resources{
+		Secrets: []*corev1.Secret{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-no-trailing-newline",
+				Namespace: secretNamespace,
+			},
+			Data: map[string][]byte{
+				caCertKey: bytes.TrimRight(rsaCert, "\n"),
+			},
+		}},
+		ConfigMaps: []*corev1.ConfigMap{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca",
+				Namespace: secretNamespace,
+			},
+			Data: map[string]string{
+				caCertKey: string(ecdsaCert),
+			},
+		}},
+	}
+	configMapKind := v1beta1.Kind(KindConfigMap)
+	refs := []v1beta1.SecretObjectReference{
+		{Name: "ca-no-trailing-newline"},
+		{Kind: &configMapKind, Name: "ca"},
+	}
+
+	translator := &Translator{}
+	got, err := translator.getCACertificate(crossNamespaceFrom{namespace: secretNamespace}, refs, resources)
+	require.NoError(t, err)
+
+	expected := append(append(bytes.TrimRight(rsaCert, "\n"), '\n'), ecdsaCert...)
+	require.Equal(t, string(expected), string(got))
+
+	// Every certificate of the bundle must be decodable.
+	var certs int
+	for block, rest := pem.Decode(got); block != nil; block, rest = pem.Decode(rest) {
+		certs++
+	}
+	require.Equal(t, 2, certs)
+}
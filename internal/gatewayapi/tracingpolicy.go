@@ -0,0 +1,148 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+
+	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+func (t *Translator) ProcessTracingPolicies(tracingPolicies []*egv1a1.TracingPolicy,
+	gateways []*GatewayContext,
+	routes []RouteContext,
+	xdsIR XdsIRMap) []*egv1a1.TracingPolicy {
+	resolver := &policyTargetResolver[*egv1a1.TracingPolicy]{
+		kind:       egv1a1.KindTracingPolicy,
+		routeKinds: []string{KindHTTPRoute, KindGRPCRoute},
+		targetRef: func(policy *egv1a1.TracingPolicy) *egv1a1.PolicyTargetReferenceWithSectionName {
+			return &policy.Spec.TargetRef
+		},
+		setCondition: status.SetTracingPolicyCondition,
+	}
+
+	return resolver.attach(tracingPolicies, gateways, routes, func(policy *egv1a1.TracingPolicy, target *policyTarget) error {
+		if target.route != nil {
+			if !routeHasTracing(target.route, xdsIR) {
+				return fmt.Errorf("tracing is not enabled for any Gateway of %s %s/%s, no tracing provider is configured in their EnvoyProxy",
+					GetRouteType(target.route), target.route.GetNamespace(), target.route.GetName())
+			}
+		} else if !gatewayHasTracing(target.gateway.Gateway, xdsIR) {
+			return fmt.Errorf("tracing is not enabled for Gateway %s/%s, no tracing provider is configured in its EnvoyProxy",
+				target.gateway.Namespace, target.gateway.Name)
+		}
+
+		tracing, err := buildRouteTracing(policy)
+		if err != nil {
+			return err
+		}
+		if target.route != nil {
+			for _, irRoute := range irRoutesForRoute(target.route, xdsIR) {
+				irRoute.Tracing = tracing
+			}
+		} else {
+			setListenersRouteTracing(tracing, target.listeners, xdsIR)
+		}
+		return nil
+	})
+}
+
+// gatewayHasTracing returns true if the EnvoyProxy of the Gateway configures
+// a tracing provider, since the tracing overrides require tracing to be enabled.
+func gatewayHasTracing(gateway *gwv1b1.Gateway, xdsIR XdsIRMap) bool {
+	gwXdsIR, ok := xdsIR[irStringKey(gateway.Namespace, gateway.Name)]
+	return ok && gwXdsIR.Tracing != nil
+}
+
+// routeHasTracing returns true if tracing is enabled for any of the Gateways
+// the route is attached to.
+func routeHasTracing(route RouteContext, xdsIR XdsIRMap) bool {
+	for _, parentRef := range GetParentReferences(route) {
+		for _, listener := range GetRouteParentContext(route, parentRef).listeners {
+			if gatewayHasTracing(listener.gateway, xdsIR) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// setListenersRouteTracing sets the tracing overrides in the IR of the provided Listeners.
+func setListenersRouteTracing(tracing *ir.RouteTracing, listeners []*ListenerContext, xdsIR XdsIRMap) {
+	for _, listener := range listeners {
+		gwXdsIR, ok := xdsIR[irStringKey(listener.gateway.Namespace, listener.gateway.Name)]
+		if !ok {
+			continue
+		}
+		// Only valid HTTP and HTTPS Listeners are present in the IR.
+		irListener := gwXdsIR.GetHTTPListener(irHTTPListenerName(listener))
+		if irListener == nil {
+			continue
+		}
+		irListener.Tracing = tracing
+	}
+}
+
+// buildRouteTracing validates the settings of the policy and translates them
+// into the tracing overrides of the IR.
+func buildRouteTracing(policy *egv1a1.TracingPolicy) (*ir.RouteTracing, error) {
+	spec := policy.Spec
+	hasSettings := spec.SamplingRate != nil || spec.ClientSamplingRate != nil || spec.OverallSamplingRate != nil ||
+		len(spec.CustomTags) > 0 || spec.OperationName != nil
+	if spec.Disabled {
+		if hasSettings {
+			return nil, errors.New("disabled cannot be set with any other setting")
+		}
+		return &ir.RouteTracing{Disabled: true}, nil
+	}
+	if !hasSettings {
+		return nil, errors.New("at least one setting must be set")
+	}
+
+	for name, tag := range spec.CustomTags {
+		if err := validateCustomTag(name, tag); err != nil {
+			return nil, err
+		}
+	}
+
+	tracing := &ir.RouteTracing{
+		SamplingRate:        spec.SamplingRate,
+		ClientSamplingRate:  spec.ClientSamplingRate,
+		OverallSamplingRate: spec.OverallSamplingRate,
+		CustomTags:          spec.CustomTags,
+	}
+	if spec.OperationName != nil {
+		tracing.OperationName = *spec.OperationName
+	}
+	return tracing, nil
+}
+
+// validateCustomTag ensures that the field matching the type of the custom tag is set.
+func validateCustomTag(name string, tag egcfgv1a1.CustomTag) error {
+	switch tag.Type {
+	case egcfgv1a1.CustomTagTypeLiteral:
+		if tag.Literal == nil {
+			return fmt.Errorf("custom tag %s of type %s must set literal", name, tag.Type)
+		}
+	case egcfgv1a1.CustomTagTypeEnvironment:
+		if tag.Environment == nil {
+			return fmt.Errorf("custom tag %s of type %s must set environment", name, tag.Type)
+		}
+	case egcfgv1a1.CustomTagTypeRequestHeader:
+		if tag.RequestHeader == nil {
+			return fmt.Errorf("custom tag %s of type %s must set requestHeader", name, tag.Type)
+		}
+	default:
+		return fmt.Errorf("custom tag %s has an unknown type %s", name, tag.Type)
+	}
+	return nil
+}
@@ -8,6 +8,8 @@ package gatewayapi
 import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
 const (
@@ -23,6 +25,7 @@ const (
 	KindService       = "Service"
 	KindServiceImport = "ServiceImport"
 	KindSecret        = "Secret"
+	KindConfigMap     = "ConfigMap"
 
 	GroupMultiClusterService = "multicluster.x-k8s.io"
 	// OwningGatewayNamespaceLabel is the owner reference label used for managed infra.
@@ -84,6 +87,12 @@ func newTranslateResult(gateways []*GatewayContext,
 	tlsRoutes []*TLSRouteContext,
 	tcpRoutes []*TCPRouteContext,
 	udpRoutes []*UDPRouteContext,
+	clientTrafficPolicies []*egv1a1.ClientTrafficPolicy,
+	backendTLSPolicies []*egv1a1.BackendTLSPolicy,
+	compressionPolicies []*egv1a1.CompressionPolicy,
+	envoyExtensionPolicies []*egv1a1.EnvoyExtensionPolicy,
+	tracingPolicies []*egv1a1.TracingPolicy,
+	accessLoggingPolicies []*egv1a1.AccessLoggingPolicy,
 	xdsIR XdsIRMap, infraIR InfraIRMap) *TranslateResult {
 	translateResult := &TranslateResult{
 		XdsIR:   xdsIR,
@@ -108,6 +117,12 @@ func newTranslateResult(gateways []*GatewayContext,
 	for _, udpRoute := range udpRoutes {
 		translateResult.UDPRoutes = append(translateResult.UDPRoutes, udpRoute.UDPRoute)
 	}
+	translateResult.ClientTrafficPolicies = append(translateResult.ClientTrafficPolicies, clientTrafficPolicies...)
+	translateResult.BackendTLSPolicies = append(translateResult.BackendTLSPolicies, backendTLSPolicies...)
+	translateResult.CompressionPolicies = append(translateResult.CompressionPolicies, compressionPolicies...)
+	translateResult.EnvoyExtensionPolicies = append(translateResult.EnvoyExtensionPolicies, envoyExtensionPolicies...)
+	translateResult.TracingPolicies = append(translateResult.TracingPolicies, tracingPolicies...)
+	translateResult.AccessLoggingPolicies = append(translateResult.AccessLoggingPolicies, accessLoggingPolicies...)
 
 	return translateResult
 }
@@ -122,12 +137,19 @@ func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	// Process all Listeners for all relevant Gateways.
 	t.ProcessListeners(gateways, xdsIR, infraIR, resources)
 
+	// Process ClientTrafficPolicies
+	clientTrafficPolicies := t.ProcessClientTrafficPolicies(resources.ClientTrafficPolicies, gateways, xdsIR, resources)
+
 	// Process EnvoyPatchPolicies
 	t.ProcessEnvoyPatchPolicies(resources.EnvoyPatchPolicies, xdsIR)
 
 	// Process all Addresses for all relevant Gateways.
 	t.ProcessAddresses(gateways, xdsIR, infraIR, resources)
 
+	// Process BackendTLSPolicies before the routes referencing
+	// the backends they apply to.
+	backendTLSPolicies := t.ProcessBackendTLSPolicies(resources.BackendTLSPolicies, resources)
+
 	// Process all relevant HTTPRoutes.
 	httpRoutes := t.ProcessHTTPRoutes(resources.HTTPRoutes, gateways, resources, xdsIR)
 
@@ -143,10 +165,35 @@ func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	// Process all relevant UDPRoutes.
 	udpRoutes := t.ProcessUDPRoutes(resources.UDPRoutes, gateways, resources, xdsIR)
 
+	// The HTTP routes the policies below can target.
+	routes := make([]RouteContext, 0, len(httpRoutes)+len(grpcRoutes))
+	for _, httpRoute := range httpRoutes {
+		routes = append(routes, httpRoute)
+	}
+	for _, grpcRoute := range grpcRoutes {
+		routes = append(routes, grpcRoute)
+	}
+
+	// Process CompressionPolicies after the routes, since
+	// they can disable the compression of an HTTPRoute.
+	compressionPolicies := t.ProcessCompressionPolicies(resources.CompressionPolicies, gateways, routes, xdsIR)
+
+	// Process EnvoyExtensionPolicies after the routes, since
+	// they can run extensions on the requests of a route.
+	envoyExtensionPolicies := t.ProcessEnvoyExtensionPolicies(resources.EnvoyExtensionPolicies, gateways, routes, xdsIR, resources)
+
+	// Process TracingPolicies after the routes, since
+	// they can override the tracing of a route.
+	tracingPolicies := t.ProcessTracingPolicies(resources.TracingPolicies, gateways, routes, xdsIR)
+
+	// Process AccessLoggingPolicies after the routes, since
+	// they can log the requests of a route.
+	accessLoggingPolicies := t.ProcessAccessLoggingPolicies(resources.AccessLoggingPolicies, gateways, routes, xdsIR, resources)
+
 	// Sort xdsIR based on the Gateway API spec
 	sortXdsIRMap(xdsIR)
 
-	return newTranslateResult(gateways, httpRoutes, grpcRoutes, tlsRoutes, tcpRoutes, udpRoutes, xdsIR, infraIR)
+	return newTranslateResult(gateways, httpRoutes, grpcRoutes, tlsRoutes, tcpRoutes, udpRoutes, clientTrafficPolicies, backendTLSPolicies, compressionPolicies, envoyExtensionPolicies, tracingPolicies, accessLoggingPolicies, xdsIR, infraIR)
 }
 
 // GetRelevantGateways returns GatewayContexts, containing a copy of the original
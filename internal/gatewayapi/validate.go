@@ -7,6 +7,7 @@ package gatewayapi
 
 import (
 	"fmt"
+	"net"
 	"net/netip"
 	"strings"
 
@@ -16,6 +17,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
 func (t *Translator) validateBackendRef(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, route RouteContext,
@@ -29,14 +32,15 @@ func (t *Translator) validateBackendRef(backendRef *v1alpha2.BackendRef, parentR
 	if !t.validateBackendNamespace(backendRef, parentRef, route, resources, routeKind) {
 		return false
 	}
-	if !t.validateBackendPort(backendRef, parentRef, route) {
+	backendRefKind := KindDerefOr(backendRef.Kind, KindService)
+	// The ports of a Backend are set on its endpoints
+	if backendRefKind != egv1a1.KindBackend && !t.validateBackendPort(backendRef, parentRef, route) {
 		return false
 	}
 	protocol := v1.ProtocolTCP
 	if routeKind == KindUDPRoute {
 		protocol = v1.ProtocolUDP
 	}
-	backendRefKind := KindDerefOr(backendRef.Kind, KindService)
 	switch backendRefKind {
 	case KindService:
 		if !t.validateBackendService(backendRef, parentRef, resources, backendNamespace, route, protocol) {
@@ -46,17 +50,23 @@ func (t *Translator) validateBackendRef(backendRef *v1alpha2.BackendRef, parentR
 		if !t.validateBackendServiceImport(backendRef, parentRef, resources, backendNamespace, route, protocol) {
 			return false
 		}
+	case egv1a1.KindBackend:
+		if !t.validateBackendBackend(backendRef, parentRef, resources, backendNamespace, route) {
+			return false
+		}
 	}
 	return true
 }
 
 func (t *Translator) validateBackendRefGroup(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, route RouteContext) bool {
-	if backendRef.Group != nil && *backendRef.Group != "" && *backendRef.Group != GroupMultiClusterService {
+	if backendRef.Group != nil && *backendRef.Group != "" && *backendRef.Group != GroupMultiClusterService &&
+		string(*backendRef.Group) != egv1a1.GroupVersion.Group {
 		parentRef.SetCondition(route,
 			v1beta1.RouteConditionResolvedRefs,
 			metav1.ConditionFalse,
 			v1beta1.RouteReasonInvalidKind,
-			fmt.Sprintf("Group is invalid, only the core API group (specified by omitting the group field or setting it to an empty string) and %s is supported", GroupMultiClusterService),
+			fmt.Sprintf("Group is invalid, only the core API group (specified by omitting the group field or setting it to an empty string), %s and %s are supported",
+				GroupMultiClusterService, egv1a1.GroupVersion.Group),
 		)
 		return false
 	}
@@ -64,12 +74,28 @@ func (t *Translator) validateBackendRefGroup(backendRef *v1alpha2.BackendRef, pa
 }
 
 func (t *Translator) validateBackendRefKind(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, route RouteContext) bool {
-	if backendRef.Kind != nil && *backendRef.Kind != KindService && *backendRef.Kind != KindServiceImport {
+	if backendRef.Kind != nil && *backendRef.Kind != KindService && *backendRef.Kind != KindServiceImport &&
+		*backendRef.Kind != egv1a1.KindBackend {
+		parentRef.SetCondition(route,
+			v1beta1.RouteConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.RouteReasonInvalidKind,
+			"Kind is invalid, only Service, MCS ServiceImport and Backend are supported",
+		)
+		return false
+	}
+	isBackend := KindDerefOr(backendRef.Kind, KindService) == egv1a1.KindBackend
+	if isBackend != (GroupDerefOr(backendRef.Group, "") == egv1a1.GroupVersion.Group) {
+		message := fmt.Sprintf("Kind %s is invalid, only Backend is supported for the %s group",
+			KindDerefOr(backendRef.Kind, KindService), egv1a1.GroupVersion.Group)
+		if isBackend {
+			message = fmt.Sprintf("Group is invalid, Backend is only supported for the %s group", egv1a1.GroupVersion.Group)
+		}
 		parentRef.SetCondition(route,
 			v1beta1.RouteConditionResolvedRefs,
 			metav1.ConditionFalse,
 			v1beta1.RouteReasonInvalidKind,
-			"Kind is invalid, only Service and MCS ServiceImport is supported",
+			message,
 		)
 		return false
 	}
@@ -130,6 +156,10 @@ func (t *Translator) validateBackendService(backendRef *v1alpha2.BackendRef, par
 		)
 		return false
 	}
+	// ExternalName Services are resolved through DNS, and may not list their ports
+	if service.Spec.Type == v1.ServiceTypeExternalName {
+		return true
+	}
 	var portFound bool
 	for _, port := range service.Spec.Ports {
 		portProtocol := port.Protocol
@@ -193,6 +223,52 @@ func (t *Translator) validateBackendServiceImport(backendRef *v1alpha2.BackendRe
 	return true
 }
 
+func (t *Translator) validateBackendBackend(backendRef *v1alpha2.BackendRef, parentRef *RouteParentContext, resources *Resources,
+	backendNamespace string, route RouteContext) bool {
+	backend := resources.GetBackend(backendNamespace, string(backendRef.Name))
+	if backend == nil {
+		parentRef.SetCondition(route,
+			v1beta1.RouteConditionResolvedRefs,
+			metav1.ConditionFalse,
+			v1beta1.RouteReasonBackendNotFound,
+			fmt.Sprintf("Backend %s/%s not found", backendNamespace, string(backendRef.Name)),
+		)
+		return false
+	}
+	if len(backend.Spec.Endpoints) == 0 {
+		parentRef.SetCondition(route,
+			v1beta1.RouteConditionResolvedRefs,
+			metav1.ConditionFalse,
+			"InvalidBackend",
+			fmt.Sprintf("Backend %s/%s has no endpoints", backendNamespace, string(backendRef.Name)),
+		)
+		return false
+	}
+	for i, endpoint := range backend.Spec.Endpoints {
+		var err error
+		switch {
+		case (endpoint.FQDN == nil) == (endpoint.IP == nil):
+			err = fmt.Errorf("exactly one of fqdn and ip must be set")
+		case endpoint.FQDN != nil:
+			if errs := validation.IsDNS1123Subdomain(*endpoint.FQDN); len(errs) > 0 {
+				err = fmt.Errorf("fqdn %s is invalid: %s", *endpoint.FQDN, strings.Join(errs, ", "))
+			}
+		case net.ParseIP(*endpoint.IP) == nil:
+			err = fmt.Errorf("ip %s is invalid", *endpoint.IP)
+		}
+		if err != nil {
+			parentRef.SetCondition(route,
+				v1beta1.RouteConditionResolvedRefs,
+				metav1.ConditionFalse,
+				"InvalidBackend",
+				fmt.Sprintf("Endpoint %d of Backend %s/%s is invalid: %v", i, backendNamespace, string(backendRef.Name), err),
+			)
+			return false
+		}
+	}
+	return true
+}
+
 func (t *Translator) validateListenerConditions(listener *ListenerContext) (isReady bool) {
 	lConditions := listener.GetConditions()
 	if len(lConditions) == 0 {
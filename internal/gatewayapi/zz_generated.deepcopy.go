@@ -139,6 +139,17 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]*apiv1alpha1.Backend, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.Backend)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.EndpointSlices != nil {
 		in, out := &in.EndpointSlices, &out.EndpointSlices
 		*out = make([]*discoveryv1.EndpointSlice, len(*in))
@@ -161,6 +172,17 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]*v1.ConfigMap, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1.ConfigMap)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.AuthenticationFilters != nil {
 		in, out := &in.AuthenticationFilters, &out.AuthenticationFilters
 		*out = make([]*apiv1alpha1.AuthenticationFilter, len(*in))
@@ -183,6 +205,50 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.FaultInjectionFilters != nil {
+		in, out := &in.FaultInjectionFilters, &out.FaultInjectionFilters
+		*out = make([]*apiv1alpha1.FaultInjectionFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.FaultInjectionFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GRPCTranscodingFilters != nil {
+		in, out := &in.GRPCTranscodingFilters, &out.GRPCTranscodingFilters
+		*out = make([]*apiv1alpha1.GRPCTranscodingFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.GRPCTranscodingFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.UpgradeFilters != nil {
+		in, out := &in.UpgradeFilters, &out.UpgradeFilters
+		*out = make([]*apiv1alpha1.UpgradeFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.UpgradeFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.HTTPRouteFilters != nil {
+		in, out := &in.HTTPRouteFilters, &out.HTTPRouteFilters
+		*out = make([]*apiv1alpha1.HTTPRouteFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.HTTPRouteFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.EnvoyProxy != nil {
 		in, out := &in.EnvoyProxy, &out.EnvoyProxy
 		*out = new(configv1alpha1.EnvoyProxy)
@@ -206,6 +272,72 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.ClientTrafficPolicies != nil {
+		in, out := &in.ClientTrafficPolicies, &out.ClientTrafficPolicies
+		*out = make([]*apiv1alpha1.ClientTrafficPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.ClientTrafficPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BackendTLSPolicies != nil {
+		in, out := &in.BackendTLSPolicies, &out.BackendTLSPolicies
+		*out = make([]*apiv1alpha1.BackendTLSPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.BackendTLSPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CompressionPolicies != nil {
+		in, out := &in.CompressionPolicies, &out.CompressionPolicies
+		*out = make([]*apiv1alpha1.CompressionPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.CompressionPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.EnvoyExtensionPolicies != nil {
+		in, out := &in.EnvoyExtensionPolicies, &out.EnvoyExtensionPolicies
+		*out = make([]*apiv1alpha1.EnvoyExtensionPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.EnvoyExtensionPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TracingPolicies != nil {
+		in, out := &in.TracingPolicies, &out.TracingPolicies
+		*out = make([]*apiv1alpha1.TracingPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.TracingPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AccessLoggingPolicies != nil {
+		in, out := &in.AccessLoggingPolicies, &out.AccessLoggingPolicies
+		*out = make([]*apiv1alpha1.AccessLoggingPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.AccessLoggingPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
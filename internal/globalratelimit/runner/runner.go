@@ -35,7 +35,8 @@ import (
 
 const (
 	// XdsGrpcSotwConfigServerAddress is the listening address of the ratelimit xDS config server.
-	XdsGrpcSotwConfigServerAddress = "0.0.0.0"
+	// The unspecified address listens on all the IPv4 and IPv6 addresses.
+	XdsGrpcSotwConfigServerAddress = ""
 	// rateLimitTLSCertFilename is the ratelimit tls cert file.
 	rateLimitTLSCertFilename = "/certs/tls.crt"
 	// rateLimitTLSKeyFilename is the ratelimit key file.
@@ -10,7 +10,9 @@ import (
 
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/envoyproxy/gateway/api/config/v1alpha1"
@@ -25,6 +27,9 @@ type ResourceRender interface {
 	Service() (*corev1.Service, error)
 	ConfigMap() (*corev1.ConfigMap, error)
 	Deployment() (*appsv1.Deployment, error)
+	DaemonSet() (*appsv1.DaemonSet, error)
+	HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPodAutoscaler, error)
+	PodDisruptionBudget() (*policyv1.PodDisruptionBudget, error)
 }
 
 // Infra manages the creation and deletion of Kubernetes infrastructure
@@ -49,8 +54,8 @@ func NewInfra(cli client.Client, cfg *config.Server) *Infra {
 	}
 }
 
-// createOrUpdate creates a ServiceAccount/ConfigMap/Deployment/Service in the kube api server based on the
-// provided ResourceRender, if it doesn't exist and updates it if it does.
+// createOrUpdate creates a ServiceAccount/ConfigMap/Deployment/DaemonSet/HorizontalPodAutoscaler/PodDisruptionBudget/
+// Service in the kube api server based on the provided ResourceRender, if it doesn't exist and updates it if it does.
 func (i *Infra) createOrUpdate(ctx context.Context, r ResourceRender) error {
 	if err := i.createOrUpdateServiceAccount(ctx, r); err != nil {
 		return errors.Wrapf(err, "failed to create or update serviceaccount %s/%s", i.Namespace, r.Name())
@@ -64,6 +69,18 @@ func (i *Infra) createOrUpdate(ctx context.Context, r ResourceRender) error {
 		return errors.Wrapf(err, "failed to create or update deployment %s/%s", i.Namespace, r.Name())
 	}
 
+	if err := i.createOrUpdateDaemonSet(ctx, r); err != nil {
+		return errors.Wrapf(err, "failed to create or update daemonset %s/%s", i.Namespace, r.Name())
+	}
+
+	if err := i.createOrUpdateHPA(ctx, r); err != nil {
+		return errors.Wrapf(err, "failed to create or update horizontalpodautoscaler %s/%s", i.Namespace, r.Name())
+	}
+
+	if err := i.createOrUpdatePDB(ctx, r); err != nil {
+		return errors.Wrapf(err, "failed to create or update poddisruptionbudget %s/%s", i.Namespace, r.Name())
+	}
+
 	if err := i.createOrUpdateService(ctx, r); err != nil {
 		return errors.Wrapf(err, "failed to create or update service %s/%s", i.Namespace, r.Name())
 	}
@@ -71,7 +88,8 @@ func (i *Infra) createOrUpdate(ctx context.Context, r ResourceRender) error {
 	return nil
 }
 
-// delete deletes the ServiceAccount/ConfigMap/Deployment/Service in the kube api server, if it exists.
+// delete deletes the ServiceAccount/ConfigMap/Deployment/DaemonSet/HorizontalPodAutoscaler/PodDisruptionBudget/
+// Service in the kube api server, if it exists.
 func (i *Infra) delete(ctx context.Context, r ResourceRender) error {
 	if err := i.deleteServiceAccount(ctx, r); err != nil {
 		return errors.Wrapf(err, "failed to delete serviceaccount %s/%s", i.Namespace, r.Name())
@@ -85,6 +103,18 @@ func (i *Infra) delete(ctx context.Context, r ResourceRender) error {
 		return errors.Wrapf(err, "failed to delete deployment %s/%s", i.Namespace, r.Name())
 	}
 
+	if err := i.deleteDaemonSet(ctx, r); err != nil {
+		return errors.Wrapf(err, "failed to delete daemonset %s/%s", i.Namespace, r.Name())
+	}
+
+	if err := i.deleteHPA(ctx, r); err != nil {
+		return errors.Wrapf(err, "failed to delete horizontalpodautoscaler %s/%s", i.Namespace, r.Name())
+	}
+
+	if err := i.deletePDB(ctx, r); err != nil {
+		return errors.Wrapf(err, "failed to delete poddisruptionbudget %s/%s", i.Namespace, r.Name())
+	}
+
 	if err := i.deleteService(ctx, r); err != nil {
 		return errors.Wrapf(err, "failed to delete service %s/%s", i.Namespace, r.Name())
 	}
@@ -39,6 +39,9 @@ func (cli *InfraClient) CreateOrUpdate(ctx context.Context, key client.ObjectKey
 			// just perform an update for now.
 			if updateChecker() {
 				specific.SetUID(current.GetUID())
+				// Some resources, e.g. PodDisruptionBudgets, do not allow
+				// unconditional updates.
+				specific.SetResourceVersion(current.GetResourceVersion())
 				if err := cli.Client.Update(ctx, specific); err != nil {
 					return errors.Wrap(err, "for Update")
 				}
@@ -60,6 +63,19 @@ func (cli *InfraClient) Delete(ctx context.Context, object client.Object) error
 	return nil
 }
 
+// DeleteIfExists deletes the object in the kube api server, only when it exists,
+// so that no delete request is sent for the objects that are never created.
+func (cli *InfraClient) DeleteIfExists(ctx context.Context, object client.Object) error {
+	if err := cli.Client.Get(ctx, client.ObjectKeyFromObject(object), object); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return cli.Delete(ctx, object)
+}
+
 // GetUID retrieves the uid of one resource.
 func (cli *InfraClient) GetUID(ctx context.Context, key client.ObjectKey, current client.Object) (types.UID, error) {
 	if err := cli.Client.Get(ctx, key, current); err != nil {
@@ -10,7 +10,9 @@ import (
 	"reflect"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -61,13 +63,18 @@ func (i *Infra) createOrUpdateConfigMap(ctx context.Context, r ResourceRender) e
 }
 
 // createOrUpdateDeployment creates a Deployment in the kube api server based on the provided
-// ResourceRender, if it doesn't exist and updates it if it does.
+// ResourceRender, if it doesn't exist and updates it if it does. The Deployment is deleted if
+// the ResourceRender does not render one.
 func (i *Infra) createOrUpdateDeployment(ctx context.Context, r ResourceRender) error {
 	deployment, err := r.Deployment()
 	if err != nil {
 		return err
 	}
 
+	if deployment == nil {
+		return i.deleteDeployment(ctx, r)
+	}
+
 	current := &appsv1.Deployment{}
 	key := types.NamespacedName{
 		Namespace: deployment.Namespace,
@@ -75,10 +82,87 @@ func (i *Infra) createOrUpdateDeployment(ctx context.Context, r ResourceRender)
 	}
 
 	return i.Client.CreateOrUpdate(ctx, key, current, deployment, func() bool {
+		// the replicas are managed by the horizontal pod autoscaler,
+		// keep the current ones instead of resetting them.
+		if deployment.Spec.Replicas == nil {
+			deployment.Spec.Replicas = current.Spec.Replicas
+		}
 		return !reflect.DeepEqual(deployment.Spec, current.Spec)
 	})
 }
 
+// createOrUpdateDaemonSet creates a DaemonSet in the kube api server based on the provided
+// ResourceRender, if it doesn't exist and updates it if it does. The DaemonSet is deleted if
+// the ResourceRender does not render one.
+func (i *Infra) createOrUpdateDaemonSet(ctx context.Context, r ResourceRender) error {
+	daemonSet, err := r.DaemonSet()
+	if err != nil {
+		return err
+	}
+
+	if daemonSet == nil {
+		return i.deleteDaemonSet(ctx, r)
+	}
+
+	current := &appsv1.DaemonSet{}
+	key := types.NamespacedName{
+		Namespace: daemonSet.Namespace,
+		Name:      daemonSet.Name,
+	}
+
+	return i.Client.CreateOrUpdate(ctx, key, current, daemonSet, func() bool {
+		return !reflect.DeepEqual(daemonSet.Spec, current.Spec)
+	})
+}
+
+// createOrUpdateHPA creates a HorizontalPodAutoscaler in the kube api server based on the provided
+// ResourceRender, if it doesn't exist and updates it if it does. The HorizontalPodAutoscaler is
+// deleted if the ResourceRender does not render one.
+func (i *Infra) createOrUpdateHPA(ctx context.Context, r ResourceRender) error {
+	hpa, err := r.HorizontalPodAutoscaler()
+	if err != nil {
+		return err
+	}
+
+	if hpa == nil {
+		return i.deleteHPA(ctx, r)
+	}
+
+	current := &autoscalingv2.HorizontalPodAutoscaler{}
+	key := types.NamespacedName{
+		Namespace: hpa.Namespace,
+		Name:      hpa.Name,
+	}
+
+	return i.Client.CreateOrUpdate(ctx, key, current, hpa, func() bool {
+		return !reflect.DeepEqual(hpa.Spec, current.Spec)
+	})
+}
+
+// createOrUpdatePDB creates a PodDisruptionBudget in the kube api server based on the provided
+// ResourceRender, if it doesn't exist and updates it if it does. The PodDisruptionBudget is
+// deleted if the ResourceRender does not render one.
+func (i *Infra) createOrUpdatePDB(ctx context.Context, r ResourceRender) error {
+	pdb, err := r.PodDisruptionBudget()
+	if err != nil {
+		return err
+	}
+
+	if pdb == nil {
+		return i.deletePDB(ctx, r)
+	}
+
+	current := &policyv1.PodDisruptionBudget{}
+	key := types.NamespacedName{
+		Namespace: pdb.Namespace,
+		Name:      pdb.Name,
+	}
+
+	return i.Client.CreateOrUpdate(ctx, key, current, pdb, func() bool {
+		return !reflect.DeepEqual(pdb.Spec, current.Spec)
+	})
+}
+
 // createOrUpdateRateLimitService creates a Service in the kube api server based on the provided ResourceRender,
 // if it doesn't exist or updates it if it does.
 func (i *Infra) createOrUpdateService(ctx context.Context, r ResourceRender) error {
@@ -119,7 +203,43 @@ func (i *Infra) deleteDeployment(ctx context.Context, r ResourceRender) error {
 		},
 	}
 
-	return i.Client.Delete(ctx, deployment)
+	return i.Client.DeleteIfExists(ctx, deployment)
+}
+
+// deleteDaemonSet deletes the Envoy DaemonSet in the kube api server, if it exists.
+func (i *Infra) deleteDaemonSet(ctx context.Context, r ResourceRender) error {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.Namespace,
+			Name:      r.Name(),
+		},
+	}
+
+	return i.Client.DeleteIfExists(ctx, daemonSet)
+}
+
+// deleteHPA deletes the HorizontalPodAutoscaler in the kube api server, if it exists.
+func (i *Infra) deleteHPA(ctx context.Context, r ResourceRender) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.Namespace,
+			Name:      r.Name(),
+		},
+	}
+
+	return i.Client.DeleteIfExists(ctx, hpa)
+}
+
+// deletePDB deletes the PodDisruptionBudget in the kube api server, if it exists.
+func (i *Infra) deletePDB(ctx context.Context, r ResourceRender) error {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.Namespace,
+			Name:      r.Name(),
+		},
+	}
+
+	return i.Client.DeleteIfExists(ctx, pdb)
 }
 
 // deleteConfigMap deletes the ConfigMap in the kube api server, if it exists.
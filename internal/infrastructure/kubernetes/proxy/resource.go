@@ -7,12 +7,16 @@ package proxy
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/cmd/envoy"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/resource"
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -38,6 +42,11 @@ const (
 	envoyNsEnvVar = "ENVOY_GATEWAY_NAMESPACE"
 	// envoyPodEnvVar is the name of the Envoy pod name environment variable.
 	envoyPodEnvVar = "ENVOY_POD_NAME"
+	// shutdownManagerContainerName is the name of the shutdown manager container.
+	shutdownManagerContainerName = "shutdown-manager"
+	// shutdownReadyTimeoutMargin is the time the preStop hook of the Envoy container
+	// waits for the connections to drain, in addition to the drain timeout.
+	shutdownReadyTimeoutMargin = 10 * time.Second
 )
 
 var (
@@ -85,7 +94,7 @@ func envoyLabels(extraLabels map[string]string) map[string]string {
 }
 
 // expectedProxyContainers returns expected proxy containers.
-func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.KubernetesDeploymentSpec) ([]corev1.Container, error) {
+func expectedProxyContainers(infra *ir.ProxyInfra, containerSpec *egcfgv1a1.KubernetesContainerSpec) ([]corev1.Container, error) {
 	// Define slice to hold container ports
 	var ports []corev1.ContainerPort
 
@@ -110,9 +119,15 @@ func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.K
 		}
 	}
 
-	var proxyMetrics *egcfgv1a1.ProxyMetrics
+	var (
+		proxyMetrics    *egcfgv1a1.ProxyMetrics
+		ipFamily        *egcfgv1a1.IPFamily
+		overloadManager *egcfgv1a1.ProxyOverloadManager
+	)
 	if infra.Config != nil {
 		proxyMetrics = infra.Config.Spec.Telemetry.Metrics
+		ipFamily = infra.Config.Spec.IPFamily
+		overloadManager = infra.Config.Spec.OverloadManager.ForContainer(containerSpec)
 	}
 
 	if proxyMetrics != nil && proxyMetrics.Prometheus != nil {
@@ -126,7 +141,7 @@ func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.K
 	var bootstrapConfigurations string
 
 	// Get the default Bootstrap
-	bootstrapConfigurations, err := bootstrap.GetRenderedBootstrapConfig(proxyMetrics)
+	bootstrapConfigurations, err := bootstrap.GetRenderedBootstrapConfig(proxyMetrics, ipFamily, overloadManager)
 	if err != nil {
 		return nil, err
 	}
@@ -159,18 +174,26 @@ func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.K
 		args = append(args, fmt.Sprintf("--component-log-level %s", componentsLogLevel))
 	}
 
+	// Once the shutdown manager drains the listeners, Envoy asks the clients to close
+	// all the connections right away instead of gradually over the drain time.
+	shutdownConfig := infra.GetProxyConfig().Spec.Shutdown
+	args = append(args,
+		fmt.Sprintf("--drain-time-s %d", int64(math.Ceil(shutdownConfig.GetDrainTimeout().Seconds()))),
+		"--drain-strategy immediate",
+	)
+
 	containers := []corev1.Container{
 		{
 			Name:                     envoyContainerName,
-			Image:                    *deploymentConfig.Container.Image,
+			Image:                    *containerSpec.Image,
 			ImagePullPolicy:          corev1.PullIfNotPresent,
 			Command:                  []string{"envoy"},
 			Args:                     args,
-			Env:                      expectedProxyContainerEnv(deploymentConfig),
-			Resources:                *deploymentConfig.Container.Resources,
-			SecurityContext:          deploymentConfig.Container.SecurityContext,
+			Env:                      expectedProxyContainerEnv(containerSpec),
+			Resources:                *containerSpec.Resources,
+			SecurityContext:          containerSpec.SecurityContext,
 			Ports:                    ports,
-			VolumeMounts:             expectedContainerVolumeMounts(deploymentConfig),
+			VolumeMounts:             expectedContainerVolumeMounts(containerSpec),
 			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 			TerminationMessagePath:   "/dev/termination-log",
 			ReadinessProbe: &corev1.Probe{
@@ -186,14 +209,68 @@ func expectedProxyContainers(infra *ir.ProxyInfra, deploymentConfig *egcfgv1a1.K
 				SuccessThreshold: 1,
 				FailureThreshold: 3,
 			},
+			Lifecycle: &corev1.Lifecycle{
+				PreStop: &corev1.LifecycleHandler{
+					HTTPGet: &corev1.HTTPGetAction{
+						Path:   envoy.ShutdownManagerReadyPath,
+						Port:   intstr.FromInt(envoy.ShutdownManagerPort),
+						Scheme: corev1.URISchemeHTTP,
+					},
+				},
+			},
 		},
+		expectedShutdownManagerContainer(infra),
 	}
 
 	return containers, nil
 }
 
+// expectedShutdownManagerContainer returns the expected shutdown manager container, which
+// drains the connections of Envoy before the Envoy container terminates.
+func expectedShutdownManagerContainer(infra *ir.ProxyInfra) corev1.Container {
+	proxyConfig := infra.GetProxyConfig()
+	shutdownConfig := proxyConfig.Spec.Shutdown
+	shutdownManager := proxyConfig.GetEnvoyProxyProvider().GetEnvoyProxyKubeProvider().ShutdownManager
+
+	return corev1.Container{
+		Name:            shutdownManagerContainerName,
+		Image:           *shutdownManager.Image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"envoy-gateway"},
+		Args: []string{
+			"envoy",
+			"shutdown-manager",
+			"--ready-timeout",
+			(shutdownConfig.GetDrainTimeout() + shutdownReadyTimeoutMargin).String(),
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    k8sresource.MustParse("10m"),
+				corev1.ResourceMemory: k8sresource.MustParse("32Mi"),
+			},
+		},
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		TerminationMessagePath:   "/dev/termination-log",
+		Lifecycle: &corev1.Lifecycle{
+			PreStop: &corev1.LifecycleHandler{
+				Exec: &corev1.ExecAction{
+					Command: []string{
+						"envoy-gateway",
+						"envoy",
+						"shutdown",
+						"--drain-timeout",
+						shutdownConfig.GetDrainTimeout().String(),
+						"--min-drain-duration",
+						shutdownConfig.GetMinDrainDuration().String(),
+					},
+				},
+			},
+		},
+	}
+}
+
 // expectedContainerVolumeMounts returns expected proxy container volume mounts.
-func expectedContainerVolumeMounts(deploymentSpec *egcfgv1a1.KubernetesDeploymentSpec) []corev1.VolumeMount {
+func expectedContainerVolumeMounts(containerSpec *egcfgv1a1.KubernetesContainerSpec) []corev1.VolumeMount {
 	volumeMounts := []corev1.VolumeMount{
 		{
 			Name:      "certs",
@@ -206,11 +283,11 @@ func expectedContainerVolumeMounts(deploymentSpec *egcfgv1a1.KubernetesDeploymen
 		},
 	}
 
-	return resource.ExpectedContainerVolumeMounts(deploymentSpec.Container, volumeMounts)
+	return resource.ExpectedContainerVolumeMounts(containerSpec, volumeMounts)
 }
 
 // expectedDeploymentVolumes returns expected proxy deployment volumes.
-func expectedDeploymentVolumes(name string, deploymentSpec *egcfgv1a1.KubernetesDeploymentSpec) []corev1.Volume {
+func expectedDeploymentVolumes(name string, podSpec *egcfgv1a1.KubernetesPodSpec) []corev1.Volume {
 	volumes := []corev1.Volume{
 		{
 			Name: "certs",
@@ -245,11 +322,20 @@ func expectedDeploymentVolumes(name string, deploymentSpec *egcfgv1a1.Kubernetes
 		},
 	}
 
-	return resource.ExpectedDeploymentVolumes(deploymentSpec.Pod, volumes)
+	return resource.ExpectedDeploymentVolumes(podSpec, volumes)
+}
+
+// expectedDeploymentReplicas returns the replicas of the Envoy Proxy Deployment. The
+// replicas are left unset when a HorizontalPodAutoscaler manages them.
+func expectedDeploymentReplicas(kubeProvider *egcfgv1a1.EnvoyProxyKubernetesProvider) *int32 {
+	if kubeProvider.EnvoyHpa != nil {
+		return nil
+	}
+	return kubeProvider.EnvoyDeployment.Replicas
 }
 
 // expectedProxyContainerEnv returns expected proxy container envs.
-func expectedProxyContainerEnv(deploymentConfig *egcfgv1a1.KubernetesDeploymentSpec) []corev1.EnvVar {
+func expectedProxyContainerEnv(containerSpec *egcfgv1a1.KubernetesContainerSpec) []corev1.EnvVar {
 	env := []corev1.EnvVar{
 		{
 			Name: envoyNsEnvVar,
@@ -271,5 +357,19 @@ func expectedProxyContainerEnv(deploymentConfig *egcfgv1a1.KubernetesDeploymentS
 		},
 	}
 
-	return resource.ExpectedProxyContainerEnv(deploymentConfig.Container, env)
+	return resource.ExpectedProxyContainerEnv(containerSpec, env)
+}
+
+// expectedServiceIPFamilies returns the IP family policy and the IP families of the
+// Envoy Proxy Service for the IP family.
+func expectedServiceIPFamilies(ipFamily egcfgv1a1.IPFamily) (*corev1.IPFamilyPolicy, []corev1.IPFamily) {
+	singleStack, preferDualStack := corev1.IPFamilyPolicySingleStack, corev1.IPFamilyPolicyPreferDualStack
+	switch ipFamily {
+	case egcfgv1a1.IPv6:
+		return &singleStack, []corev1.IPFamily{corev1.IPv6Protocol}
+	case egcfgv1a1.DualStack:
+		return &preferDualStack, []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+	default:
+		return &singleStack, []corev1.IPFamily{corev1.IPv4Protocol}
+	}
 }
@@ -11,7 +11,9 @@ import (
 
 	"golang.org/x/exp/maps"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
@@ -100,7 +102,18 @@ func (r *ResourceRender) Service() (*corev1.Service, error) {
 	serviceSpec := resource.ExpectedServiceSpec(envoyServiceConfig)
 	serviceSpec.Ports = ports
 	serviceSpec.Selector = resource.GetSelector(labels).MatchLabels
-	serviceSpec.ExternalIPs = r.infra.Addresses
+	if len(r.infra.Addresses) > 0 {
+		if serviceSpec.Type == corev1.ServiceTypeLoadBalancer {
+			// The load balancer of a Service can only be requested a single IP,
+			// the other addresses are reported as not assigned in the Gateway status.
+			serviceSpec.LoadBalancerIP = r.infra.Addresses[0]
+		} else {
+			serviceSpec.ExternalIPs = r.infra.Addresses
+		}
+	}
+	if ipFamily := r.infra.GetProxyConfig().Spec.IPFamily; ipFamily != nil {
+		serviceSpec.IPFamilyPolicy, serviceSpec.IPFamilies = expectedServiceIPFamilies(*ipFamily)
+	}
 
 	svc := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
@@ -144,15 +157,103 @@ func (r *ResourceRender) ConfigMap() (*corev1.ConfigMap, error) {
 	}, nil
 }
 
-// Deployment returns the expected Deployment based on the provided infra.
+// Deployment returns the expected Deployment based on the provided infra, or nil
+// if the Envoy proxies run as a DaemonSet.
 func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 	// Get the EnvoyProxy config to configure the deployment.
 	provider := r.infra.GetProxyConfig().GetEnvoyProxyProvider()
 	if provider.Type != egcfgv1a1.ProviderTypeKubernetes {
 		return nil, fmt.Errorf("invalid provider type %v for Kubernetes infra manager", provider.Type)
 	}
-	deploymentConfig := provider.GetEnvoyProxyKubeProvider().EnvoyDeployment
+	kubeProvider := provider.GetEnvoyProxyKubeProvider()
+	if kubeProvider.EnvoyDaemonSet != nil {
+		return nil, nil
+	}
+	deploymentConfig := kubeProvider.EnvoyDeployment
+
+	// Set the labels based on the owning gateway name.
+	dpLabels := envoyLabels(r.infra.GetProxyMetadata().Labels)
+	if len(dpLabels[gatewayapi.OwningGatewayNamespaceLabel]) == 0 || len(dpLabels[gatewayapi.OwningGatewayNameLabel]) == 0 {
+		return nil, fmt.Errorf("missing owning gateway labels")
+	}
+
+	selector, podTemplate, err := r.expectedPodTemplateSpec(deploymentConfig.Pod, deploymentConfig.Container)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      ExpectedResourceHashedName(r.infra.Name),
+			Labels:    dpLabels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas:                expectedDeploymentReplicas(kubeProvider),
+			Strategy:                *deploymentConfig.Strategy,
+			Selector:                selector,
+			Template:                podTemplate,
+			RevisionHistoryLimit:    pointer.Int32(10),
+			ProgressDeadlineSeconds: pointer.Int32(600),
+		},
+	}
+
+	return deployment, nil
+}
+
+// DaemonSet returns the expected DaemonSet based on the provided infra, or nil
+// if the Envoy proxies run as a Deployment.
+func (r *ResourceRender) DaemonSet() (*appsv1.DaemonSet, error) {
+	// Get the EnvoyProxy config to configure the daemonset.
+	provider := r.infra.GetProxyConfig().GetEnvoyProxyProvider()
+	if provider.Type != egcfgv1a1.ProviderTypeKubernetes {
+		return nil, fmt.Errorf("invalid provider type %v for Kubernetes infra manager", provider.Type)
+	}
+	daemonSetConfig := provider.GetEnvoyProxyKubeProvider().EnvoyDaemonSet
+	if daemonSetConfig == nil {
+		return nil, nil
+	}
+
+	// Set the labels based on the owning gateway name.
+	dsLabels := envoyLabels(r.infra.GetProxyMetadata().Labels)
+	if len(dsLabels[gatewayapi.OwningGatewayNamespaceLabel]) == 0 || len(dsLabels[gatewayapi.OwningGatewayNameLabel]) == 0 {
+		return nil, fmt.Errorf("missing owning gateway labels")
+	}
+
+	selector, podTemplate, err := r.expectedPodTemplateSpec(daemonSetConfig.Pod, daemonSetConfig.Container)
+	if err != nil {
+		return nil, err
+	}
+
+	daemonSet := &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DaemonSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      ExpectedResourceHashedName(r.infra.Name),
+			Labels:    dsLabels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector:             selector,
+			Template:             podTemplate,
+			UpdateStrategy:       *daemonSetConfig.Strategy,
+			RevisionHistoryLimit: pointer.Int32(10),
+		},
+	}
+
+	return daemonSet, nil
+}
 
+// expectedPodTemplateSpec returns the selector and the template of the Envoy pods
+// of the Deployment or the DaemonSet.
+func (r *ResourceRender) expectedPodTemplateSpec(podConfig *egcfgv1a1.KubernetesPodSpec,
+	containerConfig *egcfgv1a1.KubernetesContainerSpec) (*metav1.LabelSelector, corev1.PodTemplateSpec, error) {
 	enablePrometheus := false
 	if r.infra.Config != nil &&
 		r.infra.Config.Spec.Telemetry.Metrics != nil &&
@@ -161,26 +262,20 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 	}
 
 	// Get expected bootstrap configurations rendered ProxyContainers
-	containers, err := expectedProxyContainers(r.infra, deploymentConfig)
+	containers, err := expectedProxyContainers(r.infra, containerConfig)
 	if err != nil {
-		return nil, err
+		return nil, corev1.PodTemplateSpec{}, err
 	}
 
-	// Set the labels based on the owning gateway name.
 	labels := r.infra.GetProxyMetadata().Labels
-	dpLabels := envoyLabels(labels)
-	if len(dpLabels[gatewayapi.OwningGatewayNamespaceLabel]) == 0 || len(dpLabels[gatewayapi.OwningGatewayNameLabel]) == 0 {
-		return nil, fmt.Errorf("missing owning gateway labels")
-	}
-
-	maps.Copy(labels, deploymentConfig.Pod.Labels)
+	maps.Copy(labels, podConfig.Labels)
 	podLabels := envoyLabels(labels)
 	selector := resource.GetSelector(podLabels)
 
 	// Get annotations
 	var annotations map[string]string
-	if deploymentConfig.Pod.Annotations != nil {
-		annotations = deploymentConfig.Pod.Annotations
+	if podConfig.Annotations != nil {
+		annotations = podConfig.Annotations
 	}
 	if enablePrometheus {
 		if annotations == nil {
@@ -191,43 +286,115 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 		annotations["prometheus.io/port"] = strconv.Itoa(bootstrap.EnvoyReadinessPort)
 	}
 
-	deployment := &appsv1.Deployment{
+	return selector, corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      selector.MatchLabels,
+			Annotations: annotations,
+		},
+		Spec: corev1.PodSpec{
+			Containers:                    containers,
+			ServiceAccountName:            ExpectedResourceHashedName(r.infra.Name),
+			AutomountServiceAccountToken:  pointer.Bool(false),
+			TerminationGracePeriodSeconds: resource.ExpectedTerminationGracePeriodSeconds(podConfig),
+			HostNetwork:                   podConfig.HostNetwork,
+			DNSPolicy:                     resource.ExpectedDNSPolicy(podConfig),
+			RestartPolicy:                 corev1.RestartPolicyAlways,
+			SchedulerName:                 "default-scheduler",
+			SecurityContext:               podConfig.SecurityContext,
+			Affinity:                      podConfig.Affinity,
+			Tolerations:                   podConfig.Tolerations,
+			Volumes:                       expectedDeploymentVolumes(r.infra.Name, podConfig),
+		},
+	}, nil
+}
+
+// HorizontalPodAutoscaler returns the expected HorizontalPodAutoscaler of the Deployment
+// based on the provided infra, or nil if the Deployment is not autoscaled.
+func (r *ResourceRender) HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	provider := r.infra.GetProxyConfig().GetEnvoyProxyProvider()
+	if provider.Type != egcfgv1a1.ProviderTypeKubernetes {
+		return nil, fmt.Errorf("invalid provider type %v for Kubernetes infra manager", provider.Type)
+	}
+	kubeProvider := provider.GetEnvoyProxyKubeProvider()
+	hpaConfig := kubeProvider.EnvoyHpa
+	// A DaemonSet runs a pod per node and cannot be autoscaled.
+	if hpaConfig == nil || kubeProvider.EnvoyDaemonSet != nil {
+		return nil, nil
+	}
+
+	// Set the labels based on the owning gateway name.
+	labels := envoyLabels(r.infra.GetProxyMetadata().Labels)
+	if len(labels[gatewayapi.OwningGatewayNamespaceLabel]) == 0 || len(labels[gatewayapi.OwningGatewayNameLabel]) == 0 {
+		return nil, fmt.Errorf("missing owning gateway labels")
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       "Deployment",
-			APIVersion: "apps/v1",
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: "autoscaling/v2",
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: r.Namespace,
 			Name:      ExpectedResourceHashedName(r.infra.Name),
-			Labels:    dpLabels,
+			Labels:    labels,
 		},
-		Spec: appsv1.DeploymentSpec{
-			Replicas: deploymentConfig.Replicas,
-			Strategy: *deploymentConfig.Strategy,
-			Selector: selector,
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels:      selector.MatchLabels,
-					Annotations: annotations,
-				},
-				Spec: corev1.PodSpec{
-					Containers:                    containers,
-					ServiceAccountName:            ExpectedResourceHashedName(r.infra.Name),
-					AutomountServiceAccountToken:  pointer.Bool(false),
-					TerminationGracePeriodSeconds: pointer.Int64(int64(300)),
-					DNSPolicy:                     corev1.DNSClusterFirst,
-					RestartPolicy:                 corev1.RestartPolicyAlways,
-					SchedulerName:                 "default-scheduler",
-					SecurityContext:               deploymentConfig.Pod.SecurityContext,
-					Affinity:                      deploymentConfig.Pod.Affinity,
-					Tolerations:                   deploymentConfig.Pod.Tolerations,
-					Volumes:                       expectedDeploymentVolumes(r.infra.Name, deploymentConfig),
-				},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       ExpectedResourceHashedName(r.infra.Name),
 			},
-			RevisionHistoryLimit:    pointer.Int32(10),
-			ProgressDeadlineSeconds: pointer.Int32(600),
+			MinReplicas: hpaConfig.MinReplicas,
+			MaxReplicas: hpaConfig.MaxReplicas,
+			Metrics:     hpaConfig.Metrics,
+			Behavior:    hpaConfig.Behavior,
 		},
+	}, nil
+}
+
+// PodDisruptionBudget returns the expected PodDisruptionBudget of the Envoy pods
+// based on the provided infra, or nil if no PodDisruptionBudget is configured.
+func (r *ResourceRender) PodDisruptionBudget() (*policyv1.PodDisruptionBudget, error) {
+	provider := r.infra.GetProxyConfig().GetEnvoyProxyProvider()
+	if provider.Type != egcfgv1a1.ProviderTypeKubernetes {
+		return nil, fmt.Errorf("invalid provider type %v for Kubernetes infra manager", provider.Type)
+	}
+	kubeProvider := provider.GetEnvoyProxyKubeProvider()
+	pdbConfig := kubeProvider.EnvoyPDB
+	if pdbConfig == nil {
+		return nil, nil
 	}
 
-	return deployment, nil
+	// Set the labels based on the owning gateway name.
+	labels := r.infra.GetProxyMetadata().Labels
+	pdbLabels := envoyLabels(labels)
+	if len(pdbLabels[gatewayapi.OwningGatewayNamespaceLabel]) == 0 || len(pdbLabels[gatewayapi.OwningGatewayNameLabel]) == 0 {
+		return nil, fmt.Errorf("missing owning gateway labels")
+	}
+
+	// Select the pods of the Deployment or the DaemonSet, which also carry the
+	// custom pod labels.
+	podConfig := kubeProvider.EnvoyDeployment.Pod
+	if kubeProvider.EnvoyDaemonSet != nil {
+		podConfig = kubeProvider.EnvoyDaemonSet.Pod
+	}
+	maps.Copy(labels, podConfig.Labels)
+	selector := resource.GetSelector(envoyLabels(labels))
+
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: "policy/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      ExpectedResourceHashedName(r.infra.Name),
+			Labels:    pdbLabels,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector:       selector,
+			MinAvailable:   pdbConfig.MinAvailable,
+			MaxUnavailable: pdbConfig.MaxUnavailable,
+		},
+	}, nil
 }
@@ -10,12 +10,17 @@ import (
 	"os"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/yaml"
 
@@ -69,6 +74,9 @@ func TestDeployment(t *testing.T) {
 		bootstrap    string
 		telemetry    *egcfgv1a1.ProxyTelemetry
 		concurrency  *int32
+		shutdown     *egcfgv1a1.ShutdownConfig
+		shutdownMgr  *egcfgv1a1.ShutdownManager
+		overloadMgr  *egcfgv1a1.ProxyOverloadManager
 	}{
 		{
 			caseName: "default",
@@ -270,6 +278,42 @@ func TestDeployment(t *testing.T) {
 			concurrency: pointer.Int32(4),
 			bootstrap:   `test bootstrap config`,
 		},
+		{
+			caseName: "shutdown",
+			infra:    newTestInfra(),
+			deploy: &egcfgv1a1.KubernetesDeploymentSpec{
+				Pod: &egcfgv1a1.KubernetesPodSpec{
+					TerminationGracePeriodSeconds: pointer.Int64(120),
+				},
+			},
+			shutdown: &egcfgv1a1.ShutdownConfig{
+				DrainTimeout:     &metav1.Duration{Duration: 90 * time.Second},
+				MinDrainDuration: &metav1.Duration{Duration: 10 * time.Second},
+			},
+			shutdownMgr: &egcfgv1a1.ShutdownManager{
+				Image: pointer.String("envoyproxy/gateway:v1.2.3"),
+			},
+		},
+		{
+			caseName: "overload-manager",
+			infra:    newTestInfra(),
+			deploy: &egcfgv1a1.KubernetesDeploymentSpec{
+				Container: &egcfgv1a1.KubernetesContainerSpec{
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100m"),
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+					},
+				},
+			},
+			overloadMgr: &egcfgv1a1.ProxyOverloadManager{
+				MaxActiveDownstreamConnections: pointer.Int64(50000),
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.caseName, func(t *testing.T) {
@@ -277,6 +321,9 @@ func TestDeployment(t *testing.T) {
 			if tc.deploy != nil {
 				kube.EnvoyDeployment = tc.deploy
 			}
+			if tc.shutdownMgr != nil {
+				kube.ShutdownManager = tc.shutdownMgr
+			}
 			replace := egcfgv1a1.BootstrapTypeReplace
 			if tc.bootstrap != "" {
 				tc.infra.Proxy.Config.Spec.Bootstrap = &egcfgv1a1.ProxyBootstrap{
@@ -299,6 +346,14 @@ func TestDeployment(t *testing.T) {
 				tc.infra.Proxy.Config.Spec.Concurrency = tc.concurrency
 			}
 
+			if tc.shutdown != nil {
+				tc.infra.Proxy.Config.Spec.Shutdown = tc.shutdown
+			}
+
+			if tc.overloadMgr != nil {
+				tc.infra.Proxy.Config.Spec.OverloadManager = tc.overloadMgr
+			}
+
 			r := NewResourceRender(cfg.Namespace, tc.infra.GetProxyInfra())
 			dp, err := r.Deployment()
 			require.NoError(t, err)
@@ -329,15 +384,95 @@ func loadDeployment(caseName string) (*appsv1.Deployment, error) {
 	return deployment, nil
 }
 
+func TestDaemonSet(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	dnsDefault := corev1.DNSDefault
+	maxUnavailableDaemonSetPods := intstr.FromString("10%")
+	cases := []struct {
+		caseName  string
+		infra     *ir.Infra
+		daemonSet *egcfgv1a1.KubernetesDaemonSetSpec
+	}{
+		{
+			caseName:  "default",
+			infra:     newTestInfra(),
+			daemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{},
+		},
+		{
+			caseName: "host-network",
+			infra:    newTestInfra(),
+			daemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{
+				Strategy: &appsv1.DaemonSetUpdateStrategy{
+					Type: appsv1.RollingUpdateDaemonSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+						MaxUnavailable: &maxUnavailableDaemonSetPods,
+					},
+				},
+				Pod: &egcfgv1a1.KubernetesPodSpec{
+					Labels: map[string]string{
+						"foo.bar": "custom-label",
+					},
+					HostNetwork: true,
+				},
+			},
+		},
+		{
+			caseName: "dns-policy",
+			infra:    newTestInfra(),
+			daemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{
+				Pod: &egcfgv1a1.KubernetesPodSpec{
+					HostNetwork: true,
+					DNSPolicy:   &dnsDefault,
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.caseName, func(t *testing.T) {
+			provider := tc.infra.GetProxyInfra().GetProxyConfig().GetEnvoyProxyProvider().GetEnvoyProxyKubeProvider()
+			provider.EnvoyDaemonSet = tc.daemonSet
+
+			r := NewResourceRender(cfg.Namespace, tc.infra.GetProxyInfra())
+			ds, err := r.DaemonSet()
+			require.NoError(t, err)
+
+			expected, err := loadDaemonSet(tc.caseName)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, ds)
+
+			// The Envoy proxies run as a DaemonSet instead of a Deployment.
+			dp, err := r.Deployment()
+			require.NoError(t, err)
+			assert.Nil(t, dp)
+		})
+	}
+}
+
+func loadDaemonSet(caseName string) (*appsv1.DaemonSet, error) {
+	daemonSetYAML, err := os.ReadFile(fmt.Sprintf("testdata/daemonsets/%s.yaml", caseName))
+	if err != nil {
+		return nil, err
+	}
+	daemonSet := &appsv1.DaemonSet{}
+	_ = yaml.Unmarshal(daemonSetYAML, daemonSet)
+	return daemonSet, nil
+}
+
 func TestService(t *testing.T) {
 	cfg, err := config.New()
 	require.NoError(t, err)
 
 	svcType := egcfgv1a1.ServiceTypeClusterIP
+	ipv6, dualStack := egcfgv1a1.IPv6, egcfgv1a1.DualStack
 	cases := []struct {
-		caseName string
-		infra    *ir.Infra
-		service  *egcfgv1a1.KubernetesServiceSpec
+		caseName  string
+		infra     *ir.Infra
+		service   *egcfgv1a1.KubernetesServiceSpec
+		addresses []string
+		ipFamily  *egcfgv1a1.IPFamily
 	}{
 		{
 			caseName: "default",
@@ -354,9 +489,34 @@ func TestService(t *testing.T) {
 				Type: &svcType,
 			},
 		},
+		{
+			caseName:  "loadbalancer-with-addresses",
+			infra:     newTestInfra(),
+			addresses: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			caseName: "clusterip-with-addresses",
+			infra:    newTestInfra(),
+			service: &egcfgv1a1.KubernetesServiceSpec{
+				Type: &svcType,
+			},
+			addresses: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			caseName: "ipv6",
+			infra:    newTestInfra(),
+			ipFamily: &ipv6,
+		},
+		{
+			caseName: "dual-stack",
+			infra:    newTestInfra(),
+			ipFamily: &dualStack,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.caseName, func(t *testing.T) {
+			tc.infra.Proxy.Addresses = tc.addresses
+			tc.infra.GetProxyInfra().GetProxyConfig().Spec.IPFamily = tc.ipFamily
 			provider := tc.infra.GetProxyInfra().GetProxyConfig().GetEnvoyProxyProvider().GetEnvoyProxyKubeProvider()
 			if tc.service != nil {
 				provider.EnvoyService = tc.service
@@ -384,6 +544,149 @@ func loadService(caseName string) (*corev1.Service, error) {
 	return svc, nil
 }
 
+func TestHorizontalPodAutoscaler(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	averageMemory := resource.MustParse("1Gi")
+
+	cases := []struct {
+		caseName string
+		infra    *ir.Infra
+		hpa      *egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec
+	}{
+		{
+			caseName: "default",
+			infra:    newTestInfra(),
+			hpa: &egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+				MaxReplicas: 5,
+			},
+		},
+		{
+			caseName: "custom",
+			infra:    newTestInfra(),
+			hpa: &egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+				MinReplicas: pointer.Int32(2),
+				MaxReplicas: 10,
+				Metrics: []autoscalingv2.MetricSpec{
+					{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name: corev1.ResourceMemory,
+							Target: autoscalingv2.MetricTarget{
+								Type:         autoscalingv2.AverageValueMetricType,
+								AverageValue: &averageMemory,
+							},
+						},
+					},
+				},
+				Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+					ScaleDown: &autoscalingv2.HPAScalingRules{
+						StabilizationWindowSeconds: pointer.Int32(600),
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.caseName, func(t *testing.T) {
+			provider := tc.infra.GetProxyInfra().GetProxyConfig().GetEnvoyProxyProvider().GetEnvoyProxyKubeProvider()
+			provider.EnvoyHpa = tc.hpa
+
+			r := NewResourceRender(cfg.Namespace, tc.infra.GetProxyInfra())
+			hpa, err := r.HorizontalPodAutoscaler()
+			require.NoError(t, err)
+
+			expected, err := loadHPA(tc.caseName)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, hpa)
+
+			// The replicas of the deployment are managed by the autoscaler.
+			dp, err := r.Deployment()
+			require.NoError(t, err)
+			assert.Nil(t, dp.Spec.Replicas)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		r := NewResourceRender(cfg.Namespace, newTestInfra().GetProxyInfra())
+		hpa, err := r.HorizontalPodAutoscaler()
+		require.NoError(t, err)
+		assert.Nil(t, hpa)
+	})
+}
+
+func loadHPA(caseName string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpaYAML, err := os.ReadFile(fmt.Sprintf("testdata/hpa/%s.yaml", caseName))
+	if err != nil {
+		return nil, err
+	}
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	_ = yaml.Unmarshal(hpaYAML, hpa)
+	return hpa, nil
+}
+
+func TestPodDisruptionBudget(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	minAvailable := intstr.FromInt(1)
+	maxUnavailable := intstr.FromString("25%")
+	cases := []struct {
+		caseName string
+		infra    *ir.Infra
+		pdb      *egcfgv1a1.KubernetesPodDisruptionBudgetSpec
+	}{
+		{
+			caseName: "min-available",
+			infra:    newTestInfra(),
+			pdb: &egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+				MinAvailable: &minAvailable,
+			},
+		},
+		{
+			caseName: "max-unavailable",
+			infra:    newTestInfra(),
+			pdb: &egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.caseName, func(t *testing.T) {
+			provider := tc.infra.GetProxyInfra().GetProxyConfig().GetEnvoyProxyProvider().GetEnvoyProxyKubeProvider()
+			provider.EnvoyPDB = tc.pdb
+
+			r := NewResourceRender(cfg.Namespace, tc.infra.GetProxyInfra())
+			pdb, err := r.PodDisruptionBudget()
+			require.NoError(t, err)
+
+			expected, err := loadPDB(tc.caseName)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, pdb)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		r := NewResourceRender(cfg.Namespace, newTestInfra().GetProxyInfra())
+		pdb, err := r.PodDisruptionBudget()
+		require.NoError(t, err)
+		assert.Nil(t, pdb)
+	})
+}
+
+func loadPDB(caseName string) (*policyv1.PodDisruptionBudget, error) {
+	pdbYAML, err := os.ReadFile(fmt.Sprintf("testdata/pdb/%s.yaml", caseName))
+	if err != nil {
+		return nil, err
+	}
+	pdb := &policyv1.PodDisruptionBudget{}
+	_ = yaml.Unmarshal(pdbYAML, pdb)
+	return pdb, nil
+}
+
 func TestConfigMap(t *testing.T) {
 	cfg, err := config.New()
 	require.NoError(t, err)
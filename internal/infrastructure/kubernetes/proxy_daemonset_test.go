@@ -0,0 +1,155 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+func newTestInfraWithDaemonSet(daemonSet *egcfgv1a1.KubernetesDaemonSetSpec) *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.Config = &egcfgv1a1.EnvoyProxy{
+		Spec: egcfgv1a1.EnvoyProxySpec{
+			Provider: &egcfgv1a1.EnvoyProxyProvider{
+				Type: egcfgv1a1.ProviderTypeKubernetes,
+				Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+					EnvoyDaemonSet: daemonSet,
+				},
+			},
+		},
+	}
+	return infra
+}
+
+func TestCreateOrUpdateProxyDaemonSet(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	withDaemonSet := newTestInfraWithDaemonSet(&egcfgv1a1.KubernetesDaemonSetSpec{
+		Pod: &egcfgv1a1.KubernetesPodSpec{
+			HostNetwork: true,
+		},
+	})
+	daemonSet, err := proxy.NewResourceRender(cfg.Namespace, withDaemonSet.GetProxyInfra()).DaemonSet()
+	require.NoError(t, err)
+
+	withDeployment := newTestInfraWithDaemonSet(nil)
+	deployment, err := proxy.NewResourceRender(cfg.Namespace, withDeployment.GetProxyInfra()).Deployment()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		in             *ir.Infra
+		current        []client.Object
+		wantDaemonSet  bool
+		wantDeployment bool
+	}{
+		{
+			name:          "create daemonset",
+			in:            withDaemonSet,
+			wantDaemonSet: true,
+		},
+		{
+			name:          "switch from deployment to daemonset",
+			in:            withDaemonSet,
+			current:       []client.Object{deployment.DeepCopy()},
+			wantDaemonSet: true,
+		},
+		{
+			name:           "switch from daemonset to deployment",
+			in:             withDeployment,
+			current:        []client.Object{daemonSet.DeepCopy()},
+			wantDeployment: true,
+		},
+		{
+			name:           "create deployment",
+			in:             withDeployment,
+			wantDeployment: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			deletes := 0
+			cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(tc.current...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Delete: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						deletes++
+						return cli.Delete(ctx, obj, opts...)
+					},
+				}).Build()
+			kube := NewInfra(cli, cfg)
+			r := proxy.NewResourceRender(kube.Namespace, tc.in.GetProxyInfra())
+			require.NoError(t, kube.createOrUpdateDeployment(context.Background(), r))
+			require.NoError(t, kube.createOrUpdateDaemonSet(context.Background(), r))
+			// only the existing objects that are replaced are deleted.
+			require.Equal(t, len(tc.current), deletes)
+
+			key := client.ObjectKey{
+				Namespace: kube.Namespace,
+				Name:      proxy.ExpectedResourceHashedName(tc.in.Proxy.Name),
+			}
+
+			actualDaemonSet := &appsv1.DaemonSet{}
+			err := kube.Client.Get(context.Background(), key, actualDaemonSet)
+			if tc.wantDaemonSet {
+				require.NoError(t, err)
+				require.Equal(t, daemonSet.Spec, actualDaemonSet.Spec)
+			} else {
+				require.True(t, kerrors.IsNotFound(err))
+			}
+
+			err = kube.Client.Get(context.Background(), key, &appsv1.Deployment{})
+			if tc.wantDeployment {
+				require.NoError(t, err)
+			} else {
+				require.True(t, kerrors.IsNotFound(err))
+			}
+		})
+	}
+}
+
+func TestDeleteProxyDaemonSet(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	infra := newTestInfraWithDaemonSet(&egcfgv1a1.KubernetesDaemonSetSpec{})
+	r := proxy.NewResourceRender(cfg.Namespace, infra.GetProxyInfra())
+	daemonSet, err := r.DaemonSet()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(daemonSet).Build()
+	kube := NewInfra(cli, cfg)
+	require.NoError(t, kube.deleteDaemonSet(context.Background(), r))
+
+	actual := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: kube.Namespace,
+			Name:      r.Name(),
+		},
+	}
+	err = kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), actual)
+	require.True(t, kerrors.IsNotFound(err))
+}
@@ -0,0 +1,182 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+func newTestInfraWithHPA(hpa *egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec) *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.Config = &egcfgv1a1.EnvoyProxy{
+		Spec: egcfgv1a1.EnvoyProxySpec{
+			Provider: &egcfgv1a1.EnvoyProxyProvider{
+				Type: egcfgv1a1.ProviderTypeKubernetes,
+				Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+					EnvoyHpa: hpa,
+				},
+			},
+		},
+	}
+	return infra
+}
+
+func TestCreateOrUpdateProxyHPA(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	withHPA := newTestInfraWithHPA(&egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+		MinReplicas: pointer.Int32(2),
+		MaxReplicas: 5,
+	})
+	hpa, err := proxy.NewResourceRender(cfg.Namespace, withHPA.GetProxyInfra()).HorizontalPodAutoscaler()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		in      *ir.Infra
+		current *autoscalingv2.HorizontalPodAutoscaler
+		want    *autoscalingv2.HorizontalPodAutoscaler
+	}{
+		{
+			name: "create hpa",
+			in:   withHPA,
+			want: hpa,
+		},
+		{
+			name: "update hpa max replicas",
+			in: newTestInfraWithHPA(&egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+				MinReplicas: pointer.Int32(2),
+				MaxReplicas: 10,
+			}),
+			current: hpa,
+			want: func() *autoscalingv2.HorizontalPodAutoscaler {
+				want := hpa.DeepCopy()
+				want.Spec.MaxReplicas = 10
+				return want
+			}(),
+		},
+		{
+			name:    "delete hpa no longer configured",
+			in:      newTestInfraWithHPA(nil),
+			current: hpa,
+			want:    nil,
+		},
+		{
+			name: "hpa not configured",
+			in:   newTestInfraWithHPA(nil),
+			want: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Delete: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						// nothing is deleted when nothing exists.
+						if tc.current == nil {
+							t.Errorf("unexpected delete of %s", obj.GetName())
+						}
+						return cli.Delete(ctx, obj, opts...)
+					},
+				})
+			if tc.current != nil {
+				builder = builder.WithObjects(tc.current.DeepCopy())
+			}
+
+			kube := NewInfra(builder.Build(), cfg)
+			r := proxy.NewResourceRender(kube.Namespace, tc.in.GetProxyInfra())
+			require.NoError(t, kube.createOrUpdateHPA(context.Background(), r))
+
+			actual := &autoscalingv2.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: kube.Namespace,
+					Name:      proxy.ExpectedResourceHashedName(tc.in.Proxy.Name),
+				},
+			}
+			err := kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), actual)
+			if tc.want == nil {
+				require.True(t, kerrors.IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want.Spec, actual.Spec)
+		})
+	}
+}
+
+func TestCreateOrUpdateProxyDeploymentWithHPA(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	infra := newTestInfraWithHPA(&egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+		MaxReplicas: 5,
+	})
+	r := proxy.NewResourceRender(cfg.Namespace, infra.GetProxyInfra())
+	deploy, err := r.Deployment()
+	require.NoError(t, err)
+
+	// The autoscaler scaled the deployment out.
+	current := deploy.DeepCopy()
+	current.Spec.Replicas = pointer.Int32(4)
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(current).Build()
+
+	kube := NewInfra(cli, cfg)
+	require.NoError(t, kube.createOrUpdateDeployment(context.Background(), r))
+
+	actual := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: kube.Namespace,
+			Name:      proxy.ExpectedResourceHashedName(infra.Proxy.Name),
+		},
+	}
+	require.NoError(t, kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), actual))
+	require.Equal(t, pointer.Int32(4), actual.Spec.Replicas)
+}
+
+func TestDeleteProxyHPA(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	infra := newTestInfraWithHPA(&egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+		MaxReplicas: 5,
+	})
+	r := proxy.NewResourceRender(cfg.Namespace, infra.GetProxyInfra())
+	hpa, err := r.HorizontalPodAutoscaler()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(hpa).Build()
+	kube := NewInfra(cli, cfg)
+	require.NoError(t, kube.deleteHPA(context.Background(), r))
+
+	err = kube.Client.Get(context.Background(), client.ObjectKeyFromObject(hpa), &autoscalingv2.HorizontalPodAutoscaler{})
+	require.True(t, kerrors.IsNotFound(err))
+
+	// Deleting a missing HorizontalPodAutoscaler is not an error.
+	require.NoError(t, kube.deleteHPA(context.Background(), r))
+}
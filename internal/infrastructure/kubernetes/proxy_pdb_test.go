@@ -0,0 +1,130 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+func newTestInfraWithPDB(pdb *egcfgv1a1.KubernetesPodDisruptionBudgetSpec) *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.Config = &egcfgv1a1.EnvoyProxy{
+		Spec: egcfgv1a1.EnvoyProxySpec{
+			Provider: &egcfgv1a1.EnvoyProxyProvider{
+				Type: egcfgv1a1.ProviderTypeKubernetes,
+				Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+					EnvoyPDB: pdb,
+				},
+			},
+		},
+	}
+	return infra
+}
+
+func TestCreateOrUpdateProxyPDB(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	minAvailable, maxUnavailable := intstr.FromInt(1), intstr.FromString("50%")
+	withPDB := newTestInfraWithPDB(&egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+		MinAvailable: &minAvailable,
+	})
+	pdb, err := proxy.NewResourceRender(cfg.Namespace, withPDB.GetProxyInfra()).PodDisruptionBudget()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		in      *ir.Infra
+		current *policyv1.PodDisruptionBudget
+		want    *policyv1.PodDisruptionBudget
+	}{
+		{
+			name: "create pdb",
+			in:   withPDB,
+			want: pdb,
+		},
+		{
+			name: "update pdb to max unavailable",
+			in: newTestInfraWithPDB(&egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+			}),
+			current: pdb,
+			want: func() *policyv1.PodDisruptionBudget {
+				want := pdb.DeepCopy()
+				want.Spec.MinAvailable = nil
+				want.Spec.MaxUnavailable = &maxUnavailable
+				return want
+			}(),
+		},
+		{
+			name:    "delete pdb no longer configured",
+			in:      newTestInfraWithPDB(nil),
+			current: pdb,
+			want:    nil,
+		},
+		{
+			name: "pdb not configured",
+			in:   newTestInfraWithPDB(nil),
+			want: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Delete: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						// nothing is deleted when nothing exists.
+						if tc.current == nil {
+							t.Errorf("unexpected delete of %s", obj.GetName())
+						}
+						return cli.Delete(ctx, obj, opts...)
+					},
+				})
+			if tc.current != nil {
+				builder = builder.WithObjects(tc.current.DeepCopy())
+			}
+
+			kube := NewInfra(builder.Build(), cfg)
+			r := proxy.NewResourceRender(kube.Namespace, tc.in.GetProxyInfra())
+			require.NoError(t, kube.createOrUpdatePDB(context.Background(), r))
+
+			actual := &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: kube.Namespace,
+					Name:      proxy.ExpectedResourceHashedName(tc.in.Proxy.Name),
+				},
+			}
+			err := kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), actual)
+			if tc.want == nil {
+				require.True(t, kerrors.IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want.Spec, actual.Spec)
+		})
+	}
+}
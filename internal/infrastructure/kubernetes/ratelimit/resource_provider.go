@@ -7,7 +7,9 @@ package ratelimit
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -55,6 +57,21 @@ func (r *ResourceRender) ConfigMap() (*corev1.ConfigMap, error) {
 	return nil, nil
 }
 
+// DaemonSet returns nil, the rate limit service always runs as a Deployment.
+func (r *ResourceRender) DaemonSet() (*appsv1.DaemonSet, error) {
+	return nil, nil
+}
+
+// HorizontalPodAutoscaler returns nil, the rate limit deployment is not autoscaled.
+func (r *ResourceRender) HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return nil, nil
+}
+
+// PodDisruptionBudget returns nil, the rate limit deployment has no pod disruption budget.
+func (r *ResourceRender) PodDisruptionBudget() (*policyv1.PodDisruptionBudget, error) {
+	return nil, nil
+}
+
 // Service returns the expected rate limit Service based on the provided infra.
 func (r *ResourceRender) Service() (*corev1.Service, error) {
 	const apiVersion = "v1"
@@ -172,8 +189,9 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 					Containers:                    containers,
 					ServiceAccountName:            InfraName,
 					AutomountServiceAccountToken:  pointer.Bool(false),
-					TerminationGracePeriodSeconds: pointer.Int64(int64(300)),
-					DNSPolicy:                     corev1.DNSClusterFirst,
+					TerminationGracePeriodSeconds: resource.ExpectedTerminationGracePeriodSeconds(r.rateLimitDeployment.Pod),
+					HostNetwork:                   r.rateLimitDeployment.Pod.HostNetwork,
+					DNSPolicy:                     resource.ExpectedDNSPolicy(r.rateLimitDeployment.Pod),
 					RestartPolicy:                 corev1.RestartPolicyAlways,
 					SchedulerName:                 "default-scheduler",
 					SecurityContext:               r.rateLimitDeployment.Pod.SecurityContext,
@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 )
@@ -102,3 +103,25 @@ func ExpectedContainerVolumeMounts(container *egcfgv1a1.KubernetesContainerSpec,
 
 	return volumeMounts
 }
+
+// ExpectedDNSPolicy returns the DNS policy of the pods. The pods in the host
+// network keep resolving the cluster names unless a DNS policy is set.
+func ExpectedDNSPolicy(pod *egcfgv1a1.KubernetesPodSpec) corev1.DNSPolicy {
+	switch {
+	case pod.DNSPolicy != nil:
+		return *pod.DNSPolicy
+	case pod.HostNetwork:
+		return corev1.DNSClusterFirstWithHostNet
+	default:
+		return corev1.DNSClusterFirst
+	}
+}
+
+// ExpectedTerminationGracePeriodSeconds returns the duration in seconds the pods
+// need to terminate gracefully.
+func ExpectedTerminationGracePeriodSeconds(pod *egcfgv1a1.KubernetesPodSpec) *int64 {
+	if pod.TerminationGracePeriodSeconds != nil {
+		return pod.TerminationGracePeriodSeconds
+	}
+	return pointer.Int64(egcfgv1a1.DefaultTerminationGracePeriodSeconds)
+}
@@ -14,7 +14,9 @@ import (
 	"golang.org/x/exp/slices"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -29,23 +31,33 @@ var (
 	ErrTCPListenerSNIsEmpty          = errors.New("field SNIs must be specified with at least a single server name entry")
 	ErrTLSServerCertEmpty            = errors.New("field ServerCertificate must be specified")
 	ErrTLSPrivateKey                 = errors.New("field PrivateKey must be specified")
+	ErrTLSCACertificateEmpty         = errors.New("field CACertificate must be specified")
+	ErrTLSCACertificateNameEmpty     = errors.New("field Name must be specified")
+	ErrTLSUpstreamSNIEmpty           = errors.New("field SNI must be specified")
 	ErrHTTPRouteNameEmpty            = errors.New("field Name must be specified")
 	ErrHTTPRouteHostnameEmpty        = errors.New("field Hostname must be specified")
 	ErrHTTPRouteMatchEmpty           = errors.New("either PathMatch, HeaderMatches or QueryParamMatches fields must be specified")
 	ErrDestinationNameEmpty          = errors.New("field Name must be specified")
-	ErrDestEndpointHostInvalid       = errors.New("field Address must be a valid IP address")
+	ErrDestEndpointHostInvalid       = errors.New("field Address must be a valid IP address or FQDN")
 	ErrDestEndpointPortInvalid       = errors.New("field Port specified is invalid")
 	ErrStringMatchConditionInvalid   = errors.New("only one of the Exact, Prefix, SafeRegex or Distinct fields must be set")
 	ErrStringMatchNameIsEmpty        = errors.New("field Name must be specified")
 	ErrDirectResponseStatusInvalid   = errors.New("only HTTP status codes 100 - 599 are supported for DirectResponse")
 	ErrRedirectUnsupportedStatus     = errors.New("only HTTP status codes 301 and 302 are supported for redirect filters")
 	ErrRedirectUnsupportedScheme     = errors.New("only http and https are supported for the scheme in redirect filters")
-	ErrHTTPPathModifierDoubleReplace = errors.New("redirect filter cannot have a path modifier that supplies both fullPathReplace and prefixMatchReplace")
-	ErrHTTPPathModifierNoReplace     = errors.New("redirect filter cannot have a path modifier that does not supply either fullPathReplace or prefixMatchReplace")
+	ErrRedirectUnsupportedRegexPath  = errors.New("redirect filters do not support regexMatchReplace path modifiers")
+	ErrHTTPPathModifierDoubleReplace = errors.New("redirect filter cannot have a path modifier that supplies more than one of fullPathReplace, prefixMatchReplace and regexMatchReplace")
+	ErrHTTPPathModifierNoReplace     = errors.New("redirect filter cannot have a path modifier that does not supply either fullPathReplace, prefixMatchReplace or regexMatchReplace")
+	ErrURLRewriteMultipleHostnames   = errors.New("urlRewrite can only set one of hostname, hostnameHeader and backendHostname")
+	ErrRegexMatchReplaceEmptyPattern = errors.New("regexMatchReplace pattern must be set")
+	ErrMirrorDestinationEmpty        = errors.New("mirror destination must be set")
+	ErrMirrorPercentageInvalid       = errors.New("mirror percentage must be between 0 and 100")
 	ErrAddHeaderEmptyName            = errors.New("header modifier filter cannot configure a header without a name to be added")
 	ErrAddHeaderDuplicate            = errors.New("header modifier filter attempts to add the same header more than once (case insensitive)")
 	ErrRemoveHeaderDuplicate         = errors.New("header modifier filter attempts to remove the same header more than once (case insensitive)")
 	ErrRequestAuthenRequiresJwt      = errors.New("jwt field is required when request authentication is set")
+	ErrVirtualClusterNameEmpty       = errors.New("field Name must be specified")
+	ErrVirtualClusterMatchEmpty      = errors.New("field HeaderMatches must be specified with at least a single match")
 )
 
 // Xds holds the intermediate representation of a Gateway and is
@@ -161,6 +173,8 @@ type HTTPListener struct {
 	Address string `json:"address" yaml:"address"`
 	// Port on which the service can be expected to be accessed by clients.
 	Port uint32 `json:"port" yaml:"port"`
+	// IPFamily specifies the IP family of the listener. If unset, IPv4 is used.
+	IPFamily *egcfgv1a1.IPFamily `json:"ipFamily,omitempty" yaml:"ipFamily,omitempty"`
 	// Hostnames (Host/Authority header value) with which the service can be expected to be accessed by clients.
 	// This field is required. Wildcard hosts are supported in the suffix or prefix form.
 	// Refer to https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/route/v3/route_components.proto#config-route-v3-virtualhost
@@ -172,6 +186,33 @@ type HTTPListener struct {
 	Routes []*HTTPRoute `json:"routes,omitempty" yaml:"routes,omitempty"`
 	// IsHTTP2 is set if the upstream client as well as the downstream server are configured to serve HTTP2 traffic.
 	IsHTTP2 bool `json:"isHTTP2" yaml:"isHTTP2"`
+	// TLSSettings holds the TLS parameters used when terminating TLS connections.
+	// Only applies when TLS is set.
+	TLSSettings *TLSSettings `json:"tlsSettings,omitempty" yaml:"tlsSettings,omitempty"`
+	// Headers holds the settings for the headers of the requests received by the listener.
+	Headers *HeaderSettings `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Timeout holds the timeouts of the connections and requests received by the listener.
+	Timeout *ClientTimeout `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// Path holds the settings used to normalize the path of the requests received by the listener.
+	// If unset, the path is normalized, adjacent slashes are merged and escaped slashes
+	// are unescaped with a redirect.
+	Path *PathSettings `json:"path,omitempty" yaml:"path,omitempty"`
+	// HTTP1 holds the HTTP/1 protocol options of the listener.
+	HTTP1 *HTTP1Settings `json:"http1,omitempty" yaml:"http1,omitempty"`
+	// Upgrades holds the HTTP protocol upgrades and the CONNECT requests accepted by the listener.
+	// If unset, only the WebSocket upgrades are accepted, unless the listener is HTTP2.
+	Upgrades *Upgrades `json:"upgrades,omitempty" yaml:"upgrades,omitempty"`
+	// LocalReply defines the custom responses returned in place of the local replies of Envoy Proxy.
+	LocalReply *LocalReply `json:"localReply,omitempty" yaml:"localReply,omitempty"`
+	// Compression holds the settings used to compress the responses sent by the listener.
+	Compression *Compression `json:"compression,omitempty" yaml:"compression,omitempty"`
+	// EnvoyExtensions holds the external processors, Lua filters and Wasm modules run on all the requests received by the listener.
+	EnvoyExtensions *EnvoyExtensions `json:"envoyExtensions,omitempty" yaml:"envoyExtensions,omitempty"`
+	// Tracing overrides the tracing of the requests on all the routes of the listener.
+	Tracing *RouteTracing `json:"tracing,omitempty" yaml:"tracing,omitempty"`
+	// StatPrefix is the prefix of the statistics of the HTTP connection manager of the listener.
+	// If unset, "https" is used for the listeners with TLS and "http" for the others.
+	StatPrefix string `json:"statPrefix,omitempty" yaml:"statPrefix,omitempty"`
 }
 
 // Validate the fields within the HTTPListener structure
@@ -196,6 +237,11 @@ func (h HTTPListener) Validate() error {
 			}
 		}
 	}
+	if h.TLSSettings != nil {
+		if err := h.TLSSettings.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 	for _, route := range h.Routes {
 		if err := route.Validate(); err != nil {
 			errs = multierror.Append(errs, err)
@@ -227,6 +273,157 @@ func (t TLSListenerConfig) Validate() error {
 	return errs
 }
 
+// TLSSettings holds the TLS parameters used when terminating downstream
+// TLS connections.
+// +k8s:deepcopy-gen=true
+type TLSSettings struct {
+	// MinVersion is the minimum TLS version supported.
+	MinVersion *egv1a1.TLSVersion `json:"minVersion,omitempty" yaml:"minVersion,omitempty"`
+	// MaxVersion is the maximum TLS version supported.
+	MaxVersion *egv1a1.TLSVersion `json:"maxVersion,omitempty" yaml:"maxVersion,omitempty"`
+	// Ciphers is the set of cipher suites supported when negotiating TLS 1.0 - 1.2.
+	Ciphers []string `json:"ciphers,omitempty" yaml:"ciphers,omitempty"`
+	// ECDHCurves is the set of supported ECDH curves.
+	ECDHCurves []string `json:"ecdhCurves,omitempty" yaml:"ecdhCurves,omitempty"`
+	// ALPNProtocols is the list of ALPN protocols exposed by the listener.
+	// If empty, h2 and http/1.1 are exposed.
+	ALPNProtocols []string `json:"alpnProtocols,omitempty" yaml:"alpnProtocols,omitempty"`
+	// ClientCertificateValidation holds the configuration used to validate
+	// client certificates, i.e. mutual TLS.
+	ClientCertificateValidation *ClientCertificateValidation `json:"clientCertificateValidation,omitempty" yaml:"clientCertificateValidation,omitempty"`
+}
+
+// Validate the fields within the TLSSettings structure
+func (t TLSSettings) Validate() error {
+	if t.ClientCertificateValidation != nil {
+		return t.ClientCertificateValidation.Validate()
+	}
+	return nil
+}
+
+// ClientCertificateValidation holds the configuration used to validate
+// the certificates presented by downstream clients.
+// +k8s:deepcopy-gen=true
+type ClientCertificateValidation struct {
+	// Name of the xDS secret holding the CA certificate bundle.
+	Name string `json:"name" yaml:"name"`
+	// CACertificate is the PEM encoded bundle of trusted CA certificates.
+	CACertificate []byte `json:"caCertificate,omitempty" yaml:"caCertificate,omitempty"`
+	// SubjectAltNames is an allow-list of Subject Alternative Names.
+	// If empty, any certificate signed by a trusted CA is accepted.
+	SubjectAltNames []egv1a1.SubjectAltName `json:"subjectAltNames,omitempty" yaml:"subjectAltNames,omitempty"`
+	// Optional is set if connections without a client certificate are accepted.
+	Optional bool `json:"optional,omitempty" yaml:"optional,omitempty"`
+}
+
+// Validate the fields within the ClientCertificateValidation structure
+func (c ClientCertificateValidation) Validate() error {
+	var errs error
+	if c.Name == "" {
+		errs = multierror.Append(errs, ErrTLSCACertificateNameEmpty)
+	}
+	if len(c.CACertificate) == 0 {
+		errs = multierror.Append(errs, ErrTLSCACertificateEmpty)
+	}
+	return errs
+}
+
+// HeaderSettings holds the settings for the headers of requests
+// received by a listener.
+// +k8s:deepcopy-gen=true
+type HeaderSettings struct {
+	// XForwardedClientCert configures the handling of the x-forwarded-client-cert header.
+	XForwardedClientCert *XForwardedClientCert `json:"xForwardedClientCert,omitempty" yaml:"xForwardedClientCert,omitempty"`
+	// MaxRequestHeadersKiB is the maximum size, in KiB, of the request headers.
+	MaxRequestHeadersKiB *uint32 `json:"maxRequestHeadersKiB,omitempty" yaml:"maxRequestHeadersKiB,omitempty"`
+	// MaxRequestHeaders is the maximum number of request headers.
+	MaxRequestHeaders *uint32 `json:"maxRequestHeaders,omitempty" yaml:"maxRequestHeaders,omitempty"`
+}
+
+// ClientTimeout holds the timeouts of the connections and requests
+// received by a listener.
+// +k8s:deepcopy-gen=true
+type ClientTimeout struct {
+	// Request is the time allowed to receive the entire request.
+	Request *metav1.Duration `json:"request,omitempty" yaml:"request,omitempty"`
+	// RequestHeaders is the time allowed to receive the request headers.
+	RequestHeaders *metav1.Duration `json:"requestHeaders,omitempty" yaml:"requestHeaders,omitempty"`
+	// Idle is the idle timeout of the connections.
+	Idle *metav1.Duration `json:"idle,omitempty" yaml:"idle,omitempty"`
+	// StreamIdle is the idle timeout of the requests.
+	StreamIdle *metav1.Duration `json:"streamIdle,omitempty" yaml:"streamIdle,omitempty"`
+}
+
+// PathSettings holds the settings used to normalize the path of
+// the requests received by a listener.
+// +k8s:deepcopy-gen=true
+type PathSettings struct {
+	// DisableNormalization disables the RFC 3986 normalization of the path.
+	DisableNormalization bool `json:"disableNormalization,omitempty" yaml:"disableNormalization,omitempty"`
+	// DisableMergeSlashes disables merging adjacent slashes in the path.
+	DisableMergeSlashes bool `json:"disableMergeSlashes,omitempty" yaml:"disableMergeSlashes,omitempty"`
+	// EscapedSlashesAction is the action taken when the path contains escaped slashes.
+	EscapedSlashesAction egv1a1.PathEscapedSlashAction `json:"escapedSlashesAction" yaml:"escapedSlashesAction"`
+}
+
+// HTTP1Settings holds the HTTP/1 protocol options of a listener.
+// +k8s:deepcopy-gen=true
+type HTTP1Settings struct {
+	// PreserveHeaderCase preserves the case of the HTTP/1 headers.
+	PreserveHeaderCase bool `json:"preserveHeaderCase,omitempty" yaml:"preserveHeaderCase,omitempty"`
+	// EnableHTTP10 accepts HTTP/1.0 requests.
+	EnableHTTP10 bool `json:"enableHTTP10,omitempty" yaml:"enableHTTP10,omitempty"`
+}
+
+// Upgrades holds the HTTP protocol upgrades and the CONNECT requests accepted by a listener or a route.
+// +k8s:deepcopy-gen=true
+type Upgrades struct {
+	// Types holds the upgrade types enabled or disabled.
+	Types []HTTPUpgrade `json:"types,omitempty" yaml:"types,omitempty"`
+	// IdleTimeout of the upgraded streams and the CONNECT tunnels.
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty"`
+}
+
+// HTTPUpgrade enables or disables an upgrade type.
+// +k8s:deepcopy-gen=true
+type HTTPUpgrade struct {
+	// Type of the upgrade, such as "websocket", "CONNECT" or "CONNECT-UDP".
+	Type string `json:"type" yaml:"type"`
+	// Enabled accepts the upgrade type.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+// LocalReply holds the custom responses returned in place of the local replies of Envoy Proxy.
+// +k8s:deepcopy-gen=true
+type LocalReply struct {
+	// Mappings are evaluated in order, the first matching mapping is applied.
+	Mappings []*LocalReplyMapping `json:"mappings,omitempty" yaml:"mappings,omitempty"`
+}
+
+// LocalReplyMapping holds a custom response and the local replies it applies to.
+// +k8s:deepcopy-gen=true
+type LocalReplyMapping struct {
+	// StatusCodes matches the local replies with any of the status codes.
+	StatusCodes []uint32 `json:"statusCodes,omitempty" yaml:"statusCodes,omitempty"`
+	// ResponseFlags matches the local replies with any of the Envoy response flags.
+	ResponseFlags []string `json:"responseFlags,omitempty" yaml:"responseFlags,omitempty"`
+	// StatusCode overrides the status code of the local reply.
+	StatusCode *uint32 `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
+	// Body overrides the body of the local reply.
+	Body *string `json:"body,omitempty" yaml:"body,omitempty"`
+	// ContentType is the content type of the body.
+	ContentType *string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+}
+
+// XForwardedClientCert holds the configuration for the x-forwarded-client-cert header.
+// +k8s:deepcopy-gen=true
+type XForwardedClientCert struct {
+	// Mode defines how the header is forwarded to the upstream.
+	Mode egv1a1.XFCCForwardMode `json:"mode" yaml:"mode"`
+	// CertDetailsToAdd are the fields of the client certificate added to the header.
+	CertDetailsToAdd []egv1a1.XFCCCertData `json:"certDetailsToAdd,omitempty" yaml:"certDetailsToAdd,omitempty"`
+}
+
 // BackendWeights stores the weights of valid and invalid backends for the route so that 500 error responses can be returned in the same proportions
 type BackendWeights struct {
 	Valid   uint32 `json:"valid" yaml:"valid"`
@@ -260,8 +457,8 @@ type HTTPRoute struct {
 	DirectResponse *DirectResponse `json:"directResponse,omitempty" yaml:"directResponse,omitempty"`
 	// Redirections to be returned for this route. Takes precedence over Destinations.
 	Redirect *Redirect `json:"redirect,omitempty" yaml:"redirect,omitempty"`
-	// Destination that requests to this HTTPRoute will be mirrored to
-	Mirror *RouteDestination `json:"mirror,omitempty" yaml:"mirror,omitempty"`
+	// Mirrors defines the destinations that requests to this HTTPRoute will be mirrored to
+	Mirrors []*MirrorPolicy `json:"mirrors,omitempty" yaml:"mirrors,omitempty"`
 	// Destination associated with this matched route.
 	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
 	// Rewrite to be changed for this route.
@@ -271,6 +468,22 @@ type HTTPRoute struct {
 	RateLimit *RateLimit `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
 	// RequestAuthentication defines the schema for authenticating HTTP requests.
 	RequestAuthentication *RequestAuthentication `json:"requestAuthentication,omitempty" yaml:"requestAuthentication,omitempty"`
+	// FaultInjection defines the delays and aborts injected into the requests on this route.
+	FaultInjection *FaultInjection `json:"faultInjection,omitempty" yaml:"faultInjection,omitempty"`
+	// GRPCTranscoding defines the gRPC-Web and gRPC-JSON transcoding of the requests on this route.
+	GRPCTranscoding *GRPCTranscoding `json:"grpcTranscoding,omitempty" yaml:"grpcTranscoding,omitempty"`
+	// Upgrades overrides the HTTP protocol upgrades and the CONNECT requests accepted by the listener on this route.
+	Upgrades *Upgrades `json:"upgrades,omitempty" yaml:"upgrades,omitempty"`
+	// DisableCompression disables the compression of the responses on this route.
+	DisableCompression bool `json:"disableCompression,omitempty" yaml:"disableCompression,omitempty"`
+	// EnvoyExtensions holds the external processors, Lua filters and Wasm modules run on the requests on this route.
+	EnvoyExtensions *EnvoyExtensions `json:"envoyExtensions,omitempty" yaml:"envoyExtensions,omitempty"`
+	// Tracing overrides the tracing of the requests on this route. Takes precedence over the tracing of the listener.
+	Tracing *RouteTracing `json:"tracing,omitempty" yaml:"tracing,omitempty"`
+	// AccessLog holds the access logs of the requests on this route, in addition to the access logs of the gateway.
+	AccessLog *RouteAccessLog `json:"accessLog,omitempty" yaml:"accessLog,omitempty"`
+	// VirtualClusters collect the statistics of the requests to the hostname of this route that match them.
+	VirtualClusters []*VirtualCluster `json:"virtualClusters,omitempty" yaml:"virtualClusters,omitempty"`
 	// ExtensionRefs holds unstructured resources that were introduced by an extension and used on the HTTPRoute as extensionRef filters
 	ExtensionRefs []*UnstructuredRef `json:"extensionRefs,omitempty" yaml:"extensionRefs,omitempty"`
 }
@@ -351,8 +564,8 @@ func (h HTTPRoute) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
-	if h.Mirror != nil {
-		if err := h.Mirror.Validate(); err != nil {
+	for _, mirror := range h.Mirrors {
+		if err := mirror.Validate(); err != nil {
 			errs = multierror.Append(errs, err)
 		}
 	}
@@ -416,6 +629,11 @@ func (h HTTPRoute) Validate() error {
 			}
 		}
 	}
+	for _, vc := range h.VirtualClusters {
+		if err := vc.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 	return errs
 }
 
@@ -429,6 +647,58 @@ func (j *JwtRequestAuthentication) Validate() error {
 	return errs
 }
 
+// VirtualCluster holds the match conditions of the requests whose statistics are
+// collected under the name of the virtual cluster.
+// +k8s:deepcopy-gen=true
+type VirtualCluster struct {
+	// Name of the virtual cluster, used in the names of its statistics.
+	Name string `json:"name" yaml:"name"`
+	// HeaderMatches define the match conditions on the request headers, including
+	// the :path and :method pseudo-headers.
+	HeaderMatches []*StringMatch `json:"headerMatches" yaml:"headerMatches"`
+}
+
+// Validate the fields within the VirtualCluster structure
+func (v VirtualCluster) Validate() error {
+	var errs error
+	if v.Name == "" {
+		errs = multierror.Append(errs, ErrVirtualClusterNameEmpty)
+	}
+	if len(v.HeaderMatches) == 0 {
+		errs = multierror.Append(errs, ErrVirtualClusterMatchEmpty)
+	}
+	for _, hMatch := range v.HeaderMatches {
+		if err := hMatch.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// MirrorPolicy holds the destination that a sample of the requests are mirrored to
+// +k8s:deepcopy-gen=true
+type MirrorPolicy struct {
+	// Destination that the requests are mirrored to.
+	Destination *RouteDestination `json:"destination" yaml:"destination"`
+	// Percentage of the requests mirrored. Defaults to 100.
+	Percentage *uint32 `json:"percentage,omitempty" yaml:"percentage,omitempty"`
+}
+
+// Validate the fields within the MirrorPolicy structure
+func (m MirrorPolicy) Validate() error {
+	var errs error
+	if m.Destination == nil {
+		errs = multierror.Append(errs, ErrMirrorDestinationEmpty)
+	} else if err := m.Destination.Validate(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if m.Percentage != nil && *m.Percentage > 100 {
+		errs = multierror.Append(errs, ErrMirrorPercentageInvalid)
+	}
+
+	return errs
+}
+
 // RouteDestination holds the destination details associated with the route
 // +kubebuilder:object:generate=true
 type RouteDestination struct {
@@ -437,6 +707,24 @@ type RouteDestination struct {
 	// reused
 	Name      string                 `json:"name" yaml:"name"`
 	Endpoints []*DestinationEndpoint `json:"endpoints,omitempty" yaml:"endpoints,omitempty"`
+	// TLS configuration used to connect to the endpoints.
+	// If unset, connections to the endpoints are plaintext.
+	TLS *TLSUpstreamConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
+	// DNS configures how the FQDN endpoints are resolved.
+	DNS *DNSSettings `json:"dns,omitempty" yaml:"dns,omitempty"`
+	// StatName is the name used in the statistics of the destination in place of its name.
+	StatName string `json:"statName,omitempty" yaml:"statName,omitempty"`
+}
+
+// HasFQDNEndpoints returns true if any of the endpoints of the destination
+// is an FQDN, which must be resolved through DNS.
+func (r RouteDestination) HasFQDNEndpoints() bool {
+	for _, ep := range r.Endpoints {
+		if net.ParseIP(ep.Host) == nil {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate the fields within the RouteDestination structure
@@ -450,11 +738,61 @@ func (r RouteDestination) Validate() error {
 			errs = multierror.Append(errs, err)
 		}
 	}
+	if r.TLS != nil {
+		if err := r.TLS.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
 
 	return errs
 
 }
 
+// TLSUpstreamConfig holds the TLS configuration used to connect to the
+// endpoints of a destination.
+// +k8s:deepcopy-gen=true
+type TLSUpstreamConfig struct {
+	// Name of the xDS secret holding the CA certificate bundle.
+	Name string `json:"name" yaml:"name"`
+	// SNI is the server name sent to the endpoints. The certificate presented
+	// by the endpoints must contain it as a DNS Subject Alternative Name.
+	SNI string `json:"sni" yaml:"sni"`
+	// CACertificate is the PEM encoded bundle of CA certificates trusted
+	// to sign the certificates presented by the endpoints.
+	CACertificate []byte `json:"caCertificate,omitempty" yaml:"caCertificate,omitempty"`
+	// ClientCertificate is presented to the endpoints for mutual TLS.
+	ClientCertificate *TLSListenerConfig `json:"clientCertificate,omitempty" yaml:"clientCertificate,omitempty"`
+}
+
+// Validate the fields within the TLSUpstreamConfig structure
+func (t TLSUpstreamConfig) Validate() error {
+	var errs error
+	if t.Name == "" {
+		errs = multierror.Append(errs, ErrTLSCACertificateNameEmpty)
+	}
+	if t.SNI == "" {
+		errs = multierror.Append(errs, ErrTLSUpstreamSNIEmpty)
+	}
+	if len(t.CACertificate) == 0 {
+		errs = multierror.Append(errs, ErrTLSCACertificateEmpty)
+	}
+	if t.ClientCertificate != nil {
+		if err := t.ClientCertificate.Validate(); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// DNSSettings holds the settings used to resolve the FQDN endpoints of a destination.
+// +k8s:deepcopy-gen=true
+type DNSSettings struct {
+	// RefreshRate is the interval at which the FQDN endpoints are resolved.
+	RefreshRate *metav1.Duration `json:"refreshRate,omitempty" yaml:"refreshRate,omitempty"`
+	// RespectDNSTTL uses the TTL of the DNS records as the refresh interval.
+	RespectDNSTTL *bool `json:"respectDnsTtl,omitempty" yaml:"respectDnsTtl,omitempty"`
+}
+
 // DestinationEndpoint holds the endpoint details associated with the destination
 // +kubebuilder:object:generate=true
 type DestinationEndpoint struct {
@@ -470,8 +808,7 @@ type DestinationEndpoint struct {
 // Validate the fields within the DestinationEndpoint structure
 func (d DestinationEndpoint) Validate() error {
 	var errs error
-	// Only support IP hosts for now
-	if ip := net.ParseIP(d.Host); ip == nil {
+	if ip := net.ParseIP(d.Host); ip == nil && len(utilvalidation.IsDNS1123Subdomain(d.Host)) > 0 {
 		errs = multierror.Append(errs, ErrDestEndpointHostInvalid)
 	}
 	if d.Port == 0 {
@@ -542,6 +879,12 @@ type URLRewrite struct {
 	Path *HTTPPathModifier `json:"path,omitempty" yaml:"path,omitempty"`
 	// Hostname configures the replacement of the request's hostname.
 	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	// HostnameHeader configures the replacement of the request's hostname
+	// with the value of the provided request header.
+	HostnameHeader *string `json:"hostnameHeader,omitempty" yaml:"hostnameHeader,omitempty"`
+	// BackendHostname configures the replacement of the request's hostname
+	// with the hostname of the selected backend endpoint.
+	BackendHostname bool `json:"backendHostname,omitempty" yaml:"backendHostname,omitempty"`
 }
 
 // Validate the fields within the URLRewrite structure
@@ -554,6 +897,20 @@ func (r URLRewrite) Validate() error {
 		}
 	}
 
+	hostnames := 0
+	if r.Hostname != nil {
+		hostnames++
+	}
+	if r.HostnameHeader != nil {
+		hostnames++
+	}
+	if r.BackendHostname {
+		hostnames++
+	}
+	if hostnames > 1 {
+		errs = multierror.Append(errs, ErrURLRewriteMultipleHostnames)
+	}
+
 	return errs
 }
 
@@ -586,6 +943,9 @@ func (r Redirect) Validate() error {
 		if err := r.Path.Validate(); err != nil {
 			errs = multierror.Append(errs, err)
 		}
+		if r.Path.RegexMatchReplace != nil {
+			errs = multierror.Append(errs, ErrRedirectUnsupportedRegexPath)
+		}
 	}
 
 	if r.StatusCode != nil {
@@ -604,23 +964,48 @@ type HTTPPathModifier struct {
 	FullReplace *string `json:"fullReplace" yaml:"fullReplace"`
 	// PrefixMatchReplace provides a string to replace the matched prefix of the request.
 	PrefixMatchReplace *string `json:"prefixMatchReplace" yaml:"prefixMatchReplace"`
+	// RegexMatchReplace provides a regular expression substitution of the path of the request.
+	RegexMatchReplace *RegexMatchReplace `json:"regexMatchReplace,omitempty" yaml:"regexMatchReplace,omitempty"`
 }
 
 // Validate the fields within the HTTPPathModifier structure
 func (r HTTPPathModifier) Validate() error {
 	var errs error
 
-	if r.FullReplace != nil && r.PrefixMatchReplace != nil {
+	replaces := 0
+	if r.FullReplace != nil {
+		replaces++
+	}
+	if r.PrefixMatchReplace != nil {
+		replaces++
+	}
+	if r.RegexMatchReplace != nil {
+		replaces++
+		if r.RegexMatchReplace.Pattern == "" {
+			errs = multierror.Append(errs, ErrRegexMatchReplaceEmptyPattern)
+		}
+	}
+
+	if replaces > 1 {
 		errs = multierror.Append(errs, ErrHTTPPathModifierDoubleReplace)
 	}
 
-	if r.FullReplace == nil && r.PrefixMatchReplace == nil {
+	if replaces == 0 {
 		errs = multierror.Append(errs, ErrHTTPPathModifierNoReplace)
 	}
 
 	return errs
 }
 
+// RegexMatchReplace holds a regular expression substitution
+// +k8s:deepcopy-gen=true
+type RegexMatchReplace struct {
+	// Pattern is the regular expression matching the parts of the path to replace.
+	Pattern string `json:"pattern" yaml:"pattern"`
+	// Substitution replaces the matched parts of the path.
+	Substitution string `json:"substitution" yaml:"substitution"`
+}
+
 // StringMatch holds the various match conditions.
 // Only one of Exact, Prefix, SafeRegex or Distinct can be set.
 // +k8s:deepcopy-gen=true
@@ -679,6 +1064,8 @@ type TCPListener struct {
 	Address string `json:"address" yaml:"address"`
 	// Port on which the service can be expected to be accessed by clients.
 	Port uint32 `json:"port" yaml:"port"`
+	// IPFamily specifies the IP family of the listener. If unset, IPv4 is used.
+	IPFamily *egcfgv1a1.IPFamily `json:"ipFamily,omitempty" yaml:"ipFamily,omitempty"`
 	// TLS holds information for configuring TLS on a listener
 	TLS *TLS `json:"tls,omitempty" yaml:"tls,omitempty"`
 	// Destinations associated with TCP traffic to the service.
@@ -757,6 +1144,8 @@ type UDPListener struct {
 	Address string `json:"address" yaml:"address"`
 	// Port on which the service can be expected to be accessed by clients.
 	Port uint32 `json:"port" yaml:"port"`
+	// IPFamily specifies the IP family of the listener. If unset, IPv4 is used.
+	IPFamily *egcfgv1a1.IPFamily `json:"ipFamily,omitempty" yaml:"ipFamily,omitempty"`
 	// Destination associated with UDP traffic to the service.
 	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
 }
@@ -782,6 +1171,167 @@ func (h UDPListener) Validate() error {
 	return errs
 }
 
+// FaultInjection holds the delays and aborts injected into the requests of a route.
+// +k8s:deepcopy-gen=true
+type FaultInjection struct {
+	// Delay added to the requests.
+	Delay *FaultInjectionDelay `json:"delay,omitempty" yaml:"delay,omitempty"`
+	// Abort returned to the requests.
+	Abort *FaultInjectionAbort `json:"abort,omitempty" yaml:"abort,omitempty"`
+	// MaxActiveFaults is the maximum number of requests delayed or aborted at the same time.
+	MaxActiveFaults *uint32 `json:"maxActiveFaults,omitempty" yaml:"maxActiveFaults,omitempty"`
+}
+
+// FaultInjectionDelay holds the delay added to the requests.
+// +k8s:deepcopy-gen=true
+type FaultInjectionDelay struct {
+	// FixedDelay added to the requests.
+	FixedDelay *metav1.Duration `json:"fixedDelay,omitempty" yaml:"fixedDelay,omitempty"`
+	// HeaderControlled reads the delay from the request headers.
+	HeaderControlled bool `json:"headerControlled,omitempty" yaml:"headerControlled,omitempty"`
+	// Percentage of the requests delayed.
+	Percentage *uint32 `json:"percentage,omitempty" yaml:"percentage,omitempty"`
+}
+
+// FaultInjectionAbort holds the error returned to the requests.
+// +k8s:deepcopy-gen=true
+type FaultInjectionAbort struct {
+	// HTTPStatus returned to the requests.
+	HTTPStatus *int32 `json:"httpStatus,omitempty" yaml:"httpStatus,omitempty"`
+	// GRPCStatus returned to the requests.
+	GRPCStatus *int32 `json:"grpcStatus,omitempty" yaml:"grpcStatus,omitempty"`
+	// HeaderControlled reads the status from the request headers.
+	HeaderControlled bool `json:"headerControlled,omitempty" yaml:"headerControlled,omitempty"`
+	// Percentage of the requests aborted.
+	Percentage *uint32 `json:"percentage,omitempty" yaml:"percentage,omitempty"`
+}
+
+// GRPCTranscoding holds the transcoding of the requests of a route to gRPC requests.
+// +k8s:deepcopy-gen=true
+type GRPCTranscoding struct {
+	// JSON transcodes the RESTful JSON requests.
+	JSON *GRPCJSONTranscoder `json:"json,omitempty" yaml:"json,omitempty"`
+}
+
+// GRPCJSONTranscoder holds the settings of a gRPC-JSON transcoder.
+// +k8s:deepcopy-gen=true
+type GRPCJSONTranscoder struct {
+	// Name of the transcoder, unique across the routes of a listener.
+	Name string `json:"name" yaml:"name"`
+	// DescriptorSet is the serialized proto descriptor set of the gRPC services.
+	DescriptorSet []byte `json:"descriptorSet" yaml:"descriptorSet"`
+	// Services are the fully qualified names of the transcoded gRPC services.
+	Services []string `json:"services" yaml:"services"`
+	// PrintOptions defines how the gRPC responses are printed as JSON.
+	PrintOptions *egv1a1.GRPCJSONPrintOptions `json:"printOptions,omitempty" yaml:"printOptions,omitempty"`
+	// IgnoreUnknownQueryParameters ignores the query parameters not mapped to the gRPC requests.
+	IgnoreUnknownQueryParameters bool `json:"ignoreUnknownQueryParameters,omitempty" yaml:"ignoreUnknownQueryParameters,omitempty"`
+}
+
+// Compression holds the settings used to compress the responses of a listener.
+// +k8s:deepcopy-gen=true
+type Compression struct {
+	// Compressors is the list of compression algorithms, in order of preference.
+	Compressors []egv1a1.CompressorType `json:"compressors,omitempty" yaml:"compressors,omitempty"`
+	// MinContentLength is the minimum size of the responses to compress.
+	MinContentLength *uint32 `json:"minContentLength,omitempty" yaml:"minContentLength,omitempty"`
+	// ContentTypes is the list of content types of the responses to compress.
+	ContentTypes []string `json:"contentTypes,omitempty" yaml:"contentTypes,omitempty"`
+	// DisableOnETagHeader disables the compression of the responses containing an ETag header.
+	DisableOnETagHeader bool `json:"disableOnETagHeader,omitempty" yaml:"disableOnETagHeader,omitempty"`
+}
+
+// RouteTracing overrides the tracing configured for the Gateway on the requests of a route.
+// +k8s:deepcopy-gen=true
+type RouteTracing struct {
+	// Disabled disables the tracing of the requests.
+	Disabled bool `json:"disabled,omitempty" yaml:"disabled,omitempty"`
+	// SamplingRate is the percentage of the requests randomly selected for tracing.
+	SamplingRate *uint32 `json:"samplingRate,omitempty" yaml:"samplingRate,omitempty"`
+	// ClientSamplingRate is the percentage of the requests traced when the client sets the x-client-trace-id header.
+	ClientSamplingRate *uint32 `json:"clientSamplingRate,omitempty" yaml:"clientSamplingRate,omitempty"`
+	// OverallSamplingRate is the maximum percentage of the requests traced.
+	OverallSamplingRate *uint32 `json:"overallSamplingRate,omitempty" yaml:"overallSamplingRate,omitempty"`
+	// CustomTags are the custom tags added to each span.
+	CustomTags map[string]egcfgv1a1.CustomTag `json:"customTags,omitempty" yaml:"customTags,omitempty"`
+	// OperationName is the name of the spans created for the requests.
+	OperationName string `json:"operationName,omitempty" yaml:"operationName,omitempty"`
+}
+
+// RouteAccessLog holds the access logs of the requests on a route.
+// +k8s:deepcopy-gen=true
+type RouteAccessLog struct {
+	// Name identifies the access logs. Routes with the same name share the same access logs.
+	Name string `json:"name" yaml:"name"`
+	// AccessLog holds the access logging configuration.
+	AccessLog *AccessLog `json:"accessLog,omitempty" yaml:"accessLog,omitempty"`
+}
+
+// EnvoyExtensions holds the external processors, Lua filters and Wasm modules run on the requests.
+// +k8s:deepcopy-gen=true
+type EnvoyExtensions struct {
+	// ExtProc are the external processors the requests are sent to, in order,
+	// before the Lua filters. The external processors of a route replace the
+	// ones of its listener.
+	ExtProc []*ExtProc `json:"extProc,omitempty" yaml:"extProc,omitempty"`
+	// Lua filters run on the requests, in order.
+	Lua []*Lua `json:"lua,omitempty" yaml:"lua,omitempty"`
+	// Wasm modules run on the requests, in order, after the Lua filters.
+	Wasm []*Wasm `json:"wasm,omitempty" yaml:"wasm,omitempty"`
+}
+
+// ExtProc holds an external processor.
+// +k8s:deepcopy-gen=true
+type ExtProc struct {
+	// Name is a unique name for the external processor.
+	Name string `json:"name" yaml:"name"`
+	// Destination is the gRPC service of the external processor.
+	Destination *RouteDestination `json:"destination" yaml:"destination"`
+	// Authority is the authority of the requests sent to the external processor.
+	Authority string `json:"authority" yaml:"authority"`
+	// ProcessingMode defines the parts of the requests and responses sent to the external processor.
+	ProcessingMode *egv1a1.ExtProcProcessingMode `json:"processingMode,omitempty" yaml:"processingMode,omitempty"`
+	// MessageTimeout is the time allowed to the external processor to respond to each message.
+	MessageTimeout *metav1.Duration `json:"messageTimeout,omitempty" yaml:"messageTimeout,omitempty"`
+	// FailOpen allows the requests to proceed when the external processor fails.
+	FailOpen bool `json:"failOpen,omitempty" yaml:"failOpen,omitempty"`
+}
+
+// Lua holds a Lua filter.
+// +k8s:deepcopy-gen=true
+type Lua struct {
+	// Name is a unique name for the Lua filter.
+	Name string `json:"name" yaml:"name"`
+	// Code is the source code of the Lua filter.
+	Code string `json:"code" yaml:"code"`
+}
+
+// Wasm holds a Wasm module.
+// +k8s:deepcopy-gen=true
+type Wasm struct {
+	// Name is a unique name for the Wasm module.
+	Name string `json:"name" yaml:"name"`
+	// RootID is the root ID of the module.
+	RootID *string `json:"rootID,omitempty" yaml:"rootID,omitempty"`
+	// Code is the code of the module.
+	Code *WasmCode `json:"code" yaml:"code"`
+	// Config is the JSON configuration passed to the module.
+	Config *string `json:"config,omitempty" yaml:"config,omitempty"`
+	// FailOpen allows the requests to proceed when the module fails.
+	FailOpen bool `json:"failOpen,omitempty" yaml:"failOpen,omitempty"`
+}
+
+// WasmCode holds the code of a Wasm module, either inline or downloaded from an URL.
+// +k8s:deepcopy-gen=true
+type WasmCode struct {
+	// Inline is the code of the module.
+	Inline []byte `json:"inline,omitempty" yaml:"inline,omitempty"`
+	// URL is the HTTP or HTTPS URL the code of the module is downloaded from.
+	URL *string `json:"url,omitempty" yaml:"url,omitempty"`
+	// SHA256 is the hex-encoded SHA-256 checksum of the code downloaded from the URL.
+	SHA256 string `json:"sha256,omitempty" yaml:"sha256,omitempty"`
+}
+
 // RateLimit holds the rate limiting configuration.
 // +k8s:deepcopy-gen=true
 type RateLimit struct {
@@ -837,20 +1387,48 @@ type AccessLog struct {
 	Text          []*TextAccessLog          `json:"text,omitempty" yaml:"text,omitempty"`
 	JSON          []*JSONAccessLog          `json:"json,omitempty" yaml:"json,omitempty"`
 	OpenTelemetry []*OpenTelemetryAccessLog `json:"openTelemetry,omitempty" yaml:"openTelemetry,omitempty"`
+	ALS           []*ALSAccessLog           `json:"als,omitempty" yaml:"als,omitempty"`
+}
+
+// AccessLogFilter holds the conditions a request must match to be logged.
+// +k8s:deepcopy-gen=true
+type AccessLogFilter struct {
+	StatusCodes    []StatusCodeRange  `json:"statusCodes,omitempty" yaml:"statusCodes,omitempty"`
+	MinDuration    *metav1.Duration   `json:"minDuration,omitempty" yaml:"minDuration,omitempty"`
+	ResponseFlags  []string           `json:"responseFlags,omitempty" yaml:"responseFlags,omitempty"`
+	RequestHeaders []string           `json:"requestHeaders,omitempty" yaml:"requestHeaders,omitempty"`
+	Sampling       *AccessLogSampling `json:"sampling,omitempty" yaml:"sampling,omitempty"`
+	NotHealthCheck bool               `json:"notHealthCheck,omitempty" yaml:"notHealthCheck,omitempty"`
+}
+
+// StatusCodeRange holds an inclusive range of response status codes.
+// +k8s:deepcopy-gen=true
+type StatusCodeRange struct {
+	Start uint32 `json:"start" yaml:"start"`
+	End   uint32 `json:"end" yaml:"end"`
+}
+
+// AccessLogSampling holds the percentage of the requests to log.
+// +k8s:deepcopy-gen=true
+type AccessLogSampling struct {
+	Percent    uint32 `json:"percent" yaml:"percent"`
+	RuntimeKey string `json:"runtimeKey" yaml:"runtimeKey"`
 }
 
 // TextAccessLog holds the configuration for text access logging.
 // +k8s:deepcopy-gen=true
 type TextAccessLog struct {
-	Format *string `json:"format,omitempty" yaml:"format,omitempty"`
-	Path   string  `json:"path" yaml:"path"`
+	Format *string          `json:"format,omitempty" yaml:"format,omitempty"`
+	Path   string           `json:"path" yaml:"path"`
+	Filter *AccessLogFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
 }
 
 // JSONAccessLog holds the configuration for JSON access logging.
 // +k8s:deepcopy-gen=true
 type JSONAccessLog struct {
-	JSON map[string]string `json:"json,omitempty" yaml:"json,omitempty"`
-	Path string            `json:"path" yaml:"path"`
+	JSON   map[string]string `json:"json,omitempty" yaml:"json,omitempty"`
+	Path   string            `json:"path" yaml:"path"`
+	Filter *AccessLogFilter  `json:"filter,omitempty" yaml:"filter,omitempty"`
 }
 
 // OpenTelemetryAccessLog holds the configuration for OpenTelemetry access logging.
@@ -861,6 +1439,26 @@ type OpenTelemetryAccessLog struct {
 	Host       string            `json:"host" yaml:"host"`
 	Port       uint32            `json:"port" yaml:"port"`
 	Resources  map[string]string `json:"resources,omitempty" yaml:"resources,omitempty"`
+	Filter     *AccessLogFilter  `json:"filter,omitempty" yaml:"filter,omitempty"`
+}
+
+// ALSAccessLog holds the configuration for gRPC Access Log Service access logging.
+// +k8s:deepcopy-gen=true
+type ALSAccessLog struct {
+	LogName string                               `json:"logName" yaml:"logName"`
+	Host    string                               `json:"host" yaml:"host"`
+	Port    uint32                               `json:"port" yaml:"port"`
+	Type    egcfgv1a1.ALSEnvoyProxyAccessLogType `json:"type" yaml:"type"`
+	HTTP    *ALSAccessLogHTTP                    `json:"http,omitempty" yaml:"http,omitempty"`
+	Filter  *AccessLogFilter                     `json:"filter,omitempty" yaml:"filter,omitempty"`
+}
+
+// ALSAccessLogHTTP holds the additional headers added to the HTTP access log entries.
+// +k8s:deepcopy-gen=true
+type ALSAccessLogHTTP struct {
+	RequestHeaders   []string `json:"requestHeaders,omitempty" yaml:"requestHeaders,omitempty"`
+	ResponseHeaders  []string `json:"responseHeaders,omitempty" yaml:"responseHeaders,omitempty"`
+	ResponseTrailers []string `json:"responseTrailers,omitempty" yaml:"responseTrailers,omitempty"`
 }
 
 // EnvoyPatchPolicy defines the intermediate representation of the EnvoyPatchPolicy resource.
@@ -910,6 +1508,44 @@ type JSONPatchOperation struct {
 // +k8s:deepcopy-gen=true
 type Tracing struct {
 	ServiceName string `json:"serviceName"`
+	// SamplingRate is the percentage of the requests that are traced.
+	SamplingRate uint32 `json:"samplingRate" yaml:"samplingRate"`
+	// CustomTags are the custom tags added to each span.
+	CustomTags map[string]egcfgv1a1.CustomTag `json:"customTags,omitempty" yaml:"customTags,omitempty"`
+	// OpenTelemetry is the OpenTelemetry collector the spans are exported to.
+	OpenTelemetry *OpenTelemetryTracing `json:"openTelemetry,omitempty" yaml:"openTelemetry,omitempty"`
+	// Zipkin is the Zipkin collector the spans are sent to.
+	Zipkin *ZipkinTracing `json:"zipkin,omitempty" yaml:"zipkin,omitempty"`
+	// Datadog is the Datadog agent the spans are sent to.
+	Datadog *DatadogTracing `json:"datadog,omitempty" yaml:"datadog,omitempty"`
+}
 
-	egcfgv1a1.ProxyTracing
+// OpenTelemetryTracing holds the configuration of an OpenTelemetry collector,
+// the spans are exported to it using OTLP over gRPC.
+// +k8s:deepcopy-gen=true
+type OpenTelemetryTracing struct {
+	Host string `json:"host" yaml:"host"`
+	Port uint32 `json:"port" yaml:"port"`
+}
+
+// ZipkinTracing holds the configuration of a Zipkin collector, the spans are
+// sent to it over HTTP.
+// +k8s:deepcopy-gen=true
+type ZipkinTracing struct {
+	Host string `json:"host" yaml:"host"`
+	Port uint32 `json:"port" yaml:"port"`
+	// CollectorEndpoint is the API path of the collector the spans are sent to.
+	CollectorEndpoint string `json:"collectorEndpoint" yaml:"collectorEndpoint"`
+	// Encoding is the encoding of the spans sent to the collector.
+	Encoding egcfgv1a1.ZipkinEncoding `json:"encoding" yaml:"encoding"`
+	// Enable128BitTraceID generates 128-bit trace IDs instead of 64-bit trace IDs.
+	Enable128BitTraceID bool `json:"enable128BitTraceId,omitempty" yaml:"enable128BitTraceId,omitempty"`
+}
+
+// DatadogTracing holds the configuration of a Datadog agent, the spans are
+// sent to it over HTTP.
+// +k8s:deepcopy-gen=true
+type DatadogTracing struct {
+	Host string `json:"host" yaml:"host"`
+	Port uint32 `json:"port" yaml:"port"`
 }
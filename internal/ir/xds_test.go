@@ -275,6 +275,39 @@ var (
 		},
 	}
 
+	urlRewriteRegexHTTPRoute = HTTPRoute{
+		Name:     "rewrite",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Prefix: ptrTo("/rewrite"),
+		},
+		URLRewrite: &URLRewrite{
+			HostnameHeader: ptrTo("x-host"),
+			Path: &HTTPPathModifier{
+				RegexMatchReplace: &RegexMatchReplace{
+					Pattern:      "^/rewrite/(.*)$",
+					Substitution: "/\\1",
+				},
+			},
+		},
+	}
+
+	urlRewriteFilterBadHostname = HTTPRoute{
+		Name:     "rewrite",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("rewrite"),
+		},
+		URLRewrite: &URLRewrite{
+			Hostname:        ptrTo("rewrite.example.com"),
+			BackendHostname: true,
+			Path: &HTTPPathModifier{
+				PrefixMatchReplace: ptrTo("/rewrite"),
+				RegexMatchReplace:  &RegexMatchReplace{},
+			},
+		},
+	}
+
 	addRequestHeaderHTTPRoute = HTTPRoute{
 		Name:     "addheader",
 		Hostname: "*",
@@ -456,7 +489,31 @@ var (
 		PathMatch: &StringMatch{
 			Exact: ptrTo("mirrorfilter"),
 		},
-		Mirror: &happyRouteDestination,
+		Mirrors: []*MirrorPolicy{
+			{
+				Destination: &happyRouteDestination,
+			},
+			{
+				Destination: &happyRouteDestination,
+				Percentage:  ptrTo(uint32(10)),
+			},
+		},
+	}
+	requestMirrorFilterBadMirror = HTTPRoute{
+		Name:     "mirrorfilter",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Exact: ptrTo("mirrorfilter"),
+		},
+		Mirrors: []*MirrorPolicy{
+			{
+				Percentage: ptrTo(uint32(10)),
+			},
+			{
+				Destination: &happyRouteDestination,
+				Percentage:  ptrTo(uint32(101)),
+			},
+		},
 	}
 
 	// RouteDestination
@@ -892,6 +949,16 @@ func TestValidateHTTPRoute(t *testing.T) {
 			input: urlRewriteFilterBadPath,
 			want:  []error{ErrHTTPPathModifierDoubleReplace},
 		},
+		{
+			name:  "rewrite-regex-httproute",
+			input: urlRewriteRegexHTTPRoute,
+			want:  nil,
+		},
+		{
+			name:  "rewrite-bad-hostname-regex",
+			input: urlRewriteFilterBadHostname,
+			want:  []error{ErrURLRewriteMultipleHostnames, ErrHTTPPathModifierDoubleReplace, ErrRegexMatchReplaceEmptyPattern},
+		},
 		{
 			name:  "add-request-headers-httproute",
 			input: addRequestHeaderHTTPRoute,
@@ -941,6 +1008,11 @@ func TestValidateHTTPRoute(t *testing.T) {
 			input: requestMirrorFilter,
 			want:  nil,
 		},
+		{
+			name:  "mirror-filter-bad-mirror",
+			input: requestMirrorFilterBadMirror,
+			want:  []error{ErrMirrorDestinationEmpty, ErrMirrorPercentageInvalid},
+		},
 	}
 	for _, test := range tests {
 		test := test
@@ -969,9 +1041,9 @@ func TestValidateRouteDestination(t *testing.T) {
 			want:  nil,
 		},
 		{
-			name: "invalid ip",
+			name: "fqdn",
 			input: RouteDestination{
-				Name: "invalid ip",
+				Name: "fqdn",
 				Endpoints: []*DestinationEndpoint{
 					{
 						Host: "example.com",
@@ -979,6 +1051,19 @@ func TestValidateRouteDestination(t *testing.T) {
 					},
 				},
 			},
+			want: nil,
+		},
+		{
+			name: "invalid host",
+			input: RouteDestination{
+				Name: "invalid host",
+				Endpoints: []*DestinationEndpoint{
+					{
+						Host: "example_com:8080",
+						Port: 8080,
+					},
+				},
+			},
 			want: ErrDestEndpointHostInvalid,
 		},
 		{
@@ -29,6 +29,13 @@ type ProviderResources struct {
 	TLSRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.TLSRouteStatus]
 	TCPRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.TCPRouteStatus]
 	UDPRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.UDPRouteStatus]
+
+	ClientTrafficPolicyStatuses  watchable.Map[types.NamespacedName, *egv1a1.ClientTrafficPolicyStatus]
+	BackendTLSPolicyStatuses     watchable.Map[types.NamespacedName, *egv1a1.BackendTLSPolicyStatus]
+	CompressionPolicyStatuses    watchable.Map[types.NamespacedName, *egv1a1.CompressionPolicyStatus]
+	EnvoyExtensionPolicyStatuses watchable.Map[types.NamespacedName, *egv1a1.EnvoyExtensionPolicyStatus]
+	TracingPolicyStatuses        watchable.Map[types.NamespacedName, *egv1a1.TracingPolicyStatus]
+	AccessLoggingPolicyStatuses  watchable.Map[types.NamespacedName, *egv1a1.AccessLoggingPolicyStatus]
 }
 
 func (p *ProviderResources) GetResources() *gatewayapi.Resources {
@@ -59,6 +66,12 @@ func (p *ProviderResources) Close() {
 	p.TLSRouteStatuses.Close()
 	p.TCPRouteStatuses.Close()
 	p.UDPRouteStatuses.Close()
+	p.ClientTrafficPolicyStatuses.Close()
+	p.BackendTLSPolicyStatuses.Close()
+	p.CompressionPolicyStatuses.Close()
+	p.EnvoyExtensionPolicyStatuses.Close()
+	p.TracingPolicyStatuses.Close()
+	p.AccessLoggingPolicyStatuses.Close()
 }
 
 // EnvoyPatchPolicyStatuses message
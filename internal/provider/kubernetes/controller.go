@@ -42,23 +42,27 @@ import (
 )
 
 const (
-	classGatewayIndex             = "classGatewayIndex"
-	gatewayTLSRouteIndex          = "gatewayTLSRouteIndex"
-	gatewayHTTPRouteIndex         = "gatewayHTTPRouteIndex"
-	gatewayGRPCRouteIndex         = "gatewayGRPCRouteIndex"
-	gatewayTCPRouteIndex          = "gatewayTCPRouteIndex"
-	gatewayUDPRouteIndex          = "gatewayUDPRouteIndex"
-	secretGatewayIndex            = "secretGatewayIndex"
-	targetRefGrantRouteIndex      = "targetRefGrantRouteIndex"
-	backendHTTPRouteIndex         = "backendHTTPRouteIndex"
-	backendGRPCRouteIndex         = "backendGRPCRouteIndex"
-	backendTLSRouteIndex          = "backendTLSRouteIndex"
-	backendTCPRouteIndex          = "backendTCPRouteIndex"
-	backendUDPRouteIndex          = "backendUDPRouteIndex"
-	authenFilterHTTPRouteIndex    = "authenHTTPRouteIndex"
-	rateLimitFilterHTTPRouteIndex = "rateLimitHTTPRouteIndex"
-	authenFilterGRPCRouteIndex    = "authenGRPCRouteIndex"
-	rateLimitFilterGRPCRouteIndex = "rateLimitGRPCRouteIndex"
+	classGatewayIndex               = "classGatewayIndex"
+	gatewayTLSRouteIndex            = "gatewayTLSRouteIndex"
+	gatewayHTTPRouteIndex           = "gatewayHTTPRouteIndex"
+	gatewayGRPCRouteIndex           = "gatewayGRPCRouteIndex"
+	gatewayTCPRouteIndex            = "gatewayTCPRouteIndex"
+	gatewayUDPRouteIndex            = "gatewayUDPRouteIndex"
+	secretGatewayIndex              = "secretGatewayIndex"
+	targetRefGrantRouteIndex        = "targetRefGrantRouteIndex"
+	backendHTTPRouteIndex           = "backendHTTPRouteIndex"
+	backendGRPCRouteIndex           = "backendGRPCRouteIndex"
+	backendTLSRouteIndex            = "backendTLSRouteIndex"
+	backendTCPRouteIndex            = "backendTCPRouteIndex"
+	backendUDPRouteIndex            = "backendUDPRouteIndex"
+	authenFilterHTTPRouteIndex      = "authenHTTPRouteIndex"
+	rateLimitFilterHTTPRouteIndex   = "rateLimitHTTPRouteIndex"
+	faultFilterHTTPRouteIndex       = "faultHTTPRouteIndex"
+	upgradeFilterHTTPRouteIndex     = "upgradeHTTPRouteIndex"
+	routeFilterHTTPRouteIndex       = "routeFilterHTTPRouteIndex"
+	authenFilterGRPCRouteIndex      = "authenGRPCRouteIndex"
+	rateLimitFilterGRPCRouteIndex   = "rateLimitGRPCRouteIndex"
+	transcodingFilterGRPCRouteIndex = "transcodingGRPCRouteIndex"
 )
 
 type gatewayAPIReconciler struct {
@@ -131,6 +135,18 @@ type resourceMappings struct {
 	// rateLimitFilters is a map of RateLimitFilters, where the key is the
 	// namespaced name of the RateLimitFilter.
 	rateLimitFilters map[types.NamespacedName]*egv1a1.RateLimitFilter
+	// faultInjectionFilters is a map of FaultInjectionFilters, where the key is the
+	// namespaced name of the FaultInjectionFilter.
+	faultInjectionFilters map[types.NamespacedName]*egv1a1.FaultInjectionFilter
+	// grpcTranscodingFilters is a map of GRPCTranscodingFilters, where the key is the
+	// namespaced name of the GRPCTranscodingFilter.
+	grpcTranscodingFilters map[types.NamespacedName]*egv1a1.GRPCTranscodingFilter
+	// upgradeFilters is a map of UpgradeFilters, where the key is the
+	// namespaced name of the UpgradeFilter.
+	upgradeFilters map[types.NamespacedName]*egv1a1.UpgradeFilter
+	// httpRouteFilters is a map of HTTPRouteFilters, where the key is the
+	// namespaced name of the HTTPRouteFilter.
+	httpRouteFilters map[types.NamespacedName]*egv1a1.HTTPRouteFilter
 	// extensionRefFilters is a map of filters managed by an extension.
 	// The key is the namespaced name of the filter and the value is the
 	// unstructured form of the resource.
@@ -144,6 +160,10 @@ func newResourceMapping() *resourceMappings {
 		allAssociatedRefGrants:   map[types.NamespacedName]*gwapiv1a2.ReferenceGrant{},
 		authenFilters:            map[types.NamespacedName]*egv1a1.AuthenticationFilter{},
 		rateLimitFilters:         map[types.NamespacedName]*egv1a1.RateLimitFilter{},
+		faultInjectionFilters:    map[types.NamespacedName]*egv1a1.FaultInjectionFilter{},
+		grpcTranscodingFilters:   map[types.NamespacedName]*egv1a1.GRPCTranscodingFilter{},
+		upgradeFilters:           map[types.NamespacedName]*egv1a1.UpgradeFilter{},
+		httpRouteFilters:         map[types.NamespacedName]*egv1a1.HTTPRouteFilter{},
 		extensionRefFilters:      map[types.NamespacedName]unstructured.Unstructured{},
 	}
 }
@@ -204,6 +224,26 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Reques
 		return reconcile.Result{}, err
 	}
 
+	if err := r.processClientTrafficPolicies(ctx, resourceMap, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.processCompressionPolicies(ctx, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.processEnvoyExtensionPolicies(ctx, resourceMap, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.processTracingPolicies(ctx, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.processAccessLoggingPolicies(ctx, resourceMap, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	for backendRef := range resourceMap.allAssociatedBackendRefs {
 		backendRefKind := gatewayapi.KindDerefOr(backendRef.Kind, gatewayapi.KindService)
 		r.log.Info("processing Backend", "kind", backendRefKind, "namespace", string(*backendRef.Namespace),
@@ -238,6 +278,31 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Reques
 					"name", string(backendRef.Name))
 			}
 			endpointSliceLabelKey = mcsapi.LabelServiceName
+
+		case egv1a1.KindBackend:
+			backend := new(egv1a1.Backend)
+			err := r.client.Get(ctx, types.NamespacedName{Namespace: string(*backendRef.Namespace), Name: string(backendRef.Name)}, backend)
+			if err != nil {
+				r.log.Error(err, "failed to get Backend", "namespace", string(*backendRef.Namespace),
+					"name", string(backendRef.Name))
+			} else {
+				if backend.Spec.TLS != nil {
+					from := ObjectKindNamespacedName{
+						kind:      egv1a1.KindBackend,
+						namespace: backend.Namespace,
+						name:      backend.Name,
+					}
+					if err := r.processBackendTLSConfigCertificates(ctx, from, backend.Spec.TLS, resourceMap, resourceTree); err != nil {
+						return reconcile.Result{}, err
+					}
+				}
+				resourceMap.allAssociatedNamespaces[backend.Namespace] = struct{}{}
+				resourceTree.Backends = append(resourceTree.Backends, backend)
+				r.log.Info("added Backend to resource tree", "namespace", string(*backendRef.Namespace),
+					"name", string(backendRef.Name))
+			}
+			// The endpoints of a Backend are listed in its spec
+			continue
 		}
 
 		// Retrieve the EndpointSlices associated with the service
@@ -261,6 +326,12 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Reques
 		}
 	}
 
+	// BackendTLSPolicies are processed after the backends, since
+	// the Services they target may already be in the resourceTree.
+	if err := r.processBackendTLSPolicies(ctx, resourceMap, resourceTree); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Add all ReferenceGrants to the resourceTree
 	for _, referenceGrant := range resourceMap.allAssociatedRefGrants {
 		resourceTree.ReferenceGrants = append(resourceTree.ReferenceGrants, referenceGrant)
@@ -383,10 +454,10 @@ func (r *gatewayAPIReconciler) statusUpdateForGateway(ctx context.Context, gtw *
 		return
 	}
 
-	// Get deployment
-	deploy, err := r.envoyDeploymentForGateway(ctx, gtw)
+	// Get deployment or daemonset
+	envoyObj, err := r.envoyObjectForGateway(ctx, gtw)
 	if err != nil {
-		r.log.Info("failed to get Deployment for gateway",
+		r.log.Info("failed to get Deployment or DaemonSet for gateway",
 			"namespace", gtw.Namespace, "name", gtw.Name)
 	}
 
@@ -399,7 +470,7 @@ func (r *gatewayAPIReconciler) statusUpdateForGateway(ctx context.Context, gtw *
 	// update accepted condition
 	status.UpdateGatewayStatusAcceptedCondition(gtw, true)
 	// update address field and programmed condition
-	status.UpdateGatewayStatusProgrammedCondition(gtw, svc, deploy, r.store.listNodeAddresses()...)
+	status.UpdateGatewayStatusProgrammedCondition(gtw, svc, envoyObj, r.store.listNodeAddresses()...)
 
 	key := utils.NamespacedName(gtw)
 
@@ -545,6 +616,380 @@ func (r *gatewayAPIReconciler) processGateways(ctx context.Context, acceptedGC *
 	return nil
 }
 
+// processClientTrafficPolicies adds all ClientTrafficPolicies, as well as the Secrets and
+// ConfigMaps holding the CA certificates and the local reply bodies referenced by them,
+// to the resourceTree.
+func (r *gatewayAPIReconciler) processClientTrafficPolicies(ctx context.Context, resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	clientTrafficPolicies := egv1a1.ClientTrafficPolicyList{}
+	if err := r.client.List(ctx, &clientTrafficPolicies); err != nil {
+		return fmt.Errorf("error listing clienttrafficpolicies: %v", err)
+	}
+
+	for _, policy := range clientTrafficPolicies.Items {
+		policy := policy
+		r.log.Info("processing ClientTrafficPolicy", "namespace", policy.Namespace, "name", policy.Name)
+
+		if policy.Spec.TLS != nil && policy.Spec.TLS.ClientValidation != nil {
+			from := ObjectKindNamespacedName{
+				kind:      egv1a1.KindClientTrafficPolicy,
+				namespace: policy.Namespace,
+				name:      policy.Name,
+			}
+			for _, caCertRef := range policy.Spec.TLS.ClientValidation.CACertificateRefs {
+				if err := r.processCertificateRef(ctx, from, caCertRef, resourceMap, resourceTree); err != nil {
+					return err
+				}
+			}
+		}
+
+		if policy.Spec.LocalReply != nil {
+			for _, mapping := range policy.Spec.LocalReply.Mappings {
+				if mapping.Body == nil || mapping.Body.ValueRef == nil ||
+					mapping.Body.ValueRef.Group != "" || mapping.Body.ValueRef.Kind != gatewayapi.KindConfigMap {
+					continue
+				}
+				configMap := new(corev1.ConfigMap)
+				key := types.NamespacedName{Namespace: policy.Namespace, Name: string(mapping.Body.ValueRef.Name)}
+				if err := r.client.Get(ctx, key, configMap); err != nil {
+					if !kerrors.IsNotFound(err) {
+						return err
+					}
+					r.log.Info("unable to find ConfigMap", "namespace", key.Namespace, "name", key.Name)
+					continue
+				}
+				resourceMap.allAssociatedNamespaces[configMap.Namespace] = struct{}{}
+				resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+			}
+		}
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.ClientTrafficPolicyStatus{}
+		resourceTree.ClientTrafficPolicies = append(resourceTree.ClientTrafficPolicies, &policy)
+	}
+
+	return nil
+}
+
+// processCompressionPolicies adds all CompressionPolicies to the resourceTree.
+func (r *gatewayAPIReconciler) processCompressionPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	compressionPolicies := egv1a1.CompressionPolicyList{}
+	if err := r.client.List(ctx, &compressionPolicies); err != nil {
+		return fmt.Errorf("error listing compressionpolicies: %v", err)
+	}
+
+	for _, policy := range compressionPolicies.Items {
+		policy := policy
+		r.log.Info("processing CompressionPolicy", "namespace", policy.Namespace, "name", policy.Name)
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.CompressionPolicyStatus{}
+		resourceTree.CompressionPolicies = append(resourceTree.CompressionPolicies, &policy)
+	}
+
+	return nil
+}
+
+// processEnvoyExtensionPolicies adds all EnvoyExtensionPolicies, as well as the ConfigMaps
+// and Secrets holding the Lua source code and the Wasm modules referenced by them, to the
+// resourceTree.
+func (r *gatewayAPIReconciler) processEnvoyExtensionPolicies(ctx context.Context, resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	envoyExtensionPolicies := egv1a1.EnvoyExtensionPolicyList{}
+	if err := r.client.List(ctx, &envoyExtensionPolicies); err != nil {
+		return fmt.Errorf("error listing envoyextensionpolicies: %v", err)
+	}
+
+	for _, policy := range envoyExtensionPolicies.Items {
+		policy := policy
+		r.log.Info("processing EnvoyExtensionPolicy", "namespace", policy.Namespace, "name", policy.Name)
+
+		var refs []*gwapiv1b1.LocalObjectReference
+		for _, lua := range policy.Spec.Lua {
+			refs = append(refs, lua.ValueRef)
+		}
+		for _, wasm := range policy.Spec.Wasm {
+			refs = append(refs, wasm.Code.ValueRef)
+		}
+		for _, ref := range refs {
+			if ref == nil || ref.Group != "" {
+				continue
+			}
+			key := types.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}
+			switch ref.Kind {
+			case gatewayapi.KindConfigMap:
+				if resourceTree.GetConfigMap(key.Namespace, key.Name) != nil {
+					continue
+				}
+				configMap := new(corev1.ConfigMap)
+				if err := r.client.Get(ctx, key, configMap); err != nil {
+					if !kerrors.IsNotFound(err) {
+						return err
+					}
+					r.log.Info("unable to find ConfigMap", "namespace", key.Namespace, "name", key.Name)
+					continue
+				}
+				resourceMap.allAssociatedNamespaces[configMap.Namespace] = struct{}{}
+				resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+			case gatewayapi.KindSecret:
+				if resourceTree.GetSecret(key.Namespace, key.Name) != nil {
+					continue
+				}
+				secret := new(corev1.Secret)
+				if err := r.client.Get(ctx, key, secret); err != nil {
+					if !kerrors.IsNotFound(err) {
+						return err
+					}
+					r.log.Info("unable to find Secret", "namespace", key.Namespace, "name", key.Name)
+					continue
+				}
+				resourceMap.allAssociatedNamespaces[secret.Namespace] = struct{}{}
+				resourceTree.Secrets = append(resourceTree.Secrets, secret)
+			}
+		}
+
+		for _, extProc := range policy.Spec.ExtProc {
+			if err := r.processPolicyBackendRef(ctx, egv1a1.KindEnvoyExtensionPolicy, &policy, extProc.BackendRef, resourceMap, resourceTree); err != nil {
+				return err
+			}
+		}
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.EnvoyExtensionPolicyStatus{}
+		resourceTree.EnvoyExtensionPolicies = append(resourceTree.EnvoyExtensionPolicies, &policy)
+	}
+
+	return nil
+}
+
+// processPolicyBackendRef adds the Service referenced by a policy, such as an external processor
+// or an access log collector, as well as the ReferenceGrant allowing the reference when it is in
+// another namespace, to the resourceTree.
+func (r *gatewayAPIReconciler) processPolicyBackendRef(ctx context.Context, policyKind string, policy client.Object,
+	backendRef gwapiv1b1.BackendObjectReference, resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	// Invalid kinds are reported in the policy status by the gateway-api layer.
+	if gatewayapi.GroupDerefOr(backendRef.Group, "") != "" ||
+		gatewayapi.KindDerefOr(backendRef.Kind, gatewayapi.KindService) != gatewayapi.KindService {
+		return nil
+	}
+
+	namespace := gatewayapi.NamespaceDerefOr(backendRef.Namespace, policy.GetNamespace())
+	if resourceTree.GetService(namespace, string(backendRef.Name)) == nil {
+		service := new(corev1.Service)
+		key := types.NamespacedName{Namespace: namespace, Name: string(backendRef.Name)}
+		if err := r.client.Get(ctx, key, service); err != nil {
+			if !kerrors.IsNotFound(err) {
+				return err
+			}
+			r.log.Info("unable to find Service", "namespace", key.Namespace, "name", key.Name)
+			return nil
+		}
+		resourceMap.allAssociatedNamespaces[service.Namespace] = struct{}{}
+		resourceTree.Services = append(resourceTree.Services, service)
+	}
+
+	if namespace != policy.GetNamespace() {
+		from := ObjectKindNamespacedName{
+			kind:      policyKind,
+			namespace: policy.GetNamespace(),
+			name:      policy.GetName(),
+		}
+		to := ObjectKindNamespacedName{
+			kind:      gatewayapi.KindService,
+			namespace: namespace,
+			name:      string(backendRef.Name),
+		}
+		refGrant, err := r.findReferenceGrant(ctx, from, to)
+		switch {
+		case err != nil:
+			r.log.Error(err, "failed to find ReferenceGrant")
+		case refGrant == nil:
+			r.log.Info("no matching ReferenceGrants found", "from", from.kind,
+				"from namespace", from.namespace, "target", to.kind, "target namespace", to.namespace)
+		default:
+			resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
+			r.log.Info("added ReferenceGrant to resource map", "namespace", refGrant.Namespace,
+				"name", refGrant.Name)
+		}
+	}
+
+	return nil
+}
+
+// processTracingPolicies adds all TracingPolicies to the resourceTree.
+func (r *gatewayAPIReconciler) processTracingPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	tracingPolicies := egv1a1.TracingPolicyList{}
+	if err := r.client.List(ctx, &tracingPolicies); err != nil {
+		return fmt.Errorf("error listing tracingpolicies: %v", err)
+	}
+
+	for _, policy := range tracingPolicies.Items {
+		policy := policy
+		r.log.Info("processing TracingPolicy", "namespace", policy.Namespace, "name", policy.Name)
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.TracingPolicyStatus{}
+		resourceTree.TracingPolicies = append(resourceTree.TracingPolicies, &policy)
+	}
+
+	return nil
+}
+
+// processAccessLoggingPolicies adds all AccessLoggingPolicies, as well as the Services
+// of the OpenTelemetry collectors referenced by them, to the resourceTree.
+func (r *gatewayAPIReconciler) processAccessLoggingPolicies(ctx context.Context, resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	accessLoggingPolicies := egv1a1.AccessLoggingPolicyList{}
+	if err := r.client.List(ctx, &accessLoggingPolicies); err != nil {
+		return fmt.Errorf("error listing accessloggingpolicies: %v", err)
+	}
+
+	for _, policy := range accessLoggingPolicies.Items {
+		policy := policy
+		r.log.Info("processing AccessLoggingPolicy", "namespace", policy.Namespace, "name", policy.Name)
+
+		for _, setting := range policy.Spec.Settings {
+			for _, sink := range setting.Sinks {
+				if sink.OpenTelemetry == nil {
+					continue
+				}
+				if err := r.processPolicyBackendRef(ctx, egv1a1.KindAccessLoggingPolicy, &policy, sink.OpenTelemetry.BackendRef, resourceMap, resourceTree); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.AccessLoggingPolicyStatus{}
+		resourceTree.AccessLoggingPolicies = append(resourceTree.AccessLoggingPolicies, &policy)
+	}
+
+	return nil
+}
+
+// processBackendTLSPolicies adds all BackendTLSPolicies, the Services they target, as well
+// as the Secrets and ConfigMaps holding the certificates referenced by them, to the resourceTree.
+func (r *gatewayAPIReconciler) processBackendTLSPolicies(ctx context.Context, resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	backendTLSPolicies := egv1a1.BackendTLSPolicyList{}
+	if err := r.client.List(ctx, &backendTLSPolicies); err != nil {
+		return fmt.Errorf("error listing backendtlspolicies: %v", err)
+	}
+
+	for _, policy := range backendTLSPolicies.Items {
+		policy := policy
+		r.log.Info("processing BackendTLSPolicy", "namespace", policy.Namespace, "name", policy.Name)
+
+		if policy.Spec.TargetRef.Group == "" && policy.Spec.TargetRef.Kind == gatewayapi.KindService &&
+			resourceTree.GetService(policy.Namespace, string(policy.Spec.TargetRef.Name)) == nil {
+			service := new(corev1.Service)
+			key := types.NamespacedName{Namespace: policy.Namespace, Name: string(policy.Spec.TargetRef.Name)}
+			if err := r.client.Get(ctx, key, service); err != nil {
+				if !kerrors.IsNotFound(err) {
+					return err
+				}
+				r.log.Info("unable to find Service", "namespace", key.Namespace, "name", key.Name)
+			} else {
+				resourceMap.allAssociatedNamespaces[service.Namespace] = struct{}{}
+				resourceTree.Services = append(resourceTree.Services, service)
+			}
+		}
+
+		from := ObjectKindNamespacedName{
+			kind:      egv1a1.KindBackendTLSPolicy,
+			namespace: policy.Namespace,
+			name:      policy.Name,
+		}
+		if err := r.processBackendTLSConfigCertificates(ctx, from, &policy.Spec.TLS, resourceMap, resourceTree); err != nil {
+			return err
+		}
+
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		policy.Status = egv1a1.BackendTLSPolicyStatus{}
+		resourceTree.BackendTLSPolicies = append(resourceTree.BackendTLSPolicies, &policy)
+	}
+
+	return nil
+}
+
+// processBackendTLSConfigCertificates adds the Secrets and ConfigMaps holding the CA and client
+// certificates referenced by the backend TLS settings to the resourceTree.
+func (r *gatewayAPIReconciler) processBackendTLSConfigCertificates(ctx context.Context, from ObjectKindNamespacedName,
+	tls *egv1a1.BackendTLSConfig, resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	for _, caCertRef := range tls.CACertificateRefs {
+		if err := r.processCertificateRef(ctx, from, caCertRef, resourceMap, resourceTree); err != nil {
+			return err
+		}
+	}
+	if tls.ClientCertificateRef != nil {
+		if err := r.processCertificateRef(ctx, from, *tls.ClientCertificateRef, resourceMap, resourceTree); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processCertificateRef adds the Secret or ConfigMap referenced by a certificate ref
+// of a policy to the resourceTree, along with the ReferenceGrant allowing the reference
+// if it crosses namespaces.
+func (r *gatewayAPIReconciler) processCertificateRef(ctx context.Context, from ObjectKindNamespacedName,
+	certRef gwapiv1b1.SecretObjectReference, resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	kind := gatewayapi.KindDerefOr(certRef.Kind, gatewayapi.KindSecret)
+	namespace := gatewayapi.NamespaceDerefOr(certRef.Namespace, from.namespace)
+	key := types.NamespacedName{Namespace: namespace, Name: string(certRef.Name)}
+
+	switch kind {
+	case gatewayapi.KindSecret:
+		secret := new(corev1.Secret)
+		if err := r.client.Get(ctx, key, secret); err != nil {
+			if kerrors.IsNotFound(err) {
+				r.log.Info("unable to find Secret", "namespace", namespace, "name", string(certRef.Name))
+				return nil
+			}
+			return err
+		}
+		resourceTree.Secrets = append(resourceTree.Secrets, secret)
+	case gatewayapi.KindConfigMap:
+		configMap := new(corev1.ConfigMap)
+		if err := r.client.Get(ctx, key, configMap); err != nil {
+			if kerrors.IsNotFound(err) {
+				r.log.Info("unable to find ConfigMap", "namespace", namespace, "name", string(certRef.Name))
+				return nil
+			}
+			return err
+		}
+		resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+	default:
+		// Invalid kinds are reported in the policy status by the gateway-api layer.
+		return nil
+	}
+	resourceMap.allAssociatedNamespaces[namespace] = struct{}{}
+
+	if namespace != from.namespace {
+		to := ObjectKindNamespacedName{
+			kind:      kind,
+			namespace: namespace,
+			name:      string(certRef.Name),
+		}
+		refGrant, err := r.findReferenceGrant(ctx, from, to)
+		switch {
+		case err != nil:
+			r.log.Error(err, "failed to find ReferenceGrant")
+		case refGrant == nil:
+			r.log.Info("no matching ReferenceGrants found", "from", from.kind,
+				"from namespace", from.namespace, "target", to.kind, "target namespace", to.namespace)
+		default:
+			resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
+			r.log.Info("added ReferenceGrant to resource map", "namespace", refGrant.Namespace,
+				"name", refGrant.Name)
+		}
+	}
+
+	return nil
+}
+
 func addReferenceGrantIndexers(ctx context.Context, mgr manager.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1a2.ReferenceGrant{}, targetRefGrantRouteIndex, func(rawObj client.Object) []string {
 		refGrant := rawObj.(*gwapiv1a2.ReferenceGrant)
@@ -562,9 +1007,9 @@ func addReferenceGrantIndexers(ctx context.Context, mgr manager.Manager) error {
 // addHTTPRouteIndexers adds indexing on HTTPRoute.
 //   - For Service, ServiceImports objects that are referenced in HTTPRoute objects via `.spec.rules.backendRefs`.
 //     This helps in querying for HTTPRoutes that are affected by a particular Service CRUD.
-//   - For AuthenticationFilter and RateLimitFilter objects that are referenced in HTTPRoute objects via
-//     `.spec.rules[].filters`. This helps in querying for HTTPRoutes that are affected by a
-//     particular AuthenticationFilter CRUD.
+//   - For AuthenticationFilter, RateLimitFilter and FaultInjectionFilter objects that are referenced in
+//     HTTPRoute objects via `.spec.rules[].filters`. This helps in querying for HTTPRoutes that are
+//     affected by a particular AuthenticationFilter CRUD.
 func addHTTPRouteIndexers(ctx context.Context, mgr manager.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.HTTPRoute{}, gatewayHTTPRouteIndex, gatewayHTTPRouteIndexFunc); err != nil {
 		return err
@@ -581,6 +1026,18 @@ func addHTTPRouteIndexers(ctx context.Context, mgr manager.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.HTTPRoute{}, rateLimitFilterHTTPRouteIndex, rateLimitFilterHTTPRouteIndexFunc); err != nil {
 		return err
 	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.HTTPRoute{}, faultFilterHTTPRouteIndex, faultFilterHTTPRouteIndexFunc); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.HTTPRoute{}, upgradeFilterHTTPRouteIndex, upgradeFilterHTTPRouteIndexFunc); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1b1.HTTPRoute{}, routeFilterHTTPRouteIndex, routeFilterHTTPRouteIndexFunc); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -626,6 +1083,69 @@ func rateLimitFilterHTTPRouteIndexFunc(rawObj client.Object) []string {
 	return filters
 }
 
+func faultFilterHTTPRouteIndexFunc(rawObj client.Object) []string {
+	httproute := rawObj.(*gwapiv1b1.HTTPRoute)
+	var filters []string
+	for _, rule := range httproute.Spec.Rules {
+		for i := range rule.Filters {
+			filter := rule.Filters[i]
+			if gatewayapi.IsFaultInjectionHTTPFilter(&filter) {
+				if err := gatewayapi.ValidateHTTPRouteFilter(&filter); err == nil {
+					filters = append(filters,
+						types.NamespacedName{
+							Namespace: httproute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}.String(),
+					)
+				}
+			}
+		}
+	}
+	return filters
+}
+
+func routeFilterHTTPRouteIndexFunc(rawObj client.Object) []string {
+	httproute := rawObj.(*gwapiv1b1.HTTPRoute)
+	var filters []string
+	for _, rule := range httproute.Spec.Rules {
+		for i := range rule.Filters {
+			filter := rule.Filters[i]
+			if gatewayapi.IsEnvoyGatewayHTTPRouteFilter(&filter) {
+				if err := gatewayapi.ValidateHTTPRouteFilter(&filter); err == nil {
+					filters = append(filters,
+						types.NamespacedName{
+							Namespace: httproute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}.String(),
+					)
+				}
+			}
+		}
+	}
+	return filters
+}
+
+func upgradeFilterHTTPRouteIndexFunc(rawObj client.Object) []string {
+	httproute := rawObj.(*gwapiv1b1.HTTPRoute)
+	var filters []string
+	for _, rule := range httproute.Spec.Rules {
+		for i := range rule.Filters {
+			filter := rule.Filters[i]
+			if gatewayapi.IsUpgradeHTTPFilter(&filter) {
+				if err := gatewayapi.ValidateHTTPRouteFilter(&filter); err == nil {
+					filters = append(filters,
+						types.NamespacedName{
+							Namespace: httproute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}.String(),
+					)
+				}
+			}
+		}
+	}
+	return filters
+}
+
 func gatewayHTTPRouteIndexFunc(rawObj client.Object) []string {
 	httproute := rawObj.(*gwapiv1b1.HTTPRoute)
 	var gateways []string
@@ -684,6 +1204,10 @@ func addGRPCRouteIndexers(ctx context.Context, mgr manager.Manager) error {
 		return err
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1a2.GRPCRoute{}, transcodingFilterGRPCRouteIndex, transcodingFilterGRPCRouteIndexFunc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -767,6 +1291,27 @@ func rateLimitFilterGRPCRouteIndexFunc(rawObj client.Object) []string {
 	return filters
 }
 
+func transcodingFilterGRPCRouteIndexFunc(rawObj client.Object) []string {
+	grpcroute := rawObj.(*gwapiv1a2.GRPCRoute)
+	var filters []string
+	for _, rule := range grpcroute.Spec.Rules {
+		for i := range rule.Filters {
+			filter := rule.Filters[i]
+			if gatewayapi.IsGRPCTranscodingGRPCFilter(&filter) {
+				if err := gatewayapi.ValidateGRPCRouteFilter(&filter); err == nil {
+					filters = append(filters,
+						types.NamespacedName{
+							Namespace: grpcroute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}.String(),
+					)
+				}
+			}
+		}
+	}
+	return filters
+}
+
 // addTLSRouteIndexers adds indexing on TLSRoute, for Service objects that are
 // referenced in TLSRoute objects via `.spec.rules.backendRefs`. This helps in
 // querying for TLSRoutes that are affected by a particular Service CRUD.
@@ -1146,6 +1691,174 @@ func (r *gatewayAPIReconciler) subscribeAndUpdateStatus(ctx context.Context) {
 		r.log.Info("udpRoute status subscriber shutting down")
 	}()
 
+	// ClientTrafficPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.ClientTrafficPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.ClientTrafficPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.ClientTrafficPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.ClientTrafficPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("clientTrafficPolicy status subscriber shutting down")
+	}()
+
+	// BackendTLSPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.BackendTLSPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.BackendTLSPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.BackendTLSPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.BackendTLSPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("backendTLSPolicy status subscriber shutting down")
+	}()
+
+	// CompressionPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.CompressionPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.CompressionPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.CompressionPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.CompressionPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("compressionPolicy status subscriber shutting down")
+	}()
+
+	// EnvoyExtensionPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.EnvoyExtensionPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.EnvoyExtensionPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.EnvoyExtensionPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.EnvoyExtensionPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("envoyExtensionPolicy status subscriber shutting down")
+	}()
+
+	// TracingPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.TracingPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.TracingPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.TracingPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.TracingPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("tracingPolicy status subscriber shutting down")
+	}()
+
+	// AccessLoggingPolicy object status updater
+	go func() {
+		message.HandleSubscription(r.resources.AccessLoggingPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *egv1a1.AccessLoggingPolicyStatus]) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key,
+					Resource:       new(egv1a1.AccessLoggingPolicy),
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*egv1a1.AccessLoggingPolicy)
+						if !ok {
+							panic(fmt.Sprintf("unsupported object type %T", obj))
+						}
+						tCopy := t.DeepCopy()
+						tCopy.Status = *val
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("accessLoggingPolicy status subscriber shutting down")
+	}()
+
 	// EnvoyPatchPolicy object status updater
 	go func() {
 		message.HandleSubscription(r.envoyPatchPolicyStatuses.Subscribe(ctx),
@@ -1335,6 +2048,15 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		return err
 	}
 
+	// Watch DaemonSet CRUDs and process affected Gateways.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &appsv1.DaemonSet{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		predicate.NewPredicateFuncs(r.validateDaemonSetForReconcile),
+	); err != nil {
+		return err
+	}
+
 	// Watch AuthenticationFilter CRUDs and enqueue associated HTTPRoute objects.
 	if err := c.Watch(
 		source.Kind(mgr.GetCache(), &egv1a1.AuthenticationFilter{}),
@@ -1351,6 +2073,97 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		return err
 	}
 
+	// Watch FaultInjectionFilter CRUDs and enqueue associated HTTPRoute objects.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.FaultInjectionFilter{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		predicate.NewPredicateFuncs(r.httpRoutesForFaultInjectionFilter)); err != nil {
+		return err
+	}
+
+	// Watch UpgradeFilter CRUDs and enqueue associated HTTPRoute objects.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.UpgradeFilter{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		predicate.NewPredicateFuncs(r.httpRoutesForUpgradeFilter)); err != nil {
+		return err
+	}
+
+	// Watch HTTPRouteFilter CRUDs and enqueue associated HTTPRoute objects.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.HTTPRouteFilter{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		predicate.NewPredicateFuncs(r.httpRoutesForHTTPRouteFilter)); err != nil {
+		return err
+	}
+
+	// Watch GRPCTranscodingFilter CRUDs and enqueue associated GRPCRoute objects.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.GRPCTranscodingFilter{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		predicate.NewPredicateFuncs(r.grpcRoutesForGRPCTranscodingFilter)); err != nil {
+		return err
+	}
+
+	// Watch ClientTrafficPolicy CRUDs
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.ClientTrafficPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass)); err != nil {
+		return err
+	}
+
+	// Watch Backend CRUDs
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.Backend{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass)); err != nil {
+		return err
+	}
+
+	// Watch BackendTLSPolicy CRUDs
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.BackendTLSPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass)); err != nil {
+		return err
+	}
+
+	// Watch CompressionPolicy CRUDs
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.CompressionPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass)); err != nil {
+		return err
+	}
+
+	// Watch EnvoyExtensionPolicy CRUDs
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.EnvoyExtensionPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass)); err != nil {
+		return err
+	}
+
+	// Watch TracingPolicy CRUDs
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.TracingPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass)); err != nil {
+		return err
+	}
+
+	// Watch AccessLoggingPolicy CRUDs
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &egv1a1.AccessLoggingPolicy{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass)); err != nil {
+		return err
+	}
+
+	// Watch ConfigMap CRUDs and process affected ClientTrafficPolicies, BackendTLSPolicies
+	// and EnvoyExtensionPolicies.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &corev1.ConfigMap{}),
+		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+		predicate.NewPredicateFuncs(r.validateConfigMapForReconcile),
+	); err != nil {
+		return err
+	}
+
 	// Watch EnvoyPatchPolicy if enabled in config
 	if r.envoyGateway.ExtensionAPIs != nil && r.envoyGateway.ExtensionAPIs.EnableEnvoyPatchPolicy {
 		// Watch EnvoyPatchPolicy CRUDs
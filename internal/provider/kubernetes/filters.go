@@ -32,6 +32,15 @@ func (r *gatewayAPIReconciler) getRateLimitFilters(ctx context.Context) ([]egv1a
 	return rateLimitList.Items, nil
 }
 
+func (r *gatewayAPIReconciler) getFaultInjectionFilters(ctx context.Context) ([]egv1a1.FaultInjectionFilter, error) {
+	faultInjectionList := new(egv1a1.FaultInjectionFilterList)
+	if err := r.client.List(ctx, faultInjectionList); err != nil {
+		return nil, fmt.Errorf("failed to list FaultInjectionFilters: %v", err)
+	}
+
+	return faultInjectionList.Items, nil
+}
+
 func (r *gatewayAPIReconciler) getExtensionRefFilters(ctx context.Context) ([]unstructured.Unstructured, error) {
 	var resourceItems []unstructured.Unstructured
 	for _, gvk := range r.extGVKs {
@@ -47,3 +56,30 @@ func (r *gatewayAPIReconciler) getExtensionRefFilters(ctx context.Context) ([]un
 
 	return resourceItems, nil
 }
+
+func (r *gatewayAPIReconciler) getUpgradeFilters(ctx context.Context) ([]egv1a1.UpgradeFilter, error) {
+	upgradeList := new(egv1a1.UpgradeFilterList)
+	if err := r.client.List(ctx, upgradeList); err != nil {
+		return nil, fmt.Errorf("failed to list UpgradeFilters: %v", err)
+	}
+
+	return upgradeList.Items, nil
+}
+
+func (r *gatewayAPIReconciler) getHTTPRouteFilters(ctx context.Context) ([]egv1a1.HTTPRouteFilter, error) {
+	routeFilterList := new(egv1a1.HTTPRouteFilterList)
+	if err := r.client.List(ctx, routeFilterList); err != nil {
+		return nil, fmt.Errorf("failed to list HTTPRouteFilters: %v", err)
+	}
+
+	return routeFilterList.Items, nil
+}
+
+func (r *gatewayAPIReconciler) getGRPCTranscodingFilters(ctx context.Context) ([]egv1a1.GRPCTranscodingFilter, error) {
+	grpcTranscodingList := new(egv1a1.GRPCTranscodingFilterList)
+	if err := r.client.List(ctx, grpcTranscodingList); err != nil {
+		return nil, fmt.Errorf("failed to list GRPCTranscodingFilters: %v", err)
+	}
+
+	return grpcTranscodingList.Items, nil
+}
@@ -76,9 +76,17 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) boo
 		return false
 	}
 
+	nsName := utils.NamespacedName(secret)
+	if r.isClientTrafficPolicyReferencingCACert(&nsName, gatewayapi.KindSecret) ||
+		r.isBackendTLSPolicyReferencingCertificate(&nsName, gatewayapi.KindSecret) ||
+		r.isBackendReferencingCertificate(&nsName, gatewayapi.KindSecret) ||
+		r.isEnvoyExtensionPolicyReferencingObject(&nsName, gatewayapi.KindSecret) {
+		return true
+	}
+
 	gwList := &gwapiv1b1.GatewayList{}
 	if err := r.client.List(context.Background(), gwList, &client.ListOptions{
-		FieldSelector: fields.OneTermEqualSelector(secretGatewayIndex, utils.NamespacedName(secret).String()),
+		FieldSelector: fields.OneTermEqualSelector(secretGatewayIndex, nsName.String()),
 	}); err != nil {
 		r.log.Error(err, "unable to find associated HTTPRoutes")
 		return false
@@ -98,6 +106,229 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) boo
 	return true
 }
 
+// validateConfigMapForReconcile checks whether the ConfigMap is referenced by a ClientTrafficPolicy,
+// a BackendTLSPolicy, a Backend, an EnvoyExtensionPolicy or a GRPCTranscodingFilter.
+func (r *gatewayAPIReconciler) validateConfigMapForReconcile(obj client.Object) bool {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	nsName := utils.NamespacedName(configMap)
+	return r.isClientTrafficPolicyReferencingCACert(&nsName, gatewayapi.KindConfigMap) ||
+		r.isClientTrafficPolicyReferencingLocalReplyBody(&nsName) ||
+		r.isBackendTLSPolicyReferencingCertificate(&nsName, gatewayapi.KindConfigMap) ||
+		r.isBackendReferencingCertificate(&nsName, gatewayapi.KindConfigMap) ||
+		r.isEnvoyExtensionPolicyReferencingObject(&nsName, gatewayapi.KindConfigMap) ||
+		r.isGRPCTranscodingFilterReferencingDescriptorSet(&nsName)
+}
+
+// isGRPCTranscodingFilterReferencingDescriptorSet returns true if the ConfigMap is referenced
+// as the proto descriptor set of any GRPCTranscodingFilter, else returns false.
+func (r *gatewayAPIReconciler) isGRPCTranscodingFilterReferencingDescriptorSet(nsName *types.NamespacedName) bool {
+	filterList := &egv1a1.GRPCTranscodingFilterList{}
+	if err := r.client.List(context.Background(), filterList, &client.ListOptions{Namespace: nsName.Namespace}); err != nil {
+		r.log.Error(err, "unable to list GRPCTranscodingFilters")
+		return false
+	}
+
+	for _, filter := range filterList.Items {
+		if json := filter.Spec.JSON; json != nil &&
+			json.DescriptorSetRef.Group == "" && json.DescriptorSetRef.Kind == gatewayapi.KindConfigMap &&
+			string(json.DescriptorSetRef.Name) == nsName.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isEnvoyExtensionPolicyReferencingObject returns true if the ConfigMap or Secret is referenced
+// as the source code of a Lua filter or a Wasm module by any EnvoyExtensionPolicy, else returns false.
+func (r *gatewayAPIReconciler) isEnvoyExtensionPolicyReferencingObject(nsName *types.NamespacedName, kind string) bool {
+	policyList := &egv1a1.EnvoyExtensionPolicyList{}
+	if err := r.client.List(context.Background(), policyList, &client.ListOptions{Namespace: nsName.Namespace}); err != nil {
+		r.log.Error(err, "unable to list EnvoyExtensionPolicies")
+		return false
+	}
+
+	for _, policy := range policyList.Items {
+		var refs []*gwapiv1b1.LocalObjectReference
+		for _, lua := range policy.Spec.Lua {
+			refs = append(refs, lua.ValueRef)
+		}
+		for _, wasm := range policy.Spec.Wasm {
+			refs = append(refs, wasm.Code.ValueRef)
+		}
+		for _, ref := range refs {
+			if ref != nil && ref.Group == "" && string(ref.Kind) == kind && string(ref.Name) == nsName.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isEnvoyExtensionPolicyReferencingBackend returns true if the Service is referenced
+// as an external processor by any EnvoyExtensionPolicy, else returns false.
+func (r *gatewayAPIReconciler) isEnvoyExtensionPolicyReferencingBackend(nsName *types.NamespacedName) bool {
+	policyList := &egv1a1.EnvoyExtensionPolicyList{}
+	if err := r.client.List(context.Background(), policyList); err != nil {
+		r.log.Error(err, "unable to list EnvoyExtensionPolicies")
+		return false
+	}
+
+	for _, policy := range policyList.Items {
+		for _, extProc := range policy.Spec.ExtProc {
+			backendRef := extProc.BackendRef
+			if gatewayapi.GroupDerefOr(backendRef.Group, "") == "" &&
+				gatewayapi.KindDerefOr(backendRef.Kind, gatewayapi.KindService) == gatewayapi.KindService &&
+				gatewayapi.NamespaceDerefOr(backendRef.Namespace, policy.Namespace) == nsName.Namespace &&
+				string(backendRef.Name) == nsName.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isAccessLoggingPolicyReferencingBackend returns true if the Service is referenced
+// as an OpenTelemetry collector by any AccessLoggingPolicy, else returns false.
+func (r *gatewayAPIReconciler) isAccessLoggingPolicyReferencingBackend(nsName *types.NamespacedName) bool {
+	policyList := &egv1a1.AccessLoggingPolicyList{}
+	if err := r.client.List(context.Background(), policyList); err != nil {
+		r.log.Error(err, "unable to list AccessLoggingPolicies")
+		return false
+	}
+
+	for _, policy := range policyList.Items {
+		for _, setting := range policy.Spec.Settings {
+			for _, sink := range setting.Sinks {
+				if sink.OpenTelemetry == nil {
+					continue
+				}
+				backendRef := sink.OpenTelemetry.BackendRef
+				if gatewayapi.GroupDerefOr(backendRef.Group, "") == "" &&
+					gatewayapi.KindDerefOr(backendRef.Kind, gatewayapi.KindService) == gatewayapi.KindService &&
+					gatewayapi.NamespaceDerefOr(backendRef.Namespace, policy.Namespace) == nsName.Namespace &&
+					string(backendRef.Name) == nsName.Name {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// isClientTrafficPolicyReferencingLocalReplyBody returns true if the ConfigMap is referenced
+// as the body of a local reply by any ClientTrafficPolicy, else returns false.
+func (r *gatewayAPIReconciler) isClientTrafficPolicyReferencingLocalReplyBody(nsName *types.NamespacedName) bool {
+	policyList := &egv1a1.ClientTrafficPolicyList{}
+	if err := r.client.List(context.Background(), policyList, &client.ListOptions{Namespace: nsName.Namespace}); err != nil {
+		r.log.Error(err, "unable to list ClientTrafficPolicies")
+		return false
+	}
+
+	for _, policy := range policyList.Items {
+		if policy.Spec.LocalReply == nil {
+			continue
+		}
+		for _, mapping := range policy.Spec.LocalReply.Mappings {
+			if mapping.Body != nil && mapping.Body.ValueRef != nil &&
+				mapping.Body.ValueRef.Group == "" && mapping.Body.ValueRef.Kind == gatewayapi.KindConfigMap &&
+				string(mapping.Body.ValueRef.Name) == nsName.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isClientTrafficPolicyReferencingCACert returns true if the Secret or ConfigMap is referenced
+// as a CA certificate by any ClientTrafficPolicy, else returns false.
+func (r *gatewayAPIReconciler) isClientTrafficPolicyReferencingCACert(nsName *types.NamespacedName, kind string) bool {
+	policyList := &egv1a1.ClientTrafficPolicyList{}
+	if err := r.client.List(context.Background(), policyList); err != nil {
+		r.log.Error(err, "unable to list ClientTrafficPolicies")
+		return false
+	}
+
+	for _, policy := range policyList.Items {
+		if policy.Spec.TLS == nil || policy.Spec.TLS.ClientValidation == nil {
+			continue
+		}
+		for _, caCertRef := range policy.Spec.TLS.ClientValidation.CACertificateRefs {
+			if gatewayapi.KindDerefOr(caCertRef.Kind, gatewayapi.KindSecret) == kind &&
+				gatewayapi.NamespaceDerefOr(caCertRef.Namespace, policy.Namespace) == nsName.Namespace &&
+				string(caCertRef.Name) == nsName.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isBackendTLSPolicyReferencingCertificate returns true if the Secret or ConfigMap is referenced
+// as a CA or client certificate by any BackendTLSPolicy, else returns false.
+func (r *gatewayAPIReconciler) isBackendTLSPolicyReferencingCertificate(nsName *types.NamespacedName, kind string) bool {
+	policyList := &egv1a1.BackendTLSPolicyList{}
+	if err := r.client.List(context.Background(), policyList); err != nil {
+		r.log.Error(err, "unable to list BackendTLSPolicies")
+		return false
+	}
+
+	for _, policy := range policyList.Items {
+		certRefs := policy.Spec.TLS.CACertificateRefs
+		if policy.Spec.TLS.ClientCertificateRef != nil {
+			certRefs = append(certRefs, *policy.Spec.TLS.ClientCertificateRef)
+		}
+		for _, certRef := range certRefs {
+			if gatewayapi.KindDerefOr(certRef.Kind, gatewayapi.KindSecret) == kind &&
+				gatewayapi.NamespaceDerefOr(certRef.Namespace, policy.Namespace) == nsName.Namespace &&
+				string(certRef.Name) == nsName.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isBackendReferencingCertificate returns true if the Secret or ConfigMap is referenced
+// as a CA or client certificate by the TLS settings of any Backend, else returns false.
+func (r *gatewayAPIReconciler) isBackendReferencingCertificate(nsName *types.NamespacedName, kind string) bool {
+	backendList := &egv1a1.BackendList{}
+	if err := r.client.List(context.Background(), backendList); err != nil {
+		r.log.Error(err, "unable to list Backends")
+		return false
+	}
+
+	for _, backend := range backendList.Items {
+		if backend.Spec.TLS == nil {
+			continue
+		}
+		certRefs := backend.Spec.TLS.CACertificateRefs
+		if backend.Spec.TLS.ClientCertificateRef != nil {
+			certRefs = append(certRefs, *backend.Spec.TLS.ClientCertificateRef)
+		}
+		for _, certRef := range certRefs {
+			if gatewayapi.KindDerefOr(certRef.Kind, gatewayapi.KindSecret) == kind &&
+				gatewayapi.NamespaceDerefOr(certRef.Namespace, backend.Namespace) == nsName.Namespace &&
+				string(certRef.Name) == nsName.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // validateServiceForReconcile tries finding the owning Gateway of the Service
 // if it exists, finds the Gateway's Deployment, and further updates the Gateway
 // status Ready condition. All Services are pushed for reconciliation.
@@ -117,7 +348,8 @@ func (r *gatewayAPIReconciler) validateServiceForReconcile(obj client.Object) bo
 	}
 
 	nsName := utils.NamespacedName(svc)
-	return r.isRouteReferencingBackend(&nsName)
+	return r.isRouteReferencingBackend(&nsName) || r.isEnvoyExtensionPolicyReferencingBackend(&nsName) ||
+		r.isAccessLoggingPolicyReferencingBackend(&nsName)
 }
 
 // validateServiceImportForReconcile tries finding the owning Gateway of the ServiceImport
@@ -241,6 +473,31 @@ func (r *gatewayAPIReconciler) validateDeploymentForReconcile(obj client.Object)
 	return false
 }
 
+// validateDaemonSetForReconcile tries finding the owning Gateway of the DaemonSet
+// if it exists, finds the Gateway's Service, and further updates the Gateway
+// status Ready condition. No DaemonSets are pushed for reconciliation.
+func (r *gatewayAPIReconciler) validateDaemonSetForReconcile(obj client.Object) bool {
+	ctx := context.Background()
+	daemonSet, ok := obj.(*appsv1.DaemonSet)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	// Only daemonsets in the configured namespace should be reconciled.
+	if daemonSet.Namespace == r.namespace {
+		// Check if the daemonset belongs to a Gateway, if so, update the Gateway status.
+		gtw := r.findOwningGateway(ctx, daemonSet.GetLabels())
+		if gtw != nil {
+			r.statusUpdateForGateway(ctx, gtw)
+			return false
+		}
+	}
+
+	// There is no need to reconcile the DaemonSet any further.
+	return false
+}
+
 // httpRoutesForAuthenticationFilter tries finding HTTPRoute referents of the provided
 // AuthenticationFilter and returns true if any exist.
 func (r *gatewayAPIReconciler) httpRoutesForAuthenticationFilter(obj client.Object) bool {
@@ -285,6 +542,94 @@ func (r *gatewayAPIReconciler) httpRoutesForRateLimitFilter(obj client.Object) b
 	return len(httpRouteList.Items) != 0
 }
 
+// httpRoutesForFaultInjectionFilter tries finding HTTPRoute referents of the provided
+// FaultInjectionFilter and returns true if any exist.
+func (r *gatewayAPIReconciler) httpRoutesForFaultInjectionFilter(obj client.Object) bool {
+	ctx := context.Background()
+	filter, ok := obj.(*egv1a1.FaultInjectionFilter)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	// Check if the FaultInjectionFilter belongs to a managed HTTPRoute.
+	httpRouteList := &gwapiv1b1.HTTPRouteList{}
+	if err := r.client.List(ctx, httpRouteList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(faultFilterHTTPRouteIndex, utils.NamespacedName(filter).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated HTTPRoutes")
+		return false
+	}
+
+	return len(httpRouteList.Items) != 0
+}
+
+// httpRoutesForUpgradeFilter tries finding HTTPRoute referents of the provided
+// UpgradeFilter and returns true if any exist.
+func (r *gatewayAPIReconciler) httpRoutesForUpgradeFilter(obj client.Object) bool {
+	ctx := context.Background()
+	filter, ok := obj.(*egv1a1.UpgradeFilter)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	// Check if the UpgradeFilter belongs to a managed HTTPRoute.
+	httpRouteList := &gwapiv1b1.HTTPRouteList{}
+	if err := r.client.List(ctx, httpRouteList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(upgradeFilterHTTPRouteIndex, utils.NamespacedName(filter).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated HTTPRoutes")
+		return false
+	}
+
+	return len(httpRouteList.Items) != 0
+}
+
+// httpRoutesForHTTPRouteFilter tries finding HTTPRoute referents of the provided
+// HTTPRouteFilter and returns true if any exist.
+func (r *gatewayAPIReconciler) httpRoutesForHTTPRouteFilter(obj client.Object) bool {
+	ctx := context.Background()
+	filter, ok := obj.(*egv1a1.HTTPRouteFilter)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	// Check if the HTTPRouteFilter belongs to a managed HTTPRoute.
+	httpRouteList := &gwapiv1b1.HTTPRouteList{}
+	if err := r.client.List(ctx, httpRouteList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(routeFilterHTTPRouteIndex, utils.NamespacedName(filter).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated HTTPRoutes")
+		return false
+	}
+
+	return len(httpRouteList.Items) != 0
+}
+
+// grpcRoutesForGRPCTranscodingFilter tries finding GRPCRoute referents of the provided
+// GRPCTranscodingFilter and returns true if any exist.
+func (r *gatewayAPIReconciler) grpcRoutesForGRPCTranscodingFilter(obj client.Object) bool {
+	ctx := context.Background()
+	filter, ok := obj.(*egv1a1.GRPCTranscodingFilter)
+	if !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	// Check if the GRPCTranscodingFilter belongs to a managed GRPCRoute.
+	grpcRouteList := &gwapiv1a2.GRPCRouteList{}
+	if err := r.client.List(ctx, grpcRouteList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(transcodingFilterGRPCRouteIndex, utils.NamespacedName(filter).String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated GRPCRoutes")
+		return false
+	}
+
+	return len(grpcRouteList.Items) != 0
+}
+
 // envoyDeploymentForGateway returns the Envoy Deployment, returning nil if the Deployment doesn't exist.
 func (r *gatewayAPIReconciler) envoyDeploymentForGateway(ctx context.Context, gateway *gwapiv1b1.Gateway) (*appsv1.Deployment, error) {
 	key := types.NamespacedName{
@@ -301,6 +646,43 @@ func (r *gatewayAPIReconciler) envoyDeploymentForGateway(ctx context.Context, ga
 	return deployment, nil
 }
 
+// envoyDaemonSetForGateway returns the Envoy DaemonSet, returning nil if the DaemonSet doesn't exist.
+func (r *gatewayAPIReconciler) envoyDaemonSetForGateway(ctx context.Context, gateway *gwapiv1b1.Gateway) (*appsv1.DaemonSet, error) {
+	key := types.NamespacedName{
+		Namespace: r.namespace,
+		Name:      infraDeploymentName(gateway),
+	}
+	daemonSet := new(appsv1.DaemonSet)
+	if err := r.client.Get(ctx, key, daemonSet); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return daemonSet, nil
+}
+
+// envoyObjectForGateway returns the Envoy Deployment, or the Envoy DaemonSet when the
+// Envoy proxies run as a DaemonSet, returning nil if neither exists.
+func (r *gatewayAPIReconciler) envoyObjectForGateway(ctx context.Context, gateway *gwapiv1b1.Gateway) (client.Object, error) {
+	deployment, err := r.envoyDeploymentForGateway(ctx, gateway)
+	if err != nil {
+		return nil, err
+	}
+	if deployment != nil {
+		return deployment, nil
+	}
+
+	daemonSet, err := r.envoyDaemonSetForGateway(ctx, gateway)
+	if err != nil {
+		return nil, err
+	}
+	if daemonSet != nil {
+		return daemonSet, nil
+	}
+	return nil, nil
+}
+
 // envoyServiceForGateway returns the Envoy service, returning nil if the service doesn't exist.
 func (r *gatewayAPIReconciler) envoyServiceForGateway(ctx context.Context, gateway *gwapiv1b1.Gateway) (*corev1.Service, error) {
 	key := types.NamespacedName{
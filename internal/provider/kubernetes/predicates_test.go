@@ -421,3 +421,52 @@ func TestValidateDeploymentForReconcile(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateDaemonSetForReconcile tests the validateDaemonSetForReconcile
+// predicate function.
+func TestValidateDaemonSetForReconcile(t *testing.T) {
+	sampleGateway := test.GetGateway(types.NamespacedName{Namespace: "default", Name: "scheduled-status-test"}, "test-gc")
+
+	testCases := []struct {
+		name      string
+		configs   []client.Object
+		daemonSet client.Object
+		expect    bool
+	}{
+		{
+			// No config should lead to a reconciliation of a DaemonSet object. The main
+			// purpose of the DaemonSet watcher is just for update Gateway object statuses.
+			name: "gateway daemonset also exist",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", v1alpha1.GatewayControllerName),
+				sampleGateway,
+				test.GetService(types.NamespacedName{Name: "daemonset"}, map[string]string{
+					gatewayapi.OwningGatewayNameLabel:      "scheduled-status-test",
+					gatewayapi.OwningGatewayNamespaceLabel: "default",
+				}, nil),
+			},
+			daemonSet: test.GetGatewayDaemonSet(types.NamespacedName{Name: "daemonset"}, map[string]string{
+				gatewayapi.OwningGatewayNameLabel:      "scheduled-status-test",
+				gatewayapi.OwningGatewayNamespaceLabel: "default",
+			}),
+			expect: false,
+		},
+	}
+
+	// Create the reconciler.
+	logger := logging.DefaultLogger(v1alpha1.LogLevelInfo)
+
+	r := gatewayAPIReconciler{
+		classController: v1alpha1.GatewayControllerName,
+		log:             logger,
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		r.client = fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(tc.configs...).Build()
+		t.Run(tc.name, func(t *testing.T) {
+			res := r.validateDaemonSetForReconcile(tc.daemonSet)
+			require.Equal(t, tc.expect, res)
+		})
+	}
+}
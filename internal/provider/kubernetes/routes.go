@@ -9,6 +9,8 @@ import (
 	"context"
 	"errors"
 
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -89,7 +91,7 @@ func (r *gatewayAPIReconciler) processGRPCRoutes(ctx context.Context, gatewayNam
 	resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
 	grpcRouteList := &gwapiv1a2.GRPCRouteList{}
 
-	// An GRPCRoute may reference an AuthenticationFilter and RateLimitFilter,
+	// An GRPCRoute may reference an AuthenticationFilter, RateLimitFilter and GRPCTranscodingFilter,
 	// so add them to the resource map first (if they exist).
 	authenFilters, err := r.getAuthenticationFilters(ctx)
 	if err != nil {
@@ -109,6 +111,15 @@ func (r *gatewayAPIReconciler) processGRPCRoutes(ctx context.Context, gatewayNam
 		resourceMap.rateLimitFilters[utils.NamespacedName(&filter)] = &filter
 	}
 
+	grpcTranscodingFilters, err := r.getGRPCTranscodingFilters(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range grpcTranscodingFilters {
+		filter := grpcTranscodingFilters[i]
+		resourceMap.grpcTranscodingFilters[utils.NamespacedName(&filter)] = &filter
+	}
+
 	if err := r.client.List(ctx, grpcRouteList, &client.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector(gatewayGRPCRouteIndex, gatewayNamespaceName),
 	}); err != nil {
@@ -196,6 +207,21 @@ func (r *gatewayAPIReconciler) processGRPCRoutes(ctx context.Context, gatewayNam
 						}
 
 						resourceTree.RateLimitFilters = append(resourceTree.RateLimitFilters, rateLimitFilter)
+					case egv1a1.KindGRPCTranscodingFilter:
+						key := types.NamespacedName{
+							Namespace: grpcRoute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}
+						grpcTranscodingFilter, ok := resourceMap.grpcTranscodingFilters[key]
+						if !ok {
+							r.log.Error(err, "GRPCTranscodingFilter not found; bypassing rule", "index", i)
+							continue
+						}
+
+						resourceTree.GRPCTranscodingFilters = append(resourceTree.GRPCTranscodingFilters, grpcTranscodingFilter)
+						if err := r.processGRPCTranscodingDescriptorSet(ctx, grpcTranscodingFilter, resourceMap, resourceTree); err != nil {
+							return err
+						}
 					default:
 						// If the Kind does not match any Envoy Gateway resources, check if it's a Kind
 						// managed by an extension and add to resourceTree
@@ -225,14 +251,42 @@ func (r *gatewayAPIReconciler) processGRPCRoutes(ctx context.Context, gatewayNam
 	return nil
 }
 
+// processGRPCTranscodingDescriptorSet adds the ConfigMap holding the proto descriptor set
+// referenced by a GRPCTranscodingFilter to the resourceTree.
+func (r *gatewayAPIReconciler) processGRPCTranscodingDescriptorSet(ctx context.Context, filter *egv1a1.GRPCTranscodingFilter,
+	resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
+	if filter.Spec.JSON == nil {
+		return nil
+	}
+	ref := filter.Spec.JSON.DescriptorSetRef
+	if ref.Group != "" || ref.Kind != gatewayapi.KindConfigMap ||
+		resourceTree.GetConfigMap(filter.Namespace, string(ref.Name)) != nil {
+		return nil
+	}
+
+	configMap := new(corev1.ConfigMap)
+	key := types.NamespacedName{Namespace: filter.Namespace, Name: string(ref.Name)}
+	if err := r.client.Get(ctx, key, configMap); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		r.log.Info("unable to find ConfigMap", "namespace", key.Namespace, "name", key.Name)
+		return nil
+	}
+	resourceMap.allAssociatedNamespaces[configMap.Namespace] = struct{}{}
+	resourceTree.ConfigMaps = append(resourceTree.ConfigMaps, configMap)
+
+	return nil
+}
+
 // processHTTPRoutes finds HTTPRoutes corresponding to a gatewayNamespaceName, further checks for
 // the backend references and pushes the HTTPRoutes to the resourceTree.
 func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNamespaceName string,
 	resourceMap *resourceMappings, resourceTree *gatewayapi.Resources) error {
 	httpRouteList := &gwapiv1b1.HTTPRouteList{}
 
-	// An HTTPRoute may reference an AuthenticationFilter, RateLimitFilter, or a filter managed
-	// by an extension so add them to the resource map first (if they exist).
+	// An HTTPRoute may reference an AuthenticationFilter, RateLimitFilter, FaultInjectionFilter, UpgradeFilter,
+	// HTTPRouteFilter, or a filter managed by an extension so add them to the resource map first (if they exist).
 	authenFilters, err := r.getAuthenticationFilters(ctx)
 	if err != nil {
 		return err
@@ -251,6 +305,33 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 		resourceMap.rateLimitFilters[utils.NamespacedName(&filter)] = &filter
 	}
 
+	faultInjectionFilters, err := r.getFaultInjectionFilters(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range faultInjectionFilters {
+		filter := faultInjectionFilters[i]
+		resourceMap.faultInjectionFilters[utils.NamespacedName(&filter)] = &filter
+	}
+
+	upgradeFilters, err := r.getUpgradeFilters(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range upgradeFilters {
+		filter := upgradeFilters[i]
+		resourceMap.upgradeFilters[utils.NamespacedName(&filter)] = &filter
+	}
+
+	httpRouteFilters, err := r.getHTTPRouteFilters(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range httpRouteFilters {
+		filter := httpRouteFilters[i]
+		resourceMap.httpRouteFilters[utils.NamespacedName(&filter)] = &filter
+	}
+
 	extensionRefFilters, err := r.getExtensionRefFilters(ctx)
 	if err != nil {
 		return err
@@ -332,50 +413,8 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 						continue
 					}
 
-					mirrorBackendObj := mirrorFilter.BackendRef
-					// Wrap the filter's BackendObjectReference into a BackendRef so we can use existing tooling to check it
-					weight := int32(1)
-					mirrorBackendRef := gwapiv1b1.BackendRef{
-						BackendObjectReference: mirrorBackendObj,
-						Weight:                 &weight,
-					}
-
-					if err := validateBackendRef(&mirrorBackendRef); err != nil {
+					if err := r.processMirrorBackendRef(ctx, &httpRoute, mirrorFilter.BackendRef, resourceMap); err != nil {
 						r.log.Error(err, "invalid backendRef")
-						continue
-					}
-
-					backendNamespace := gatewayapi.NamespaceDerefOr(mirrorBackendRef.Namespace, httpRoute.Namespace)
-					resourceMap.allAssociatedBackendRefs[gwapiv1b1.BackendObjectReference{
-						Group:     mirrorBackendRef.BackendObjectReference.Group,
-						Kind:      mirrorBackendRef.BackendObjectReference.Kind,
-						Namespace: gatewayapi.NamespacePtrV1Alpha2(backendNamespace),
-						Name:      mirrorBackendRef.Name,
-					}] = struct{}{}
-
-					if backendNamespace != httpRoute.Namespace {
-						from := ObjectKindNamespacedName{
-							kind:      gatewayapi.KindHTTPRoute,
-							namespace: httpRoute.Namespace,
-							name:      httpRoute.Name,
-						}
-						to := ObjectKindNamespacedName{
-							kind:      gatewayapi.KindDerefOr(mirrorBackendRef.Kind, gatewayapi.KindService),
-							namespace: backendNamespace,
-							name:      string(mirrorBackendRef.Name),
-						}
-						refGrant, err := r.findReferenceGrant(ctx, from, to)
-						switch {
-						case err != nil:
-							r.log.Error(err, "failed to find ReferenceGrant")
-						case refGrant == nil:
-							r.log.Info("no matching ReferenceGrants found", "from", from.kind,
-								"from namespace", from.namespace, "target", to.kind, "target namespace", to.namespace)
-						default:
-							resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
-							r.log.Info("added ReferenceGrant to resource map", "namespace", refGrant.Namespace,
-								"name", refGrant.Name)
-						}
 					}
 				} else if filter.Type == gwapiv1b1.HTTPRouteFilterExtensionRef {
 					// NOTE: filters must be in the same namespace as the HTTPRoute
@@ -404,6 +443,49 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 						}
 
 						resourceTree.RateLimitFilters = append(resourceTree.RateLimitFilters, rateLimitFilter)
+					case egv1a1.KindFaultInjectionFilter:
+						key := types.NamespacedName{
+							Namespace: httpRoute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}
+						faultInjectionFilter, ok := resourceMap.faultInjectionFilters[key]
+						if !ok {
+							r.log.Error(err, "FaultInjectionFilter not found; bypassing rule", "index", i)
+							continue
+						}
+
+						resourceTree.FaultInjectionFilters = append(resourceTree.FaultInjectionFilters, faultInjectionFilter)
+					case egv1a1.KindUpgradeFilter:
+						key := types.NamespacedName{
+							Namespace: httpRoute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}
+						upgradeFilter, ok := resourceMap.upgradeFilters[key]
+						if !ok {
+							r.log.Error(err, "UpgradeFilter not found; bypassing rule", "index", i)
+							continue
+						}
+
+						resourceTree.UpgradeFilters = append(resourceTree.UpgradeFilters, upgradeFilter)
+					case egv1a1.KindHTTPRouteFilter:
+						key := types.NamespacedName{
+							Namespace: httpRoute.Namespace,
+							Name:      string(filter.ExtensionRef.Name),
+						}
+						httpRouteFilter, ok := resourceMap.httpRouteFilters[key]
+						if !ok {
+							r.log.Error(err, "HTTPRouteFilter not found; bypassing rule", "index", i)
+							continue
+						}
+
+						// Load in the backendRef of the request mirror of the HTTPRouteFilter
+						if mirror := httpRouteFilter.Spec.RequestMirror; mirror != nil {
+							if err := r.processMirrorBackendRef(ctx, &httpRoute, mirror.BackendRef, resourceMap); err != nil {
+								r.log.Error(err, "invalid backendRef")
+							}
+						}
+
+						resourceTree.HTTPRouteFilters = append(resourceTree.HTTPRouteFilters, httpRouteFilter)
 					default:
 						// If the Kind does not match any Envoy Gateway resources, check if it's a Kind
 						// managed by an extension and add to resourceTree
@@ -433,6 +515,57 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 	return nil
 }
 
+// processMirrorBackendRef adds the backendRef of a request mirror of the provided
+// HTTPRoute, along with the ReferenceGrant allowing it, to the resource map.
+func (r *gatewayAPIReconciler) processMirrorBackendRef(ctx context.Context, httpRoute *gwapiv1b1.HTTPRoute,
+	mirrorBackendObj gwapiv1b1.BackendObjectReference, resourceMap *resourceMappings) error {
+	// Wrap the filter's BackendObjectReference into a BackendRef so we can use existing tooling to check it
+	weight := int32(1)
+	mirrorBackendRef := gwapiv1b1.BackendRef{
+		BackendObjectReference: mirrorBackendObj,
+		Weight:                 &weight,
+	}
+
+	if err := validateBackendRef(&mirrorBackendRef); err != nil {
+		return err
+	}
+
+	backendNamespace := gatewayapi.NamespaceDerefOr(mirrorBackendRef.Namespace, httpRoute.Namespace)
+	resourceMap.allAssociatedBackendRefs[gwapiv1b1.BackendObjectReference{
+		Group:     mirrorBackendRef.BackendObjectReference.Group,
+		Kind:      mirrorBackendRef.BackendObjectReference.Kind,
+		Namespace: gatewayapi.NamespacePtrV1Alpha2(backendNamespace),
+		Name:      mirrorBackendRef.Name,
+	}] = struct{}{}
+
+	if backendNamespace != httpRoute.Namespace {
+		from := ObjectKindNamespacedName{
+			kind:      gatewayapi.KindHTTPRoute,
+			namespace: httpRoute.Namespace,
+			name:      httpRoute.Name,
+		}
+		to := ObjectKindNamespacedName{
+			kind:      gatewayapi.KindDerefOr(mirrorBackendRef.Kind, gatewayapi.KindService),
+			namespace: backendNamespace,
+			name:      string(mirrorBackendRef.Name),
+		}
+		refGrant, err := r.findReferenceGrant(ctx, from, to)
+		switch {
+		case err != nil:
+			r.log.Error(err, "failed to find ReferenceGrant")
+		case refGrant == nil:
+			r.log.Info("no matching ReferenceGrants found", "from", from.kind,
+				"from namespace", from.namespace, "target", to.kind, "target namespace", to.namespace)
+		default:
+			resourceMap.allAssociatedRefGrants[utils.NamespacedName(refGrant)] = refGrant
+			r.log.Info("added ReferenceGrant to resource map", "namespace", refGrant.Namespace,
+				"name", refGrant.Name)
+		}
+	}
+
+	return nil
+}
+
 // processTCPRoutes finds TCPRoutes corresponding to a gatewayNamespaceName, further checks for
 // the backend references and pushes the TCPRoutes to the resourceTree.
 func (r *gatewayAPIReconciler) processTCPRoutes(ctx context.Context, gatewayNamespaceName string,
@@ -15,10 +15,12 @@ package status
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
@@ -71,17 +73,40 @@ func computeGatewayAcceptedCondition(gw *gwapiv1b1.Gateway, accepted bool) metav
 }
 
 // computeGatewayProgrammedCondition computes the Gateway Programmed status condition.
-// Programmed condition surfaces true when the Envoy Deployment status is ready.
-func computeGatewayProgrammedCondition(gw *gwapiv1b1.Gateway, deployment *appsv1.Deployment) metav1.Condition {
+// Programmed condition surfaces true when the Envoy Deployment or DaemonSet status is
+// ready and all the addresses requested in the Gateway spec have been assigned.
+func computeGatewayProgrammedCondition(gw *gwapiv1b1.Gateway, envoyObj client.Object, unassigned []string) metav1.Condition {
 	if len(gw.Status.Addresses) == 0 {
 		return newCondition(string(gwapiv1b1.GatewayConditionProgrammed), metav1.ConditionFalse,
 			string(gwapiv1b1.GatewayReasonAddressNotAssigned),
 			"No addresses have been assigned to the Gateway", time.Now(), gw.Generation)
 	}
 
-	// If there are no available replicas for the Envoy Deployment, don't
+	if len(unassigned) > 0 {
+		return newCondition(string(gwapiv1b1.GatewayConditionProgrammed), metav1.ConditionFalse,
+			string(gwapiv1b1.GatewayReasonAddressNotAssigned),
+			fmt.Sprintf("Requested addresses have not been assigned to the Gateway: %s", strings.Join(unassigned, ", ")),
+			time.Now(), gw.Generation)
+	}
+
+	// If there are no available pods for the Envoy DaemonSet, don't
 	// mark the Gateway as ready yet.
+	if daemonSet, ok := envoyObj.(*appsv1.DaemonSet); ok && daemonSet != nil {
+		if daemonSet.Status.NumberAvailable == 0 {
+			return newCondition(string(gwapiv1b1.GatewayConditionProgrammed), metav1.ConditionFalse,
+				string(gwapiv1b1.GatewayReasonNoResources),
+				"DaemonSet pods unavailable", time.Now(), gw.Generation)
+		}
+
+		message := fmt.Sprintf("Address assigned to the Gateway, %d/%d envoy DaemonSet pods available",
+			daemonSet.Status.NumberAvailable, daemonSet.Status.DesiredNumberScheduled)
+		return newCondition(string(gwapiv1b1.GatewayConditionProgrammed), metav1.ConditionTrue,
+			string(gwapiv1b1.GatewayConditionProgrammed), message, time.Now(), gw.Generation)
+	}
 
+	// If there are no available replicas for the Envoy Deployment, don't
+	// mark the Gateway as ready yet.
+	deployment, _ := envoyObj.(*appsv1.Deployment)
 	if deployment == nil || deployment.Status.AvailableReplicas == 0 {
 		return newCondition(string(gwapiv1b1.GatewayConditionProgrammed), metav1.ConditionFalse,
 			string(gwapiv1b1.GatewayReasonNoResources),
@@ -22,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilclock "k8s.io/utils/clock"
 	fakeclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/envoyproxy/gateway/internal/utils/ptr"
@@ -284,7 +285,9 @@ func TestGatewayReadyCondition(t *testing.T) {
 	testCases := []struct {
 		name             string
 		serviceAddress   bool
+		unassigned       []string
 		deploymentStatus appsv1.DeploymentStatus
+		daemonSetStatus  *appsv1.DaemonSetStatus
 		expect           metav1.Condition
 	}{
 		{
@@ -305,6 +308,16 @@ func TestGatewayReadyCondition(t *testing.T) {
 				Reason: string(gwapiv1b1.GatewayReasonAddressNotAssigned),
 			},
 		},
+		{
+			name:             "not ready gateway with unassigned requested address",
+			serviceAddress:   true,
+			unassigned:       []string{"2.2.2.2"},
+			deploymentStatus: appsv1.DeploymentStatus{AvailableReplicas: 1},
+			expect: metav1.Condition{
+				Status: metav1.ConditionFalse,
+				Reason: string(gwapiv1b1.GatewayReasonAddressNotAssigned),
+			},
+		},
 		{
 			name:             "not ready gateway with address unavailable pods",
 			serviceAddress:   true,
@@ -314,6 +327,24 @@ func TestGatewayReadyCondition(t *testing.T) {
 				Reason: string(gwapiv1b1.GatewayReasonNoResources),
 			},
 		},
+		{
+			name:            "ready gateway with daemonset",
+			serviceAddress:  true,
+			daemonSetStatus: &appsv1.DaemonSetStatus{NumberAvailable: 2, DesiredNumberScheduled: 3},
+			expect: metav1.Condition{
+				Status: metav1.ConditionTrue,
+				Reason: string(gwapiv1b1.GatewayConditionProgrammed),
+			},
+		},
+		{
+			name:            "not ready gateway with address unavailable daemonset pods",
+			serviceAddress:  true,
+			daemonSetStatus: &appsv1.DaemonSetStatus{NumberAvailable: 0, DesiredNumberScheduled: 3},
+			expect: metav1.Condition{
+				Status: metav1.ConditionFalse,
+				Reason: string(gwapiv1b1.GatewayReasonNoResources),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -332,8 +363,11 @@ func TestGatewayReadyCondition(t *testing.T) {
 				}
 			}
 
-			deployment := &appsv1.Deployment{Status: tc.deploymentStatus}
-			got := computeGatewayProgrammedCondition(gtw, deployment)
+			var envoyObj client.Object = &appsv1.Deployment{Status: tc.deploymentStatus}
+			if tc.daemonSetStatus != nil {
+				envoyObj = &appsv1.DaemonSet{Status: *tc.daemonSetStatus}
+			}
+			got := computeGatewayProgrammedCondition(gtw, envoyObj, tc.unassigned)
 
 			assert.Equal(t, string(gwapiv1b1.GatewayConditionProgrammed), got.Type)
 			assert.Equal(t, tc.expect.Status, got.Status)
@@ -6,8 +6,9 @@
 package status
 
 import (
-	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/envoyproxy/gateway/internal/utils/ptr"
@@ -21,19 +22,15 @@ func UpdateGatewayStatusAcceptedCondition(gw *gwapiv1b1.Gateway, accepted bool)
 
 // UpdateGatewayStatusProgrammedCondition updates the status addresses for the provided gateway
 // based on the status IP/Hostname of svc and updates the Programmed condition based on the
-// service and deployment state.
-func UpdateGatewayStatusProgrammedCondition(gw *gwapiv1b1.Gateway, svc *corev1.Service, deployment *appsv1.Deployment, nodeAddresses ...string) {
-	var addresses, hostnames []string
+// service and the state of envoyObj, the Envoy Deployment or DaemonSet.
+func UpdateGatewayStatusProgrammedCondition(gw *gwapiv1b1.Gateway, svc *corev1.Service, envoyObj client.Object, nodeAddresses ...string) {
+	var addresses, hostnames, unassigned []string
 	// Update the status addresses field.
 	if svc != nil {
-		// If the addresses is explicitly set in the Gateway spec by the user, use it
-		// to populate the Status
+		// If the addresses is explicitly set in the Gateway spec by the user, use
+		// the ones assigned to the service to populate the Status
 		if len(gw.Spec.Addresses) > 0 {
-			// Make sure the addresses have been populated into ExternalIPs
-			// and use that value
-			if len(svc.Spec.ExternalIPs) > 0 {
-				addresses = append(addresses, svc.Spec.ExternalIPs...)
-			}
+			addresses, hostnames, unassigned = computeRequestedAddresses(gw, svc)
 		} else {
 			if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
 				for i := range svc.Status.LoadBalancer.Ingress {
@@ -86,5 +83,47 @@ func UpdateGatewayStatusProgrammedCondition(gw *gwapiv1b1.Gateway, svc *corev1.S
 		gw.Status.Addresses = nil
 	}
 	// Update the programmed condition.
-	gw.Status.Conditions = MergeConditions(gw.Status.Conditions, computeGatewayProgrammedCondition(gw, deployment))
+	gw.Status.Conditions = MergeConditions(gw.Status.Conditions, computeGatewayProgrammedCondition(gw, envoyObj, unassigned))
+}
+
+// computeRequestedAddresses returns the IP addresses and the hostnames requested in
+// the spec of the provided gateway that have been assigned to svc, along with the
+// requested addresses that have not been assigned.
+//
+// The IP addresses of a LoadBalancer service are assigned once reported in the
+// ingress of the load balancer, and the ones of the other services once set as
+// external IPs. The hostnames are assigned once the service has been assigned
+// any address, since the DNS records of the hostnames are managed by the user.
+func computeRequestedAddresses(gw *gwapiv1b1.Gateway, svc *corev1.Service) (addresses, hostnames, unassigned []string) {
+	assigned := sets.New[string]()
+	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		for _, ingress := range svc.Status.LoadBalancer.Ingress {
+			if len(ingress.IP) > 0 {
+				assigned.Insert(ingress.IP)
+			}
+			if len(ingress.Hostname) > 0 {
+				assigned.Insert(ingress.Hostname)
+			}
+		}
+	} else {
+		assigned.Insert(svc.Spec.ExternalIPs...)
+	}
+
+	for _, addr := range gw.Spec.Addresses {
+		switch {
+		case addr.Type == nil || *addr.Type == gwapiv1b1.IPAddressType:
+			if assigned.Has(addr.Value) {
+				addresses = append(addresses, addr.Value)
+				continue
+			}
+		case *addr.Type == gwapiv1b1.HostnameAddressType:
+			if assigned.Len() > 0 {
+				hostnames = append(hostnames, addr.Value)
+				continue
+			}
+		}
+		unassigned = append(unassigned, addr.Value)
+	}
+
+	return addresses, hostnames, unassigned
 }
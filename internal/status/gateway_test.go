@@ -119,6 +119,108 @@ func TestUpdateGatewayStatusProgrammedCondition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "LoadBalancer svc with requested addresses",
+			args: args{
+				gw: &gwapiv1b1.Gateway{
+					Spec: gwapiv1b1.GatewaySpec{
+						Addresses: []gwapiv1b1.GatewayAddress{
+							{
+								Value: "10.0.0.1",
+							},
+							{
+								Type:  ptr.To(gwapiv1b1.IPAddressType),
+								Value: "10.0.0.2",
+							},
+							{
+								Type:  ptr.To(gwapiv1b1.HostnameAddressType),
+								Value: "gateway.example.com",
+							},
+						},
+					},
+				},
+				svc: &corev1.Service{
+					Spec: corev1.ServiceSpec{
+						ClusterIPs:     []string{"127.0.0.1"},
+						Type:           corev1.ServiceTypeLoadBalancer,
+						LoadBalancerIP: "10.0.0.1",
+					},
+					Status: corev1.ServiceStatus{
+						LoadBalancer: corev1.LoadBalancerStatus{
+							Ingress: []corev1.LoadBalancerIngress{
+								{
+									IP: "10.0.0.1",
+								},
+							},
+						},
+					},
+				},
+				addresses: []gwapiv1b1.GatewayStatusAddress{
+					{
+						Type:  ptr.To(gwapiv1b1.IPAddressType),
+						Value: "10.0.0.1",
+					},
+					{
+						Type:  ptr.To(gwapiv1b1.HostnameAddressType),
+						Value: "gateway.example.com",
+					},
+				},
+			},
+		},
+		{
+			name: "LoadBalancer svc with pending requested address",
+			args: args{
+				gw: &gwapiv1b1.Gateway{
+					Spec: gwapiv1b1.GatewaySpec{
+						Addresses: []gwapiv1b1.GatewayAddress{
+							{
+								Type:  ptr.To(gwapiv1b1.HostnameAddressType),
+								Value: "gateway.example.com",
+							},
+						},
+					},
+				},
+				svc: &corev1.Service{
+					Spec: corev1.ServiceSpec{
+						ClusterIPs: []string{"127.0.0.1"},
+						Type:       corev1.ServiceTypeLoadBalancer,
+					},
+				},
+				addresses: nil,
+			},
+		},
+		{
+			name: "ClusterIP svc with requested addresses",
+			args: args{
+				gw: &gwapiv1b1.Gateway{
+					Spec: gwapiv1b1.GatewaySpec{
+						Addresses: []gwapiv1b1.GatewayAddress{
+							{
+								Type:  ptr.To(gwapiv1b1.IPAddressType),
+								Value: "10.0.0.1",
+							},
+							{
+								Type:  ptr.To(gwapiv1b1.NamedAddressType),
+								Value: "named-address",
+							},
+						},
+					},
+				},
+				svc: &corev1.Service{
+					Spec: corev1.ServiceSpec{
+						ClusterIPs:  []string{"127.0.0.1"},
+						Type:        corev1.ServiceTypeClusterIP,
+						ExternalIPs: []string{"10.0.0.1"},
+					},
+				},
+				addresses: []gwapiv1b1.GatewayStatusAddress{
+					{
+						Type:  ptr.To(gwapiv1b1.IPAddressType),
+						Value: "10.0.0.1",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
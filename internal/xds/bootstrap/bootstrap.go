@@ -8,7 +8,9 @@ package bootstrap
 import (
 	// Register embed
 	_ "embed"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -25,23 +27,48 @@ const (
 	envoyGatewayXdsServerHost = "envoy-gateway"
 	// envoyAdminAddress is the listening address of the envoy admin interface.
 	envoyAdminAddress = "127.0.0.1"
-	// envoyAdminPort is the port used to expose admin interface.
-	envoyAdminPort = 19000
+	// envoyAdminAddressIPv6 is the listening address of the envoy admin interface
+	// when the IPv6 family is used.
+	envoyAdminAddressIPv6 = "::1"
+	// EnvoyAdminPort is the port used to expose admin interface.
+	EnvoyAdminPort = 19000
 	// envoyAdminAccessLogPath is the path used to expose admin access log.
 	envoyAdminAccessLogPath = "/dev/null"
 
 	// DefaultXdsServerPort is the default listening port of the xds-server.
 	DefaultXdsServerPort = 18000
 
-	envoyReadinessAddress = "0.0.0.0"
-	EnvoyReadinessPort    = 19001
-	EnvoyReadinessPath    = "/ready"
+	envoyReadinessAddress     = "0.0.0.0"
+	envoyReadinessAddressIPv6 = "::"
+	EnvoyReadinessPort        = 19001
+	EnvoyReadinessPath        = "/ready"
+
+	statsdSinkName    = "envoy.stat_sinks.statsd"
+	statsdSinkType    = "type.googleapis.com/envoy.config.metrics.v3.StatsdSink"
+	dogStatsdSinkName = "envoy.stat_sinks.dog_statsd"
+	dogStatsdSinkType = "type.googleapis.com/envoy.config.metrics.v3.DogStatsdSink"
 )
 
+// overloadActionNames maps the overload action types to the names of the Envoy overload actions.
+var overloadActionNames = map[egcfgv1a1.OverloadActionType]string{
+	egcfgv1a1.OverloadActionTypeShrinkHeap:               "envoy.overload_actions.shrink_heap",
+	egcfgv1a1.OverloadActionTypeStopAcceptingRequests:    "envoy.overload_actions.stop_accepting_requests",
+	egcfgv1a1.OverloadActionTypeStopAcceptingConnections: "envoy.overload_actions.stop_accepting_connections",
+	egcfgv1a1.OverloadActionTypeDisableHTTPKeepAlive:     "envoy.overload_actions.disable_http_keepalive",
+}
+
 //go:embed bootstrap.yaml.tpl
 var bootstrapTmplStr string
 
-var bootstrapTmpl = template.Must(template.New(envoyCfgFileName).Parse(bootstrapTmplStr))
+var bootstrapTmpl = template.Must(template.New(envoyCfgFileName).Funcs(template.FuncMap{
+	"quote": quote,
+}).Parse(bootstrapTmplStr))
+
+// quote returns the value as a double-quoted YAML string.
+func quote(value string) string {
+	b, _ := json.Marshal(value)
+	return string(b)
+}
 
 // envoyBootstrap defines the envoy Bootstrap configuration.
 type bootstrapConfig struct {
@@ -63,6 +90,30 @@ type bootstrapParameters struct {
 	EnablePrometheus bool
 	// OtelMetricSinks defines the configuration of the OpenTelemetry sinks.
 	OtelMetricSinks []metricSink
+	// StatsdMetricSinks defines the configuration of the StatsD and DogStatsD sinks.
+	StatsdMetricSinks []statsdMetricSink
+	// StatsTags defines the custom tags of the metrics.
+	StatsTags []statsTag
+	// HistogramBuckets defines the custom buckets of the histograms.
+	HistogramBuckets []histogramBucketSetting
+	// OverloadManager defines the configuration of the overload manager, if enabled.
+	OverloadManager *overloadManagerParameters
+}
+
+type overloadManagerParameters struct {
+	// MaxHeapSizeBytes is the maximum heap size of Envoy, if the heap is monitored.
+	MaxHeapSizeBytes int64
+	// Actions are the actions triggered by the heap usage.
+	Actions []overloadAction
+	// MaxActiveDownstreamConnections is the limit of the downstream connections, if set.
+	MaxActiveDownstreamConnections int64
+}
+
+type overloadAction struct {
+	// Name is the name of the Envoy overload action.
+	Name string
+	// Threshold is the ratio of the maximum heap size that triggers the action.
+	Threshold string
 }
 
 type xdsServerParameters struct {
@@ -70,6 +121,8 @@ type xdsServerParameters struct {
 	Address string
 	// Port is the port of the XDS Server that Envoy is managed by.
 	Port int32
+	// DNSLookupFamily is the DNS lookup family used to resolve the XDS Server address.
+	DNSLookupFamily string
 }
 
 type metricSink struct {
@@ -77,6 +130,47 @@ type metricSink struct {
 	Address string
 	// Port is the port of the XDS Server that Envoy is managed by.
 	Port int32
+	// ReportCountersAsDeltas reports the counters as deltas.
+	ReportCountersAsDeltas bool
+	// ReportHistogramsAsDeltas reports the histograms as deltas.
+	ReportHistogramsAsDeltas bool
+	// EmitTagsAsAttributes reports the tags as attributes, when set.
+	EmitTagsAsAttributes *bool
+	// UseTagExtractedName reports the names without the tags, when set.
+	UseTagExtractedName *bool
+	// Resources are the attributes of the OpenTelemetry resource of the metrics.
+	Resources map[string]string
+}
+
+type statsdMetricSink struct {
+	// Name is the name of the Envoy stats sink extension.
+	Name string
+	// Type is the type of the configuration of the sink.
+	Type string
+	// Address is the IP address of the StatsD server.
+	Address string
+	// Port is the UDP port of the StatsD server.
+	Port int32
+	// Prefix is the prefix of the metric names, if set.
+	Prefix string
+}
+
+type statsTag struct {
+	// Name is the name of the tag.
+	Name string
+	// Regex extracts the value of the tag from the metric names, if set.
+	Regex string
+	// FixedValue is the value of the tag, if set.
+	FixedValue string
+}
+
+type histogramBucketSetting struct {
+	// MatchType is the field of the Envoy string matcher: prefix, suffix or safe_regex.
+	MatchType string
+	// MatchValue is the value matched against the histogram names.
+	MatchValue string
+	// Buckets are the upper bounds of the buckets.
+	Buckets []string
 }
 
 type adminServerParameters struct {
@@ -95,6 +189,9 @@ type readyServerParameters struct {
 	Port int32
 	// ReadinessPath is the path for the envoy readiness probe
 	ReadinessPath string
+	// IPv4Compat defines whether the readiness listener bound to the IPv6
+	// wildcard address also accepts IPv4 connections.
+	IPv4Compat bool
 }
 
 // render the stringified bootstrap config in yaml format.
@@ -109,10 +206,14 @@ func (b *bootstrapConfig) render() error {
 }
 
 // GetRenderedBootstrapConfig renders the bootstrap YAML string
-func GetRenderedBootstrapConfig(proxyMetrics *egcfgv1a1.ProxyMetrics) (string, error) {
+func GetRenderedBootstrapConfig(proxyMetrics *egcfgv1a1.ProxyMetrics, ipFamily *egcfgv1a1.IPFamily,
+	overloadManager *egcfgv1a1.ProxyOverloadManager) (string, error) {
 	var (
-		enablePrometheus bool
-		metricSinks      []metricSink
+		enablePrometheus  bool
+		metricSinks       []metricSink
+		statsdMetricSinks []statsdMetricSink
+		statsTags         []statsTag
+		histogramBuckets  []histogramBucketSetting
 	)
 
 	if proxyMetrics != nil {
@@ -122,42 +223,105 @@ func GetRenderedBootstrapConfig(proxyMetrics *egcfgv1a1.ProxyMetrics) (string, e
 
 		addresses := sets.NewString()
 		for _, sink := range proxyMetrics.Sinks {
-			if sink.OpenTelemetry == nil {
-				continue
+			switch {
+			case sink.Type == egcfgv1a1.MetricSinkTypeStatsD && sink.StatsD != nil:
+				// skip duplicate sinks
+				addr := fmt.Sprintf("%s/%s:%d", sink.Type, sink.StatsD.Host, sink.StatsD.Port)
+				if addresses.Has(addr) {
+					continue
+				}
+				addresses.Insert(addr)
+
+				statsdMetricSinks = append(statsdMetricSinks,
+					newStatsdMetricSink(statsdSinkName, statsdSinkType, sink.StatsD))
+			case sink.Type == egcfgv1a1.MetricSinkTypeDogStatsD && sink.DogStatsD != nil:
+				addr := fmt.Sprintf("%s/%s:%d", sink.Type, sink.DogStatsD.Host, sink.DogStatsD.Port)
+				if addresses.Has(addr) {
+					continue
+				}
+				addresses.Insert(addr)
+
+				statsdMetricSinks = append(statsdMetricSinks,
+					newStatsdMetricSink(dogStatsdSinkName, dogStatsdSinkType, sink.DogStatsD))
+			case sink.OpenTelemetry != nil:
+				addr := fmt.Sprintf("%s:%d", sink.OpenTelemetry.Host, sink.OpenTelemetry.Port)
+				if addresses.Has(addr) {
+					continue
+				}
+				addresses.Insert(addr)
+
+				metricSinks = append(metricSinks, metricSink{
+					Address:                  sink.OpenTelemetry.Host,
+					Port:                     sink.OpenTelemetry.Port,
+					ReportCountersAsDeltas:   sink.OpenTelemetry.ReportCountersAsDeltas,
+					ReportHistogramsAsDeltas: sink.OpenTelemetry.ReportHistogramsAsDeltas,
+					EmitTagsAsAttributes:     sink.OpenTelemetry.EmitTagsAsAttributes,
+					UseTagExtractedName:      sink.OpenTelemetry.UseTagExtractedName,
+					Resources:                sink.OpenTelemetry.Resources,
+				})
 			}
+		}
 
-			// skip duplicate sinks
-			addr := fmt.Sprintf("%s:%d", sink.OpenTelemetry.Host, sink.OpenTelemetry.Port)
-			if addresses.Has(addr) {
-				continue
+		for _, tag := range proxyMetrics.StatsTags {
+			st := statsTag{Name: tag.Name}
+			if tag.Regex != nil {
+				st.Regex = *tag.Regex
 			}
-			addresses.Insert(addr)
+			if tag.FixedValue != nil {
+				st.FixedValue = *tag.FixedValue
+			}
+			statsTags = append(statsTags, st)
+		}
 
-			metricSinks = append(metricSinks, metricSink{
-				Address: sink.OpenTelemetry.Host,
-				Port:    sink.OpenTelemetry.Port,
+		for _, setting := range proxyMetrics.HistogramBuckets {
+			histogramBuckets = append(histogramBuckets, histogramBucketSetting{
+				MatchType:  stringMatchType(setting.Match.Type),
+				MatchValue: setting.Match.Value,
+				Buckets:    setting.Buckets,
 			})
 		}
 	}
 
+	adminAddress, readinessAddress := envoyAdminAddress, envoyReadinessAddress
+	var dnsLookupFamily string
+	if ipFamily != nil {
+		switch *ipFamily {
+		case egcfgv1a1.IPv4:
+			dnsLookupFamily = "V4_ONLY"
+		case egcfgv1a1.IPv6:
+			adminAddress, readinessAddress = envoyAdminAddressIPv6, envoyReadinessAddressIPv6
+			dnsLookupFamily = "V6_ONLY"
+		case egcfgv1a1.DualStack:
+			// The IPv4 loopback address is always available to dual-stack pods.
+			readinessAddress = envoyReadinessAddressIPv6
+			dnsLookupFamily = "V4_PREFERRED"
+		}
+	}
+
 	cfg := &bootstrapConfig{
 		parameters: bootstrapParameters{
 			XdsServer: xdsServerParameters{
-				Address: envoyGatewayXdsServerHost,
-				Port:    DefaultXdsServerPort,
+				Address:         envoyGatewayXdsServerHost,
+				Port:            DefaultXdsServerPort,
+				DNSLookupFamily: dnsLookupFamily,
 			},
 			AdminServer: adminServerParameters{
-				Address:       envoyAdminAddress,
-				Port:          envoyAdminPort,
+				Address:       adminAddress,
+				Port:          EnvoyAdminPort,
 				AccessLogPath: envoyAdminAccessLogPath,
 			},
 			ReadyServer: readyServerParameters{
-				Address:       envoyReadinessAddress,
+				Address:       readinessAddress,
 				Port:          EnvoyReadinessPort,
 				ReadinessPath: EnvoyReadinessPath,
+				IPv4Compat:    ipFamily != nil && *ipFamily == egcfgv1a1.DualStack,
 			},
-			EnablePrometheus: enablePrometheus,
-			OtelMetricSinks:  metricSinks,
+			EnablePrometheus:  enablePrometheus,
+			OtelMetricSinks:   metricSinks,
+			StatsdMetricSinks: statsdMetricSinks,
+			StatsTags:         statsTags,
+			HistogramBuckets:  histogramBuckets,
+			OverloadManager:   newOverloadManagerParameters(overloadManager),
 		},
 	}
 
@@ -167,3 +331,60 @@ func GetRenderedBootstrapConfig(proxyMetrics *egcfgv1a1.ProxyMetrics) (string, e
 
 	return cfg.rendered, nil
 }
+
+func newStatsdMetricSink(name, typ string, sink *egcfgv1a1.StatsDSink) statsdMetricSink {
+	s := statsdMetricSink{
+		Name:    name,
+		Type:    typ,
+		Address: sink.Host,
+		Port:    sink.Port,
+	}
+	if sink.Prefix != nil {
+		s.Prefix = *sink.Prefix
+	}
+	return s
+}
+
+// stringMatchType returns the field of the Envoy string matcher matching the provided type.
+func stringMatchType(matcherType egcfgv1a1.MatcherType) string {
+	switch matcherType {
+	case egcfgv1a1.Suffix:
+		return "suffix"
+	case egcfgv1a1.RegularExpression:
+		return "safe_regex"
+	default:
+		return "prefix"
+	}
+}
+
+// newOverloadManagerParameters returns the parameters of the overload manager, or nil
+// if neither the heap nor the downstream connections are limited.
+func newOverloadManagerParameters(overloadManager *egcfgv1a1.ProxyOverloadManager) *overloadManagerParameters {
+	if overloadManager == nil {
+		return nil
+	}
+
+	params := &overloadManagerParameters{}
+	if overloadManager.MaxHeapSize != nil {
+		params.MaxHeapSizeBytes = overloadManager.MaxHeapSize.Value()
+
+		actions := overloadManager.Actions
+		if len(actions) == 0 {
+			actions = egcfgv1a1.DefaultOverloadActions()
+		}
+		for _, action := range actions {
+			params.Actions = append(params.Actions, overloadAction{
+				Name:      overloadActionNames[action.Type],
+				Threshold: strconv.FormatFloat(float64(action.HeapThresholdPercent)/100, 'f', -1, 64),
+			})
+		}
+	}
+	if overloadManager.MaxActiveDownstreamConnections != nil {
+		params.MaxActiveDownstreamConnections = *overloadManager.MaxActiveDownstreamConnections
+	}
+
+	if params.MaxHeapSizeBytes == 0 && params.MaxActiveDownstreamConnections == 0 {
+		return nil
+	}
+	return params
+}
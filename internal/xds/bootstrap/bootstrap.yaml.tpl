@@ -6,7 +6,7 @@ admin:
       path: {{ .AdminServer.AccessLogPath }}
   address:
     socket_address:
-      address: {{ .AdminServer.Address }}
+      address: "{{ .AdminServer.Address }}"
       port_value: {{ .AdminServer.Port }}
 dynamic_resources:
   ads_config:
@@ -22,7 +22,37 @@ dynamic_resources:
   cds_config:
     ads: {}
     resource_api_version: V3
-{{- if .OtelMetricSinks }}
+{{- if or .StatsTags .HistogramBuckets }}
+stats_config:
+  {{- if .StatsTags }}
+  stats_tags:
+  {{- range $tag := .StatsTags }}
+  - tag_name: {{ quote $tag.Name }}
+    {{- if $tag.Regex }}
+    regex: {{ quote $tag.Regex }}
+    {{- else }}
+    fixed_value: {{ quote $tag.FixedValue }}
+    {{- end }}
+  {{- end }}
+  {{- end }}
+  {{- if .HistogramBuckets }}
+  histogram_bucket_settings:
+  {{- range $setting := .HistogramBuckets }}
+  - match:
+      {{- if eq $setting.MatchType "safe_regex" }}
+      safe_regex:
+        regex: {{ quote $setting.MatchValue }}
+      {{- else }}
+      {{ $setting.MatchType }}: {{ quote $setting.MatchValue }}
+      {{- end }}
+    buckets:
+    {{- range $bucket := $setting.Buckets }}
+    - {{ $bucket }}
+    {{- end }}
+  {{- end }}
+  {{- end }}
+{{- end }}
+{{- if or .OtelMetricSinks .StatsdMetricSinks }}
 stats_sinks:
 {{- range $idx, $sink := .OtelMetricSinks }}
 - name: "envoy.stat_sinks.open_telemetry"
@@ -31,16 +61,81 @@ stats_sinks:
     grpc_service:
       envoy_grpc:
         cluster_name: otel_metric_sink_{{ $idx }}
+    {{- if $sink.ReportCountersAsDeltas }}
+    report_counters_as_deltas: true
+    {{- end }}
+    {{- if $sink.ReportHistogramsAsDeltas }}
+    report_histograms_as_deltas: true
+    {{- end }}
+    {{- if $sink.EmitTagsAsAttributes }}
+    emit_tags_as_attributes: {{ $sink.EmitTagsAsAttributes }}
+    {{- end }}
+    {{- if $sink.UseTagExtractedName }}
+    use_tag_extracted_name: {{ $sink.UseTagExtractedName }}
+    {{- end }}
+    {{- if $sink.Resources }}
+    resource_detectors:
+    - name: envoy.tracers.opentelemetry.resource_detectors.static_config
+      typed_config:
+        "@type": type.googleapis.com/envoy.extensions.tracers.opentelemetry.resource_detectors.v3.StaticConfigResourceDetectorConfig
+        attributes:
+          {{- range $key, $value := $sink.Resources }}
+          {{ quote $key }}: {{ quote $value }}
+          {{- end }}
+    {{- end }}
 {{- end }}
+{{- range $sink := .StatsdMetricSinks }}
+- name: "{{ $sink.Name }}"
+  typed_config:
+    "@type": {{ $sink.Type }}
+    address:
+      socket_address:
+        address: "{{ $sink.Address }}"
+        port_value: {{ $sink.Port }}
+        protocol: UDP
+    {{- if $sink.Prefix }}
+    prefix: {{ quote $sink.Prefix }}
+    {{- end }}
+{{- end }}
+{{- end }}
+{{- with .OverloadManager }}
+overload_manager:
+  refresh_interval: 0.25s
+  resource_monitors:
+  {{- if .MaxHeapSizeBytes }}
+  - name: "envoy.resource_monitors.fixed_heap"
+    typed_config:
+      "@type": type.googleapis.com/envoy.extensions.resource_monitors.fixed_heap.v3.FixedHeapConfig
+      max_heap_size_bytes: {{ .MaxHeapSizeBytes }}
+  {{- end }}
+  {{- if .MaxActiveDownstreamConnections }}
+  - name: "envoy.resource_monitors.global_downstream_max_connections"
+    typed_config:
+      "@type": type.googleapis.com/envoy.extensions.resource_monitors.downstream_connections.v3.DownstreamConnectionsConfig
+      max_active_downstream_connections: {{ .MaxActiveDownstreamConnections }}
+  {{- end }}
+  {{- if .Actions }}
+  actions:
+  {{- range $action := .Actions }}
+  - name: "{{ $action.Name }}"
+    triggers:
+    - name: "envoy.resource_monitors.fixed_heap"
+      threshold:
+        value: {{ $action.Threshold }}
+  {{- end }}
+  {{- end }}
 {{- end }}
 static_resources:
   listeners:
   - name: envoy-gateway-proxy-ready-{{ .ReadyServer.Address }}-{{ .ReadyServer.Port }}
     address:
       socket_address:
-        address: {{ .ReadyServer.Address }}
+        address: "{{ .ReadyServer.Address }}"
         port_value: {{ .ReadyServer.Port }}
         protocol: TCP
+        {{- if .ReadyServer.IPv4Compat }}
+        ipv4_compat: true
+        {{- end }}
     filter_chains:
     - filters:
       - name: envoy.filters.network.http_connection_manager
@@ -85,13 +180,16 @@ static_resources:
         - endpoint:
             address:
               socket_address:
-                address: {{ .AdminServer.Address }}
+                address: "{{ .AdminServer.Address }}"
                 port_value: {{ .AdminServer.Port }}
   {{- end }}
   {{- range $idx, $sink := .OtelMetricSinks }}
   - name: otel_metric_sink_{{ $idx }}
     connect_timeout: 0.250s
     type: STRICT_DNS
+    {{- if $.XdsServer.DNSLookupFamily }}
+    dns_lookup_family: {{ $.XdsServer.DNSLookupFamily }}
+    {{- end }}
     typed_extension_protocol_options:
       envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
         "@type": "type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions"
@@ -125,6 +223,9 @@ static_resources:
           http2_protocol_options: {}
     name: xds_cluster
     type: STRICT_DNS
+    {{- if .XdsServer.DNSLookupFamily }}
+    dns_lookup_family: {{ .XdsServer.DNSLookupFamily }}
+    {{- end }}
     http2_protocol_options:
       connection_keepalive:
         interval: 30s
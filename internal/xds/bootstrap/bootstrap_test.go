@@ -12,14 +12,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 )
 
 func TestGetRenderedBootstrapConfig(t *testing.T) {
 	cases := []struct {
-		name         string
-		proxyMetrics *egcfgv1a1.ProxyMetrics
+		name            string
+		proxyMetrics    *egcfgv1a1.ProxyMetrics
+		ipFamily        *egcfgv1a1.IPFamily
+		overloadManager *egcfgv1a1.ProxyOverloadManager
 	}{
 		{
 			name: "default",
@@ -44,11 +47,107 @@ func TestGetRenderedBootstrapConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "statsd-metrics",
+			proxyMetrics: &egcfgv1a1.ProxyMetrics{
+				Sinks: []egcfgv1a1.MetricSink{
+					{
+						Type: egcfgv1a1.MetricSinkTypeOpenTelemetry,
+						OpenTelemetry: &egcfgv1a1.OpenTelemetrySink{
+							Host:                   "otel-collector.monitoring.svc",
+							Port:                   4317,
+							ReportCountersAsDeltas: true,
+							EmitTagsAsAttributes:   boolPtr(true),
+							UseTagExtractedName:    boolPtr(false),
+							Resources: map[string]string{
+								"k8s.cluster.name":       "cluster-1",
+								"deployment.environment": "production",
+							},
+						},
+					},
+					{
+						Type: egcfgv1a1.MetricSinkTypeStatsD,
+						StatsD: &egcfgv1a1.StatsDSink{
+							Host: "10.0.0.10",
+							Port: 8125,
+						},
+					},
+					{
+						Type: egcfgv1a1.MetricSinkTypeDogStatsD,
+						DogStatsD: &egcfgv1a1.StatsDSink{
+							Host:   "10.0.0.11",
+							Port:   8125,
+							Prefix: stringPtr("eg"),
+						},
+					},
+				},
+				StatsTags: []egcfgv1a1.StatsTag{
+					{
+						Name:       "cluster",
+						FixedValue: stringPtr("cluster-1"),
+					},
+					{
+						Name:  "listener_port",
+						Regex: stringPtr(`^listener\.[^.]+_((\d+)\.)`),
+					},
+				},
+				HistogramBuckets: []egcfgv1a1.HistogramBucketSetting{
+					{
+						Match: egcfgv1a1.Match{
+							Type:  egcfgv1a1.Suffix,
+							Value: "rq_time",
+						},
+						Buckets: []string{"0.5", "1", "5", "10", "100", "1000"},
+					},
+					{
+						Match: egcfgv1a1.Match{
+							Type:  egcfgv1a1.RegularExpression,
+							Value: `^cluster\..+\.upstream_cx_length_ms$`,
+						},
+						Buckets: []string{"1000", "60000"},
+					},
+				},
+			},
+		},
+		{
+			name:     "ipv6",
+			ipFamily: ipFamilyPtr(egcfgv1a1.IPv6),
+		},
+		{
+			name:     "dual-stack",
+			ipFamily: ipFamilyPtr(egcfgv1a1.DualStack),
+			proxyMetrics: &egcfgv1a1.ProxyMetrics{
+				Prometheus: &egcfgv1a1.PrometheusProvider{},
+			},
+		},
+		{
+			name: "overload-manager",
+			overloadManager: &egcfgv1a1.ProxyOverloadManager{
+				MaxHeapSize: resourcePtr("1Gi"),
+				Actions: []egcfgv1a1.OverloadAction{
+					{
+						Type:                 egcfgv1a1.OverloadActionTypeDisableHTTPKeepAlive,
+						HeapThresholdPercent: 90,
+					},
+					{
+						Type:                 egcfgv1a1.OverloadActionTypeStopAcceptingRequests,
+						HeapThresholdPercent: 95,
+					},
+				},
+				MaxActiveDownstreamConnections: int64Ptr(50000),
+			},
+		},
+		{
+			name: "overload-manager-default-actions",
+			overloadManager: &egcfgv1a1.ProxyOverloadManager{
+				MaxHeapSize: resourcePtr("512Mi"),
+			},
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			got, err := GetRenderedBootstrapConfig(tc.proxyMetrics)
+			got, err := GetRenderedBootstrapConfig(tc.proxyMetrics, tc.ipFamily, tc.overloadManager)
 			assert.NoError(t, err)
 			expected, err := readTestData(tc.name)
 			assert.NoError(t, err)
@@ -57,6 +156,27 @@ func TestGetRenderedBootstrapConfig(t *testing.T) {
 	}
 }
 
+func ipFamilyPtr(ipFamily egcfgv1a1.IPFamily) *egcfgv1a1.IPFamily {
+	return &ipFamily
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func resourcePtr(s string) *resource.Quantity {
+	q := resource.MustParse(s)
+	return &q
+}
+
 func readTestData(caseName string) (string, error) {
 	filename := path.Join("testdata", fmt.Sprintf("%s.yaml", caseName))
 
@@ -39,7 +39,8 @@ import (
 
 const (
 	// XdsServerAddress is the listening address of the xds-server.
-	XdsServerAddress = "0.0.0.0"
+	// The unspecified address listens on all the IPv4 and IPv6 addresses.
+	XdsServerAddress = ""
 	// xdsTLSCertFilename is the fully qualified path of the file containing the
 	// xDS server TLS certificate.
 	xdsTLSCertFilename = "/certs/tls.crt"
@@ -7,19 +7,23 @@ package translator
 
 import (
 	"errors"
+	"fmt"
 	"sort"
 
 	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	cfgcore "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	fileaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	grpcaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
 	otelaccesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
+	xdstype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	otlpcommonv1 "go.opentelemetry.io/proto/otlp/common/v1"
 	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/xds/types"
 )
@@ -45,6 +49,14 @@ const (
 
 	otelLogName   = "otel_envoy_accesslog"
 	otelAccessLog = "envoy.access_loggers.open_telemetry"
+
+	tcpGRPCAccessLog = "envoy.access_loggers.tcp_grpc"
+
+	// accessLogRuntimeKeyPrefix prefixes the runtime keys that allow overriding
+	// the status code and duration thresholds of the access log filters at runtime.
+	// Each threshold has its own key, suffixed with the index of the access log
+	// and of the bound, so that overriding one threshold leaves the others unchanged.
+	accessLogRuntimeKeyPrefix = "access_log"
 )
 
 var (
@@ -61,7 +73,7 @@ func buildXdsAccessLog(al *ir.AccessLog, forListener bool) []*accesslog.AccessLo
 		return nil
 	}
 
-	totalLen := len(al.Text) + len(al.JSON) + len(al.OpenTelemetry)
+	totalLen := len(al.Text) + len(al.JSON) + len(al.OpenTelemetry) + len(al.ALS)
 	accessLogs := make([]*accesslog.AccessLog, 0, totalLen)
 	// handle text file access logs
 	for _, text := range al.Text {
@@ -88,7 +100,8 @@ func buildXdsAccessLog(al *ir.AccessLog, forListener bool) []*accesslog.AccessLo
 		// TODO: find a better way to handle this
 		accesslogAny, _ := anypb.New(filelog)
 		accessLogs = append(accessLogs, &accesslog.AccessLog{
-			Name: wellknown.FileAccessLog,
+			Name:   wellknown.FileAccessLog,
+			Filter: buildXdsAccessLogFilter(text.Filter, forListener, accessLogRuntimeKey(len(accessLogs))),
 			ConfigType: &accesslog.AccessLog_TypedConfig{
 				TypedConfig: accesslogAny,
 			},
@@ -125,7 +138,8 @@ func buildXdsAccessLog(al *ir.AccessLog, forListener bool) []*accesslog.AccessLo
 
 		accesslogAny, _ := anypb.New(filelog)
 		accessLogs = append(accessLogs, &accesslog.AccessLog{
-			Name: wellknown.FileAccessLog,
+			Name:   wellknown.FileAccessLog,
+			Filter: buildXdsAccessLogFilter(json.Filter, forListener, accessLogRuntimeKey(len(accessLogs))),
 			ConfigType: &accesslog.AccessLog_TypedConfig{
 				TypedConfig: accesslogAny,
 			},
@@ -166,23 +180,212 @@ func buildXdsAccessLog(al *ir.AccessLog, forListener bool) []*accesslog.AccessLo
 
 		accesslogAny, _ := anypb.New(al)
 		accessLogs = append(accessLogs, &accesslog.AccessLog{
-			Name: otelAccessLog,
+			Name:   otelAccessLog,
+			Filter: buildXdsAccessLogFilter(otel.Filter, forListener, accessLogRuntimeKey(len(accessLogs))),
 			ConfigType: &accesslog.AccessLog_TypedConfig{
 				TypedConfig: accesslogAny,
 			},
 		})
 	}
+	// handle gRPC access log service access logs
+	for _, als := range al.ALS {
+		commonConfig := &grpcaccesslog.CommonGrpcAccessLogConfig{
+			LogName: als.LogName,
+			GrpcService: &cfgcore.GrpcService{
+				TargetSpecifier: &cfgcore.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &cfgcore.GrpcService_EnvoyGrpc{
+						ClusterName: buildClusterName("accesslog", als.Host, als.Port),
+						Authority:   als.Host,
+					},
+				},
+			},
+			TransportApiVersion: cfgcore.ApiVersion_V3,
+		}
 
-	// add filter for listener access logs
-	if forListener {
-		for _, al := range accessLogs {
-			al.Filter = listenerAccessLogFilter
+		var (
+			name         string
+			accesslogAny *anypb.Any
+		)
+		switch als.Type {
+		case egcfgv1a1.ALSEnvoyProxyAccessLogTypeTCP:
+			name = tcpGRPCAccessLog
+			accesslogAny, _ = anypb.New(&grpcaccesslog.TcpGrpcAccessLogConfig{
+				CommonConfig: commonConfig,
+			})
+		default:
+			httpConfig := &grpcaccesslog.HttpGrpcAccessLogConfig{
+				CommonConfig: commonConfig,
+			}
+			if als.HTTP != nil {
+				httpConfig.AdditionalRequestHeadersToLog = als.HTTP.RequestHeaders
+				httpConfig.AdditionalResponseHeadersToLog = als.HTTP.ResponseHeaders
+				httpConfig.AdditionalResponseTrailersToLog = als.HTTP.ResponseTrailers
+			}
+			name = wellknown.HTTPGRPCAccessLog
+			accesslogAny, _ = anypb.New(httpConfig)
 		}
+
+		accessLogs = append(accessLogs, &accesslog.AccessLog{
+			Name:   name,
+			Filter: buildXdsAccessLogFilter(als.Filter, forListener, accessLogRuntimeKey(len(accessLogs))),
+			ConfigType: &accesslog.AccessLog_TypedConfig{
+				TypedConfig: accesslogAny,
+			},
+		})
 	}
 
 	return accessLogs
 }
 
+// accessLogRuntimeKey returns the prefix of the runtime keys of the thresholds
+// of the access log at the provided index.
+func accessLogRuntimeKey(index int) string {
+	return fmt.Sprintf("%s.%d", accessLogRuntimeKeyPrefix, index)
+}
+
+// buildXdsAccessLogFilter builds the filter of an access log, a request is
+// logged when it matches all the conditions of the filter.
+// The listener access logs are also restricted to the requests that do not
+// match any route, the other requests are logged by the HCM.
+// The runtime keys of the thresholds of the filter are prefixed with runtimeKey.
+func buildXdsAccessLogFilter(filter *ir.AccessLogFilter, forListener bool, runtimeKey string) *accesslog.AccessLogFilter {
+	var filters []*accesslog.AccessLogFilter
+	if forListener {
+		filters = append(filters, listenerAccessLogFilter)
+	}
+
+	if filter != nil {
+		if len(filter.StatusCodes) > 0 {
+			filters = append(filters, buildXdsAccessLogStatusCodeFilter(filter.StatusCodes, runtimeKey+".status_code"))
+		}
+
+		if filter.MinDuration != nil {
+			filters = append(filters, &accesslog.AccessLogFilter{
+				FilterSpecifier: &accesslog.AccessLogFilter_DurationFilter{
+					DurationFilter: &accesslog.DurationFilter{
+						Comparison: &accesslog.ComparisonFilter{
+							Op: accesslog.ComparisonFilter_GE,
+							Value: &cfgcore.RuntimeUInt32{
+								DefaultValue: uint32(filter.MinDuration.Milliseconds()),
+								RuntimeKey:   runtimeKey + ".min_duration",
+							},
+						},
+					},
+				},
+			})
+		}
+
+		if len(filter.ResponseFlags) > 0 {
+			filters = append(filters, &accesslog.AccessLogFilter{
+				FilterSpecifier: &accesslog.AccessLogFilter_ResponseFlagFilter{
+					ResponseFlagFilter: &accesslog.ResponseFlagFilter{Flags: filter.ResponseFlags},
+				},
+			})
+		}
+
+		for _, header := range filter.RequestHeaders {
+			filters = append(filters, &accesslog.AccessLogFilter{
+				FilterSpecifier: &accesslog.AccessLogFilter_HeaderFilter{
+					HeaderFilter: &accesslog.HeaderFilter{
+						Header: &routev3.HeaderMatcher{
+							Name: header,
+							HeaderMatchSpecifier: &routev3.HeaderMatcher_PresentMatch{
+								PresentMatch: true,
+							},
+						},
+					},
+				},
+			})
+		}
+
+		if filter.Sampling != nil {
+			filters = append(filters, &accesslog.AccessLogFilter{
+				FilterSpecifier: &accesslog.AccessLogFilter_RuntimeFilter{
+					RuntimeFilter: &accesslog.RuntimeFilter{
+						RuntimeKey: filter.Sampling.RuntimeKey,
+						PercentSampled: &xdstype.FractionalPercent{
+							Numerator:   filter.Sampling.Percent,
+							Denominator: xdstype.FractionalPercent_HUNDRED,
+						},
+					},
+				},
+			})
+		}
+
+		if filter.NotHealthCheck {
+			filters = append(filters, &accesslog.AccessLogFilter{
+				FilterSpecifier: &accesslog.AccessLogFilter_NotHealthCheckFilter{
+					NotHealthCheckFilter: &accesslog.NotHealthCheckFilter{},
+				},
+			})
+		}
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return filters[0]
+	default:
+		return &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+				AndFilter: &accesslog.AndFilter{Filters: filters},
+			},
+		}
+	}
+}
+
+// buildXdsAccessLogStatusCodeFilter builds a filter matching the status codes
+// in any of the ranges. The bounds of each range are overridden at runtime with
+// the "<runtimeKey>.<range index>" key, or its "min" and "max" sub keys.
+func buildXdsAccessLogStatusCodeFilter(ranges []ir.StatusCodeRange, runtimeKey string) *accesslog.AccessLogFilter {
+	filters := make([]*accesslog.AccessLogFilter, 0, len(ranges))
+	for i, r := range ranges {
+		rangeKey := fmt.Sprintf("%s.%d", runtimeKey, i)
+		if r.Start == r.End {
+			filters = append(filters, buildXdsAccessLogStatusCodeComparison(accesslog.ComparisonFilter_EQ, r.Start, rangeKey))
+			continue
+		}
+
+		filters = append(filters, &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+				AndFilter: &accesslog.AndFilter{
+					Filters: []*accesslog.AccessLogFilter{
+						buildXdsAccessLogStatusCodeComparison(accesslog.ComparisonFilter_GE, r.Start, rangeKey+".min"),
+						buildXdsAccessLogStatusCodeComparison(accesslog.ComparisonFilter_LE, r.End, rangeKey+".max"),
+					},
+				},
+			},
+		})
+	}
+
+	if len(filters) == 1 {
+		return filters[0]
+	}
+
+	return &accesslog.AccessLogFilter{
+		FilterSpecifier: &accesslog.AccessLogFilter_OrFilter{
+			OrFilter: &accesslog.OrFilter{Filters: filters},
+		},
+	}
+}
+
+func buildXdsAccessLogStatusCodeComparison(op accesslog.ComparisonFilter_Op, code uint32, runtimeKey string) *accesslog.AccessLogFilter {
+	return &accesslog.AccessLogFilter{
+		FilterSpecifier: &accesslog.AccessLogFilter_StatusCodeFilter{
+			StatusCodeFilter: &accesslog.StatusCodeFilter{
+				Comparison: &accesslog.ComparisonFilter{
+					Op: op,
+					Value: &cfgcore.RuntimeUInt32{
+						DefaultValue: code,
+						RuntimeKey:   runtimeKey,
+					},
+				},
+			},
+		},
+	}
+}
+
 // read more here: https://opentelemetry.io/docs/specs/otel/resource/semantic_conventions/k8s/
 const (
 	k8sNamespaceNameKey = "k8s.namespace.name"
@@ -232,7 +435,7 @@ func convertToKeyValueList(attributes map[string]string, additionalLabels bool)
 	return keyValueList
 }
 
-func processClusterForAccessLog(tCtx *types.ResourceVersionTable, al *ir.AccessLog) error {
+func processClusterForAccessLog(tCtx *types.ResourceVersionTable, al *ir.AccessLog, ipFamily *egcfgv1a1.IPFamily) error {
 	if al == nil {
 		return nil
 	}
@@ -247,11 +450,28 @@ func processClusterForAccessLog(tCtx *types.ResourceVersionTable, al *ir.AccessL
 			tSocket:      nil,
 			protocol:     HTTP2,
 			endpointType: DefaultEndpointType,
+			ipFamily:     ipFamily,
 		}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 			return err
 		}
 
 	}
 
+	for _, als := range al.ALS {
+		clusterName := buildClusterName("accesslog", als.Host, als.Port)
+
+		endpoints := []*ir.DestinationEndpoint{ir.NewDestEndpoint(als.Host, als.Port)}
+		if err := addXdsCluster(tCtx, addXdsClusterArgs{
+			name:         clusterName,
+			endpoints:    endpoints,
+			tSocket:      nil,
+			protocol:     HTTP2,
+			endpointType: DefaultEndpointType,
+			ipFamily:     ipFamily,
+		}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
+			return err
+		}
+	}
+
 	return nil
 }
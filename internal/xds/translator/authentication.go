@@ -24,6 +24,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/xds/types"
@@ -314,7 +315,7 @@ type jwksCluster struct {
 }
 
 // createJwksClusters creates JWKS clusters from the provided routes, if needed.
-func createJwksClusters(tCtx *types.ResourceVersionTable, routes []*ir.HTTPRoute) error {
+func createJwksClusters(tCtx *types.ResourceVersionTable, routes []*ir.HTTPRoute, ipFamily *egcfgv1a1.IPFamily) error {
 	if tCtx == nil ||
 		tCtx.XdsResources == nil ||
 		tCtx.XdsResources[resource.ClusterType] == nil ||
@@ -345,6 +346,7 @@ func createJwksClusters(tCtx *types.ResourceVersionTable, routes []*ir.HTTPRoute
 					tSocket:      tSocket,
 					protocol:     DefaultProtocol,
 					endpointType: epType,
+					ipFamily:     ipFamily,
 				}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 					return err
 				}
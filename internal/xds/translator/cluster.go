@@ -12,12 +12,16 @@ import (
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	httpv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
@@ -68,6 +72,19 @@ func buildXdsCluster(clusterName string, tSocket *corev3.TransportSocket, protoc
 	return cluster
 }
 
+// buildDNSLookupFamily returns the DNS lookup family of the clusters of the IP family.
+// Dual-stack clusters prefer the IPv4 addresses and fall back to the IPv6 addresses.
+func buildDNSLookupFamily(ipFamily egcfgv1a1.IPFamily) clusterv3.Cluster_DnsLookupFamily {
+	switch ipFamily {
+	case egcfgv1a1.IPv6:
+		return clusterv3.Cluster_V6_ONLY
+	case egcfgv1a1.DualStack:
+		return clusterv3.Cluster_V4_PREFERRED
+	default:
+		return clusterv3.Cluster_V4_ONLY
+	}
+}
+
 func buildXdsClusterLoadAssignment(clusterName string, irEndpoints []*ir.DestinationEndpoint) *endpointv3.ClusterLoadAssignment {
 	endpoints := make([]*endpointv3.LbEndpoint, 0, len(irEndpoints))
 	for _, irEp := range irEndpoints {
@@ -126,9 +143,105 @@ func buildTypedExtensionProtocolOptions() map[string]*anypb.Any {
 	return extensionOptions
 }
 
+// buildXdsBackendTLSSocket returns an xDS TransportSocket that originates TLS
+// to the backends, validating their certificate against the CA certificate
+// fetched using SDS and the SNI hostname.
+func buildXdsBackendTLSSocket(tlsConfig *ir.TLSUpstreamConfig) (*corev3.TransportSocket, error) {
+	tlsCtx := &tlsv3.UpstreamTlsContext{
+		Sni: tlsConfig.SNI,
+		CommonTlsContext: &tlsv3.CommonTlsContext{
+			ValidationContextType: &tlsv3.CommonTlsContext_CombinedValidationContext{
+				CombinedValidationContext: &tlsv3.CommonTlsContext_CombinedCertificateValidationContext{
+					DefaultValidationContext: &tlsv3.CertificateValidationContext{
+						MatchTypedSubjectAltNames: []*tlsv3.SubjectAltNameMatcher{
+							{
+								SanType: tlsv3.SubjectAltNameMatcher_DNS,
+								Matcher: &matcherv3.StringMatcher{
+									MatchPattern: &matcherv3.StringMatcher_Exact{
+										Exact: tlsConfig.SNI,
+									},
+								},
+							},
+						},
+					},
+					ValidationContextSdsSecretConfig: &tlsv3.SdsSecretConfig{
+						Name:      tlsConfig.Name,
+						SdsConfig: makeConfigSource(),
+					},
+				},
+			},
+		},
+	}
+
+	if tlsConfig.ClientCertificate != nil {
+		tlsCtx.CommonTlsContext.TlsCertificateSdsSecretConfigs = []*tlsv3.SdsSecretConfig{
+			{
+				Name:      tlsConfig.ClientCertificate.Name,
+				SdsConfig: makeConfigSource(),
+			},
+		}
+	}
+
+	tlsCtxAny, err := anypb.New(tlsCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev3.TransportSocket{
+		Name: wellknown.TransportSocketTls,
+		ConfigType: &corev3.TransportSocket_TypedConfig{
+			TypedConfig: tlsCtxAny,
+		},
+	}, nil
+}
+
+func buildXdsBackendTLSCASecret(tlsConfig *ir.TLSUpstreamConfig) *tlsv3.Secret {
+	// Build the CA secret used to validate backend certificates
+	return &tlsv3.Secret{
+		Name: tlsConfig.Name,
+		Type: &tlsv3.Secret_ValidationContext{
+			ValidationContext: &tlsv3.CertificateValidationContext{
+				TrustedCa: &corev3.DataSource{
+					Specifier: &corev3.DataSource_InlineBytes{InlineBytes: tlsConfig.CACertificate},
+				},
+			},
+		},
+	}
+}
+
 // buildClusterName returns a cluster name for the given `host` and `port`.
 // The format is: <type>|<host>|<port>, where type is "accesslog" for access logs.
 // It's easy to distinguish when debugging.
 func buildClusterName(prefix string, host string, port uint32) string {
 	return fmt.Sprintf("%s|%s|%d", prefix, host, port)
 }
+
+// buildTypedExtensionHTTP1ProtocolOptions returns the HTTP/1 protocol options of
+// the upstream connections, matching the settings of the downstream connections.
+func buildTypedExtensionHTTP1ProtocolOptions(http1 *ir.HTTP1Settings) (map[string]*anypb.Any, error) {
+	http1Options, err := buildHTTP1ProtocolOptions(http1)
+	if err != nil {
+		return nil, err
+	}
+	// Accepting HTTP/1.0 only applies to the downstream connections.
+	http1Options.AcceptHttp_10 = false
+
+	protocolOptions := httpv3.HttpProtocolOptions{
+		UpstreamProtocolOptions: &httpv3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &httpv3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &httpv3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{
+					HttpProtocolOptions: http1Options,
+				},
+			},
+		},
+	}
+
+	anyProtocolOptions, err := anypb.New(&protocolOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]*anypb.Any{
+		extensionOptionsKey: anyProtocolOptions,
+	}, nil
+}
@@ -0,0 +1,243 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	brotliv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	gzipv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	zstdv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	compressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	compressorFilter = "envoy.filters.http.compressor"
+)
+
+// compressorFilterName returns the name of the compressor filter using the
+// provided compression algorithm, since a filter is added per algorithm.
+func compressorFilterName(compressor egv1a1.CompressorType) string {
+	return fmt.Sprintf("%s.%s", compressorFilter, strings.ToLower(string(compressor)))
+}
+
+// patchHCMWithCompressorFilters builds and appends a Compressor Filter per
+// compression algorithm to the HTTP Connection Manager if applicable, and
+// they do not already exist. A listener sharing the HTTP Connection Manager
+// with another listener using the same algorithm with other settings gets
+// its own filter.
+func patchHCMWithCompressorFilters(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	compression := irListener.Compression
+	if compression == nil {
+		return nil
+	}
+
+	for i, compressor := range compression.Compressors {
+		compressorProto, err := buildCompressor(compressor, compression)
+		if err != nil {
+			return err
+		}
+		// Prefer the compressors in the configured order when the
+		// client accepts several of them with the same q-value.
+		compressorProto.ChooseFirst = i == 0
+
+		compressorAny, err := anypb.New(compressorProto)
+		if err != nil {
+			return err
+		}
+
+		// Skip the filter if it already exists.
+		filterName := compressorFilterName(compressor)
+		if existing := findHCMFilter(mgr, filterName); existing != nil {
+			if proto.Equal(existing.GetTypedConfig(), compressorAny) {
+				continue
+			}
+			filterName = listenerCompressorFilterName(compressor, irListener)
+			if hcmContainsFilter(mgr, filterName) {
+				continue
+			}
+		}
+
+		mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+			Name: filterName,
+			ConfigType: &hcmv3.HttpFilter_TypedConfig{
+				TypedConfig: compressorAny,
+			},
+		})
+	}
+
+	return nil
+}
+
+// listenerCompressorFilterName returns the name of the compressor filter of
+// the provided listener, used when the HTTP Connection Manager shared with
+// other listeners already has a filter using the algorithm with other settings.
+func listenerCompressorFilterName(compressor egv1a1.CompressorType, irListener *ir.HTTPListener) string {
+	return fmt.Sprintf("%s/%s", compressorFilterName(compressor), irListener.Name)
+}
+
+// patchXdsHCMWithCompressorFilters adds the Compressor Filters of the provided
+// listener to the HTTP Connection Manager of the default filter chain of the xDS
+// listener, which is shared by the HTTP listeners using the same port. The added
+// filters are disabled on the routes of the other listeners.
+func patchXdsHCMWithCompressorFilters(xdsListener *listenerv3.Listener, routeCfg *routev3.RouteConfiguration,
+	irListener *ir.HTTPListener) error {
+	added, err := patchXdsHCM(xdsListener, func(mgr *hcmv3.HttpConnectionManager) error {
+		return patchHCMWithCompressorFilters(mgr, irListener)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The routes already in the route config belong to the other listeners.
+	for _, vHost := range routeCfg.GetVirtualHosts() {
+		for _, route := range vHost.Routes {
+			if err := disableRouteCompressorFilters(route, added); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// findXdsCompressorFilters returns the names of the compressor filters of the
+// HTTP Connection Manager of the provided listener.
+func findXdsCompressorFilters(xdsListener *listenerv3.Listener, irListener *ir.HTTPListener) ([]string, error) {
+	return findXdsHCMFilters(xdsListener, irListener, func(name string) bool {
+		return strings.HasPrefix(name, compressorFilter+".")
+	})
+}
+
+// buildCompressor returns the compressor filter config using the provided
+// compression algorithm.
+func buildCompressor(compressor egv1a1.CompressorType, compression *ir.Compression) (*compressorv3.Compressor, error) {
+	var (
+		libraryName string
+		library     proto.Message
+	)
+	switch compressor {
+	case egv1a1.GzipCompressorType:
+		libraryName = "envoy.compression.gzip.compressor"
+		library = &gzipv3.Gzip{}
+	case egv1a1.BrotliCompressorType:
+		libraryName = "envoy.compression.brotli.compressor"
+		library = &brotliv3.Brotli{}
+	case egv1a1.ZstdCompressorType:
+		libraryName = "envoy.compression.zstd.compressor"
+		library = &zstdv3.Zstd{}
+	default:
+		return nil, fmt.Errorf("unsupported compressor type %s", compressor)
+	}
+
+	libraryAny, err := anypb.New(library)
+	if err != nil {
+		return nil, err
+	}
+
+	commonConfig := &compressorv3.Compressor_CommonDirectionConfig{
+		ContentType: compression.ContentTypes,
+	}
+	if compression.MinContentLength != nil {
+		commonConfig.MinContentLength = wrapperspb.UInt32(*compression.MinContentLength)
+	}
+
+	return &compressorv3.Compressor{
+		CompressorLibrary: &corev3.TypedExtensionConfig{
+			Name:        libraryName,
+			TypedConfig: libraryAny,
+		},
+		ResponseDirectionConfig: &compressorv3.Compressor_ResponseDirectionConfig{
+			CommonConfig:        commonConfig,
+			DisableOnEtagHeader: compression.DisableOnETagHeader,
+		},
+	}, nil
+}
+
+// patchRouteWithCompression patches the provided route with a compressor per
+// route config disabling each compressor filter of the HTTP Connection Manager
+// that is not one of the listener, or all of them if the route has compression
+// disabled.
+func patchRouteWithCompression(route *routev3.Route, irRoute *ir.HTTPRoute, irListener *ir.HTTPListener,
+	hcmFilters []string) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if len(hcmFilters) == 0 {
+		return nil
+	}
+
+	enabled := make(map[string]bool)
+	if compression := irListener.Compression; compression != nil && !irRoute.DisableCompression {
+		for _, compressor := range compression.Compressors {
+			// The listener uses its own filter when it has one.
+			name := listenerCompressorFilterName(compressor, irListener)
+			if !slices.Contains(hcmFilters, name) {
+				name = compressorFilterName(compressor)
+			}
+			enabled[name] = true
+		}
+	}
+
+	var disabled []string
+	for _, name := range hcmFilters {
+		if !enabled[name] {
+			disabled = append(disabled, name)
+		}
+	}
+
+	return disableRouteCompressorFilters(route, disabled)
+}
+
+// disableRouteCompressorFilters disables the provided compressor filters on
+// the route.
+func disableRouteCompressorFilters(route *routev3.Route, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	disabledAny, err := anypb.New(&compressorv3.CompressorPerRoute{
+		Override: &compressorv3.CompressorPerRoute_Disabled{
+			Disabled: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	for _, name := range names {
+		route.TypedPerFilterConfig[name] = disabledAny
+	}
+
+	return nil
+}
@@ -0,0 +1,524 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	wasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	wasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	extProcFilter   = "envoy.filters.http.ext_proc"
+	wasmFilter      = "envoy.filters.http.wasm"
+	wasmRuntimeV8   = "envoy.wasm.runtime.v8"
+	wasmCodeTimeout = 10 * time.Second
+)
+
+func extProcFilterName(extProc *ir.ExtProc) string {
+	return fmt.Sprintf("%s/%s", extProcFilter, extProc.Name)
+}
+
+func luaFilterName(lua *ir.Lua) string {
+	return fmt.Sprintf("%s/%s", wellknown.Lua, lua.Name)
+}
+
+func wasmFilterName(wasm *ir.Wasm) string {
+	return fmt.Sprintf("%s/%s", wasmFilter, wasm.Name)
+}
+
+// patchHCMWithEnvoyExtensionFilters builds and appends the external processing, Lua
+// and Wasm Filters of the listener and its routes to the HTTP Connection Manager if
+// applicable, and they do not already exist. The filters are disabled on the routes
+// that do not use them, see patchRouteWithEnvoyExtensions.
+func patchHCMWithEnvoyExtensionFilters(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	return appendEnvoyExtensionFilters(mgr, listenerEnvoyExtensions(irListener))
+}
+
+// listenerEnvoyExtensions returns the extensions of the listener and of its routes.
+func listenerEnvoyExtensions(irListener *ir.HTTPListener) []*ir.EnvoyExtensions {
+	var extensions []*ir.EnvoyExtensions
+	if irListener.EnvoyExtensions != nil {
+		extensions = append(extensions, irListener.EnvoyExtensions)
+	}
+	for _, route := range irListener.Routes {
+		if route.EnvoyExtensions != nil {
+			extensions = append(extensions, route.EnvoyExtensions)
+		}
+	}
+	return extensions
+}
+
+// patchXdsHCMWithEnvoyExtensionFilters adds the extension Filters of the provided
+// listener to the HTTP Connection Manager of the default filter chain of the xDS
+// listener, which is shared by the HTTP listeners using the same port. The added
+// filters are disabled on the routes of the other listeners.
+func patchXdsHCMWithEnvoyExtensionFilters(xdsListener *listenerv3.Listener, routeCfg *routev3.RouteConfiguration,
+	irListener *ir.HTTPListener) error {
+	added, err := patchXdsHCM(xdsListener, func(mgr *hcmv3.HttpConnectionManager) error {
+		return patchHCMWithEnvoyExtensionFilters(mgr, irListener)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The routes already in the route config belong to the other listeners.
+	for _, vHost := range routeCfg.GetVirtualHosts() {
+		for _, route := range vHost.Routes {
+			if err := disableRouteEnvoyExtensionFilters(route, added); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// appendEnvoyExtensionFilters appends a filter per external processor, Lua filter
+// and Wasm module of the provided extensions to the HTTP Connection Manager,
+// skipping existing ones. The external processors run first, then the Lua filters
+// and the Wasm modules.
+func appendEnvoyExtensionFilters(mgr *hcmv3.HttpConnectionManager, extensions []*ir.EnvoyExtensions) error {
+	for _, extension := range extensions {
+		for _, extProc := range extension.ExtProc {
+			if hcmContainsFilter(mgr, extProcFilterName(extProc)) {
+				continue
+			}
+			extProcAny, err := anypb.New(buildExtProcFilter(extProc))
+			if err != nil {
+				return err
+			}
+			mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+				Name: extProcFilterName(extProc),
+				ConfigType: &hcmv3.HttpFilter_TypedConfig{
+					TypedConfig: extProcAny,
+				},
+			})
+		}
+	}
+
+	for _, extension := range extensions {
+		if err := appendLuaAndWasmFilters(mgr, extension); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func appendLuaAndWasmFilters(mgr *hcmv3.HttpConnectionManager, extensions *ir.EnvoyExtensions) error {
+	for _, lua := range extensions.Lua {
+		if hcmContainsFilter(mgr, luaFilterName(lua)) {
+			continue
+		}
+		luaAny, err := anypb.New(&luav3.Lua{
+			DefaultSourceCode: &corev3.DataSource{
+				Specifier: &corev3.DataSource_InlineString{
+					InlineString: lua.Code,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+			Name: luaFilterName(lua),
+			ConfigType: &hcmv3.HttpFilter_TypedConfig{
+				TypedConfig: luaAny,
+			},
+		})
+	}
+
+	for _, wasm := range extensions.Wasm {
+		if hcmContainsFilter(mgr, wasmFilterName(wasm)) {
+			continue
+		}
+		wasmProto, err := buildWasmFilter(wasm)
+		if err != nil {
+			return err
+		}
+		wasmAny, err := anypb.New(wasmProto)
+		if err != nil {
+			return err
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+			Name: wasmFilterName(wasm),
+			ConfigType: &hcmv3.HttpFilter_TypedConfig{
+				TypedConfig: wasmAny,
+			},
+		})
+	}
+
+	return nil
+}
+
+// findXdsEnvoyExtensionFilters returns the names of the extension filters of the
+// HTTP Connection Manager handling the routes of the provided listener.
+func findXdsEnvoyExtensionFilters(xdsListener *listenerv3.Listener, irListener *ir.HTTPListener) ([]string, error) {
+	return findXdsHCMFilters(xdsListener, irListener, func(name string) bool {
+		return strings.HasPrefix(name, extProcFilter+"/") ||
+			strings.HasPrefix(name, wellknown.Lua+"/") ||
+			strings.HasPrefix(name, wasmFilter+"/")
+	})
+}
+
+func hcmContainsFilter(mgr *hcmv3.HttpConnectionManager, name string) bool {
+	for _, httpFilter := range mgr.HttpFilters {
+		if httpFilter.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// findHCMFilter returns the filter of the HTTP Connection Manager with the
+// provided name, or nil if it does not exist.
+func findHCMFilter(mgr *hcmv3.HttpConnectionManager, name string) *hcmv3.HttpFilter {
+	for _, httpFilter := range mgr.HttpFilters {
+		if httpFilter.Name == name {
+			return httpFilter
+		}
+	}
+	return nil
+}
+
+// buildExtProcFilter returns the ext_proc filter config sending the requests to
+// the provided external processor.
+func buildExtProcFilter(extProc *ir.ExtProc) *extprocv3.ExternalProcessor {
+	filter := &extprocv3.ExternalProcessor{
+		GrpcService: &corev3.GrpcService{
+			TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
+					ClusterName: extProc.Destination.Name,
+					Authority:   extProc.Authority,
+				},
+			},
+		},
+		FailureModeAllow: extProc.FailOpen,
+		ProcessingMode:   buildExtProcProcessingMode(extProc.ProcessingMode),
+	}
+	if extProc.MessageTimeout != nil {
+		filter.MessageTimeout = durationpb.New(extProc.MessageTimeout.Duration)
+	}
+	return filter
+}
+
+// buildExtProcProcessingMode returns the processing mode of the ext_proc filter, or
+// nil to use the default mode, sending the headers of the requests and responses.
+func buildExtProcProcessingMode(mode *egv1a1.ExtProcProcessingMode) *extprocv3.ProcessingMode {
+	if mode == nil {
+		return nil
+	}
+
+	processingMode := &extprocv3.ProcessingMode{
+		RequestHeaderMode:   extprocv3.ProcessingMode_SKIP,
+		ResponseHeaderMode:  extprocv3.ProcessingMode_SKIP,
+		RequestTrailerMode:  extprocv3.ProcessingMode_SKIP,
+		ResponseTrailerMode: extprocv3.ProcessingMode_SKIP,
+	}
+	if mode.Request != nil {
+		processingMode.RequestHeaderMode = extprocv3.ProcessingMode_SEND
+		processingMode.RequestBodyMode = buildExtProcBodyMode(mode.Request.Body)
+		if mode.Request.Trailers {
+			processingMode.RequestTrailerMode = extprocv3.ProcessingMode_SEND
+		}
+	}
+	if mode.Response != nil {
+		processingMode.ResponseHeaderMode = extprocv3.ProcessingMode_SEND
+		processingMode.ResponseBodyMode = buildExtProcBodyMode(mode.Response.Body)
+		if mode.Response.Trailers {
+			processingMode.ResponseTrailerMode = extprocv3.ProcessingMode_SEND
+		}
+	}
+	return processingMode
+}
+
+func buildExtProcBodyMode(body *egv1a1.ExtProcBodyProcessingMode) extprocv3.ProcessingMode_BodySendMode {
+	if body == nil {
+		return extprocv3.ProcessingMode_NONE
+	}
+	switch *body {
+	case egv1a1.ExtProcBodyStreamed:
+		return extprocv3.ProcessingMode_STREAMED
+	case egv1a1.ExtProcBodyBuffered:
+		return extprocv3.ProcessingMode_BUFFERED
+	case egv1a1.ExtProcBodyBufferedPartial:
+		return extprocv3.ProcessingMode_BUFFERED_PARTIAL
+	}
+	return extprocv3.ProcessingMode_NONE
+}
+
+// buildWasmFilter returns the Wasm filter config running the provided module.
+func buildWasmFilter(wasm *ir.Wasm) (*wasmfilterv3.Wasm, error) {
+	code := &corev3.AsyncDataSource{}
+	if wasm.Code.URL != nil {
+		cluster, err := newWasmCodeCluster(*wasm.Code.URL)
+		if err != nil {
+			return nil, err
+		}
+		code.Specifier = &corev3.AsyncDataSource_Remote{
+			Remote: &corev3.RemoteDataSource{
+				HttpUri: &corev3.HttpUri{
+					Uri: *wasm.Code.URL,
+					HttpUpstreamType: &corev3.HttpUri_Cluster{
+						Cluster: cluster.name,
+					},
+					Timeout: durationpb.New(wasmCodeTimeout),
+				},
+				Sha256: wasm.Code.SHA256,
+			},
+		}
+	} else {
+		code.Specifier = &corev3.AsyncDataSource_Local{
+			Local: &corev3.DataSource{
+				Specifier: &corev3.DataSource_InlineBytes{
+					InlineBytes: wasm.Code.Inline,
+				},
+			},
+		}
+	}
+
+	pluginConfig := &wasmv3.PluginConfig{
+		Name: wasm.Name,
+		Vm: &wasmv3.PluginConfig_VmConfig{
+			VmConfig: &wasmv3.VmConfig{
+				VmId:    wasm.Name,
+				Runtime: wasmRuntimeV8,
+				Code:    code,
+			},
+		},
+		FailOpen: wasm.FailOpen,
+	}
+	if wasm.RootID != nil {
+		pluginConfig.RootId = *wasm.RootID
+	}
+	if wasm.Config != nil {
+		configAny, err := anypb.New(wrapperspb.String(*wasm.Config))
+		if err != nil {
+			return nil, err
+		}
+		pluginConfig.Configuration = configAny
+	}
+
+	return &wasmfilterv3.Wasm{Config: pluginConfig}, nil
+}
+
+// patchRouteWithEnvoyExtensions disables the extension filters of the HTTP
+// Connection Manager that are used neither by the route nor by its listener.
+// The external processors of the route replace the ones of its listener.
+func patchRouteWithEnvoyExtensions(route *routev3.Route, irRoute *ir.HTTPRoute, irListener *ir.HTTPListener, hcmFilters []string) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if len(hcmFilters) == 0 {
+		return nil
+	}
+
+	enabled := make(map[string]bool)
+	routeHasExtProc := irRoute.EnvoyExtensions != nil && len(irRoute.EnvoyExtensions.ExtProc) > 0
+	for _, extensions := range []*ir.EnvoyExtensions{irListener.EnvoyExtensions, irRoute.EnvoyExtensions} {
+		if extensions == nil {
+			continue
+		}
+		if extensions == irRoute.EnvoyExtensions || !routeHasExtProc {
+			for _, extProc := range extensions.ExtProc {
+				enabled[extProcFilterName(extProc)] = true
+			}
+		}
+		for _, lua := range extensions.Lua {
+			enabled[luaFilterName(lua)] = true
+		}
+		for _, wasm := range extensions.Wasm {
+			enabled[wasmFilterName(wasm)] = true
+		}
+	}
+
+	var disabled []string
+	for _, name := range hcmFilters {
+		if !enabled[name] {
+			disabled = append(disabled, name)
+		}
+	}
+
+	return disableRouteEnvoyExtensionFilters(route, disabled)
+}
+
+// disableRouteEnvoyExtensionFilters disables the provided filters on the route.
+func disableRouteEnvoyExtensionFilters(route *routev3.Route, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	disabledAny, err := anypb.New(&routev3.FilterConfig{
+		Disabled: true,
+	})
+	if err != nil {
+		return err
+	}
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	for _, name := range names {
+		route.TypedPerFilterConfig[name] = disabledAny
+	}
+
+	return nil
+}
+
+// createExtProcClusters creates the clusters of the external processors of the
+// provided listener, if needed.
+func createExtProcClusters(tCtx *types.ResourceVersionTable, irListener *ir.HTTPListener) error {
+	for _, extension := range listenerEnvoyExtensions(irListener) {
+		for _, extProc := range extension.ExtProc {
+			if err := addXdsCluster(tCtx, addXdsClusterArgs{
+				name:         extProc.Destination.Name,
+				endpoints:    extProc.Destination.Endpoints,
+				protocol:     HTTP2,
+				endpointType: Static,
+				ipFamily:     irListener.IPFamily,
+			}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type wasmCodeCluster struct {
+	name     string
+	hostname string
+	port     uint32
+	isStatic bool
+	isTLS    bool
+}
+
+// createWasmCodeClusters creates the clusters used to download the code of the
+// Wasm modules of the provided listener, if needed.
+func createWasmCodeClusters(tCtx *types.ResourceVersionTable, irListener *ir.HTTPListener) error {
+	if tCtx == nil ||
+		tCtx.XdsResources == nil ||
+		tCtx.XdsResources[resource.ClusterType] == nil {
+		return nil
+	}
+
+	extensions := []*ir.EnvoyExtensions{irListener.EnvoyExtensions}
+	for _, route := range irListener.Routes {
+		extensions = append(extensions, route.EnvoyExtensions)
+	}
+
+	for _, extension := range extensions {
+		if extension == nil {
+			continue
+		}
+		for _, wasm := range extension.Wasm {
+			if wasm.Code.URL == nil {
+				continue
+			}
+			cluster, err := newWasmCodeCluster(*wasm.Code.URL)
+			if err != nil {
+				return err
+			}
+			epType := DefaultEndpointType
+			if cluster.isStatic {
+				epType = Static
+			}
+			var tSocket *corev3.TransportSocket
+			if cluster.isTLS {
+				if tSocket, err = buildXdsUpstreamTLSSocket(); err != nil {
+					return err
+				}
+			}
+			if err := addXdsCluster(tCtx, addXdsClusterArgs{
+				name:         cluster.name,
+				endpoints:    []*ir.DestinationEndpoint{ir.NewDestEndpoint(cluster.hostname, cluster.port)},
+				tSocket:      tSocket,
+				protocol:     DefaultProtocol,
+				endpointType: epType,
+				ipFamily:     irListener.IPFamily,
+			}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// newWasmCodeCluster returns the wasmCodeCluster used to download the code from the provided URL.
+func newWasmCodeCluster(codeURL string) (*wasmCodeCluster, error) {
+	u, err := url.Parse(codeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var strPort string
+	switch u.Scheme {
+	case "http":
+		strPort = "80"
+	case "https":
+		strPort = "443"
+	default:
+		return nil, fmt.Errorf("unsupported Wasm code URL scheme %s", u.Scheme)
+	}
+
+	if u.Port() != "" {
+		strPort = u.Port()
+	}
+
+	port, err := strconv.Atoi(strPort)
+	if err != nil {
+		return nil, err
+	}
+
+	static := false
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		if v4 := ip.To4(); v4 != nil {
+			static = true
+		}
+	}
+
+	return &wasmCodeCluster{
+		name:     fmt.Sprintf("%s_%s", strings.ReplaceAll(u.Hostname(), ".", "_"), strPort),
+		hostname: u.Hostname(),
+		port:     uint32(port),
+		isStatic: static,
+		isTLS:    u.Scheme == "https",
+	}, nil
+}
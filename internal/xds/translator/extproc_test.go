@@ -0,0 +1,217 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	extprocsvcv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeExtProcServer is an external processor adding a header holding the
+// authority of the gRPC requests it receives to the processed headers.
+type fakeExtProcServer struct {
+	extprocsvcv3.UnimplementedExternalProcessorServer
+}
+
+func (s *fakeExtProcServer) Process(stream extprocsvcv3.ExternalProcessor_ProcessServer) error {
+	var authority string
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok && len(md.Get(":authority")) > 0 {
+		authority = md.Get(":authority")[0]
+	}
+	headersResponse := &extprocsvcv3.HeadersResponse{
+		Response: &extprocsvcv3.CommonResponse{
+			HeaderMutation: &extprocsvcv3.HeaderMutation{
+				SetHeaders: []*corev3.HeaderValueOption{{
+					Header: &corev3.HeaderValue{Key: "x-ext-proc-authority", Value: authority},
+				}},
+			},
+		},
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp := &extprocsvcv3.ProcessingResponse{}
+		switch req.Request.(type) {
+		case *extprocsvcv3.ProcessingRequest_RequestHeaders:
+			resp.Response = &extprocsvcv3.ProcessingResponse_RequestHeaders{RequestHeaders: headersResponse}
+		case *extprocsvcv3.ProcessingRequest_RequestBody:
+			resp.Response = &extprocsvcv3.ProcessingResponse_RequestBody{RequestBody: &extprocsvcv3.BodyResponse{}}
+		case *extprocsvcv3.ProcessingRequest_ResponseHeaders:
+			resp.Response = &extprocsvcv3.ProcessingResponse_ResponseHeaders{ResponseHeaders: headersResponse}
+		default:
+			return fmt.Errorf("unexpected request %T", req.Request)
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// TestExtProcFakeServer checks that the generated ext_proc filters and clusters
+// can be used to reach an external processor, and that the processing modes match
+// the messages the external processor is expected to handle.
+func TestExtProcFakeServer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	extprocsvcv3.RegisterExternalProcessorServer(server, &fakeExtProcServer{})
+	go func() {
+		_ = server.Serve(l)
+	}()
+	defer server.Stop()
+
+	// Send the requests of all the external processors to the fake server.
+	ir := requireXdsIRFromInputTestData(t, "xds-ir", "ext-proc.yaml")
+	port := uint32(l.Addr().(*net.TCPAddr).Port)
+	for _, extension := range listenerEnvoyExtensions(ir.HTTP[0]) {
+		for _, extProc := range extension.ExtProc {
+			for _, endpoint := range extProc.Destination.Endpoints {
+				endpoint.Host = "127.0.0.1"
+				endpoint.Port = port
+			}
+		}
+	}
+
+	tr := &Translator{}
+	tCtx, err := tr.Translate(ir)
+	require.NoError(t, err)
+
+	xdsListener := findXdsListener(tCtx, "first-listener")
+	require.NotNil(t, xdsListener)
+	mgr := &hcmv3.HttpConnectionManager{}
+	for _, filter := range xdsListener.DefaultFilterChain.Filters {
+		if filter.Name == wellknown.HTTPConnectionManager {
+			require.NoError(t, filter.GetTypedConfig().UnmarshalTo(mgr))
+		}
+	}
+
+	processors := make(map[string]*extprocv3.ExternalProcessor)
+	for _, httpFilter := range mgr.HttpFilters {
+		if httpFilter.GetTypedConfig().MessageIs(&extprocv3.ExternalProcessor{}) {
+			processor := &extprocv3.ExternalProcessor{}
+			require.NoError(t, httpFilter.GetTypedConfig().UnmarshalTo(processor))
+			processors[httpFilter.Name] = processor
+		}
+	}
+	require.Len(t, processors, 3)
+
+	testCases := []struct {
+		filter   string
+		requests []*extprocsvcv3.ProcessingRequest
+	}{
+		{
+			// The default processing mode sends the headers of the requests and responses.
+			filter: "envoy.filters.http.ext_proc/envoyextensionpolicy/default/gateway/extproc/0",
+			requests: []*extprocsvcv3.ProcessingRequest{
+				{Request: &extprocsvcv3.ProcessingRequest_RequestHeaders{RequestHeaders: &extprocsvcv3.HttpHeaders{}}},
+				{Request: &extprocsvcv3.ProcessingRequest_ResponseHeaders{ResponseHeaders: &extprocsvcv3.HttpHeaders{}}},
+			},
+		},
+		{
+			filter: "envoy.filters.http.ext_proc/envoyextensionpolicy/default/override/extproc/0",
+			requests: []*extprocsvcv3.ProcessingRequest{
+				{Request: &extprocsvcv3.ProcessingRequest_RequestHeaders{RequestHeaders: &extprocsvcv3.HttpHeaders{}}},
+				{Request: &extprocsvcv3.ProcessingRequest_RequestBody{RequestBody: &extprocsvcv3.HttpBody{Body: []byte("body"), EndOfStream: true}}},
+				{Request: &extprocsvcv3.ProcessingRequest_ResponseHeaders{ResponseHeaders: &extprocsvcv3.HttpHeaders{}}},
+			},
+		},
+		{
+			// Only the headers of the requests are sent when the response is not processed.
+			filter: "envoy.filters.http.ext_proc/envoyextensionpolicy/default/request-only/extproc/0",
+			requests: []*extprocsvcv3.ProcessingRequest{
+				{Request: &extprocsvcv3.ProcessingRequest_RequestHeaders{RequestHeaders: &extprocsvcv3.HttpHeaders{}}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.filter, func(t *testing.T) {
+			processor := processors[tc.filter]
+			require.NotNil(t, processor)
+			requireExtProcModeAllows(t, processor.ProcessingMode, tc.requests)
+
+			// Resolve the address of the external processor from the generated cluster.
+			envoyGrpc := processor.GrpcService.GetEnvoyGrpc()
+			cla := findXdsEndpoint(tCtx, envoyGrpc.ClusterName)
+			require.NotNil(t, cla)
+			require.NotNil(t, findXdsCluster(tCtx, envoyGrpc.ClusterName).GetTypedExtensionProtocolOptions())
+			socketAddress := cla.Endpoints[0].LbEndpoints[0].GetEndpoint().Address.GetSocketAddress()
+			address := net.JoinHostPort(socketAddress.Address, strconv.Itoa(int(socketAddress.GetPortValue())))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			conn, err := grpc.DialContext(ctx, address,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithAuthority(envoyGrpc.Authority))
+			require.NoError(t, err)
+			defer conn.Close()
+
+			stream, err := extprocsvcv3.NewExternalProcessorClient(conn).Process(ctx)
+			require.NoError(t, err)
+			for _, req := range tc.requests {
+				require.NoError(t, stream.Send(req))
+				resp, err := stream.Recv()
+				require.NoError(t, err)
+
+				var headers *extprocsvcv3.HeadersResponse
+				switch r := resp.Response.(type) {
+				case *extprocsvcv3.ProcessingResponse_RequestHeaders:
+					headers = r.RequestHeaders
+				case *extprocsvcv3.ProcessingResponse_ResponseHeaders:
+					headers = r.ResponseHeaders
+				case *extprocsvcv3.ProcessingResponse_RequestBody:
+					continue
+				}
+				require.NotNil(t, headers)
+				setHeaders := headers.Response.HeaderMutation.SetHeaders
+				require.Len(t, setHeaders, 1)
+				require.Equal(t, envoyGrpc.Authority, setHeaders[0].Header.Value)
+			}
+			require.NoError(t, stream.CloseSend())
+		})
+	}
+}
+
+// requireExtProcModeAllows checks that the processing mode of an ext_proc filter
+// sends the provided messages to the external processor.
+func requireExtProcModeAllows(t *testing.T, mode *extprocv3.ProcessingMode, requests []*extprocsvcv3.ProcessingRequest) {
+	t.Helper()
+
+	for _, req := range requests {
+		switch req.Request.(type) {
+		case *extprocsvcv3.ProcessingRequest_RequestHeaders:
+			require.NotEqual(t, extprocv3.ProcessingMode_SKIP, mode.GetRequestHeaderMode())
+		case *extprocsvcv3.ProcessingRequest_RequestBody:
+			require.NotEqual(t, extprocv3.ProcessingMode_NONE, mode.GetRequestBodyMode())
+		case *extprocsvcv3.ProcessingRequest_ResponseHeaders:
+			require.NotEqual(t, extprocv3.ProcessingMode_SKIP, mode.GetResponseHeaderMode())
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	commonfaultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	xdstype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// patchHCMWithFaultFilter builds and appends the Fault Filter to the HTTP
+// Connection Manager if applicable, and it does not already exist.
+// The filter itself injects no fault, the faults are configured per route.
+func patchHCMWithFaultFilter(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	if !listenerContainsFaultInjection(irListener) {
+		return nil
+	}
+
+	// Return early if filter already exists.
+	for _, httpFilter := range mgr.HttpFilters {
+		if httpFilter.Name == wellknown.Fault {
+			return nil
+		}
+	}
+
+	faultAny, err := anypb.New(&faultv3.HTTPFault{})
+	if err != nil {
+		return err
+	}
+
+	mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+		Name: wellknown.Fault,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: faultAny,
+		},
+	})
+
+	return nil
+}
+
+// patchXdsHCMWithFaultFilter adds the Fault Filter to the HTTP Connection Manager
+// of the default filter chain of the xDS listener, which is shared by the HTTP
+// listeners using the same port, if the provided listener has fault injection.
+// The routes of the other listeners have no fault per route config, so the
+// filter injects no fault in their requests.
+func patchXdsHCMWithFaultFilter(xdsListener *listenerv3.Listener, irListener *ir.HTTPListener) error {
+	_, err := patchXdsHCM(xdsListener, func(mgr *hcmv3.HttpConnectionManager) error {
+		return patchHCMWithFaultFilter(mgr, irListener)
+	})
+	return err
+}
+
+// listenerContainsFaultInjection returns true if fault injection exists for any
+// route of the provided listener.
+func listenerContainsFaultInjection(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if route.FaultInjection != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// patchRouteWithFaultInjection patches the provided route with the fault per
+// route config, if the route has fault injection.
+func patchRouteWithFaultInjection(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if irRoute.FaultInjection == nil {
+		return nil
+	}
+
+	faultAny, err := anypb.New(buildHTTPFault(irRoute.FaultInjection))
+	if err != nil {
+		return err
+	}
+
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[wellknown.Fault] = faultAny
+
+	return nil
+}
+
+// buildHTTPFault returns the fault filter config matching the IR fault injection.
+func buildHTTPFault(faultInjection *ir.FaultInjection) *faultv3.HTTPFault {
+	fault := &faultv3.HTTPFault{}
+
+	if delay := faultInjection.Delay; delay != nil {
+		fault.Delay = &commonfaultv3.FaultDelay{
+			Percentage: buildFaultPercentage(delay.Percentage),
+		}
+		if delay.HeaderControlled {
+			fault.Delay.FaultDelaySecifier = &commonfaultv3.FaultDelay_HeaderDelay_{
+				HeaderDelay: &commonfaultv3.FaultDelay_HeaderDelay{},
+			}
+		} else if delay.FixedDelay != nil {
+			fault.Delay.FaultDelaySecifier = &commonfaultv3.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(delay.FixedDelay.Duration),
+			}
+		}
+	}
+
+	if abort := faultInjection.Abort; abort != nil {
+		fault.Abort = &faultv3.FaultAbort{
+			Percentage: buildFaultPercentage(abort.Percentage),
+		}
+		switch {
+		case abort.HeaderControlled:
+			fault.Abort.ErrorType = &faultv3.FaultAbort_HeaderAbort_{
+				HeaderAbort: &faultv3.FaultAbort_HeaderAbort{},
+			}
+		case abort.GRPCStatus != nil:
+			fault.Abort.ErrorType = &faultv3.FaultAbort_GrpcStatus{
+				GrpcStatus: uint32(*abort.GRPCStatus),
+			}
+		case abort.HTTPStatus != nil:
+			fault.Abort.ErrorType = &faultv3.FaultAbort_HttpStatus{
+				HttpStatus: uint32(*abort.HTTPStatus),
+			}
+		}
+	}
+
+	if faultInjection.MaxActiveFaults != nil {
+		fault.MaxActiveFaults = wrapperspb.UInt32(*faultInjection.MaxActiveFaults)
+	}
+
+	return fault
+}
+
+// buildFaultPercentage returns the percentage of requests a fault applies to,
+// defaulting to all of them.
+func buildFaultPercentage(percentage *uint32) *xdstype.FractionalPercent {
+	numerator := uint32(100)
+	if percentage != nil {
+		numerator = *percentage
+	}
+
+	return &xdstype.FractionalPercent{
+		Numerator:   numerator,
+		Denominator: xdstype.FractionalPercent_HUNDRED,
+	}
+}
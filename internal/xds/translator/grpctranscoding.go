@@ -0,0 +1,97 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+
+	grpcjsonv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// patchHCMWithGRPCJSONTranscoders appends the gRPC-JSON transcoder filters
+// used by the routes of the provided listener to the HTTP Connection Manager.
+// The transcoders stay enabled on all the routes: they look up the route of
+// the incoming RESTful request, and the transcoded requests are matched again
+// against the method matches of the GRPCRoutes.
+func patchHCMWithGRPCJSONTranscoders(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	// Several routes may share a transcoder, so merge their services.
+	var transcoders []*ir.GRPCJSONTranscoder
+	services := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, route := range irListener.Routes {
+		if route.GRPCTranscoding == nil || route.GRPCTranscoding.JSON == nil {
+			continue
+		}
+		transcoder := route.GRPCTranscoding.JSON
+		if _, found := services[transcoder.Name]; !found {
+			transcoders = append(transcoders, transcoder)
+		}
+		for _, service := range transcoder.Services {
+			if key := transcoder.Name + "/" + service; !seen[key] {
+				seen[key] = true
+				services[transcoder.Name] = append(services[transcoder.Name], service)
+			}
+		}
+	}
+
+	for _, transcoder := range transcoders {
+		transcoderAny, err := anypb.New(buildGRPCJSONTranscoder(transcoder, services[transcoder.Name]))
+		if err != nil {
+			return err
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+			Name: grpcJSONTranscoderFilterName(transcoder),
+			ConfigType: &hcmv3.HttpFilter_TypedConfig{
+				TypedConfig: transcoderAny,
+			},
+		})
+	}
+
+	return nil
+}
+
+// grpcJSONTranscoderFilterName returns the name of the HTTP filter of a gRPC-JSON transcoder.
+func grpcJSONTranscoderFilterName(transcoder *ir.GRPCJSONTranscoder) string {
+	return fmt.Sprintf("%s/%s", wellknown.GRPCJSONTranscoder, transcoder.Name)
+}
+
+// buildGRPCJSONTranscoder returns the gRPC-JSON transcoder filter config
+// exposing the provided services.
+func buildGRPCJSONTranscoder(transcoder *ir.GRPCJSONTranscoder, services []string) *grpcjsonv3.GrpcJsonTranscoder {
+	grpcJSONTranscoder := &grpcjsonv3.GrpcJsonTranscoder{
+		DescriptorSet: &grpcjsonv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: transcoder.DescriptorSet,
+		},
+		Services: services,
+		// Expose the methods without HTTP annotations as POST requests to their gRPC path.
+		AutoMapping:                  true,
+		IgnoreUnknownQueryParameters: transcoder.IgnoreUnknownQueryParameters,
+	}
+	if printOptions := transcoder.PrintOptions; printOptions != nil {
+		grpcJSONTranscoder.PrintOptions = &grpcjsonv3.GrpcJsonTranscoder_PrintOptions{
+			AddWhitespace:              printOptions.AddWhitespace,
+			AlwaysPrintPrimitiveFields: printOptions.AlwaysPrintPrimitiveFields,
+			AlwaysPrintEnumsAsInts:     printOptions.AlwaysPrintEnumsAsInts,
+			PreserveProtoFieldNames:    printOptions.PreserveProtoFieldNames,
+		}
+	}
+
+	return grpcJSONTranscoder
+}
@@ -16,13 +16,18 @@ import (
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tcpv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	udpv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
+	preservecasev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
 	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/utils/protocov"
 	xdsfilters "github.com/envoyproxy/gateway/internal/xds/filters"
@@ -53,7 +58,7 @@ func http2ProtocolOptions() *corev3.Http2ProtocolOptions {
 	}
 }
 
-func buildXdsTCPListener(name, address string, port uint32, accesslog *ir.AccessLog) *listenerv3.Listener {
+func buildXdsTCPListener(name, address string, port uint32, ipFamily *egcfgv1a1.IPFamily, accesslog *ir.AccessLog) *listenerv3.Listener {
 	al := buildXdsAccessLog(accesslog, true)
 	return &listenerv3.Listener{
 		Name:                          name,
@@ -67,12 +72,19 @@ func buildXdsTCPListener(name, address string, port uint32, accesslog *ir.Access
 					PortSpecifier: &corev3.SocketAddress_PortValue{
 						PortValue: port,
 					},
+					Ipv4Compat: isDualStack(ipFamily),
 				},
 			},
 		},
 	}
 }
 
+// isDualStack returns true if the IP family is dual-stack, in which case the
+// listeners bound to the IPv6 wildcard address also accept IPv4 connections.
+func isDualStack(ipFamily *egcfgv1a1.IPFamily) bool {
+	return ipFamily != nil && *ipFamily == egcfgv1a1.DualStack
+}
+
 func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irListener *ir.HTTPListener,
 	accesslog *ir.AccessLog, tracing *ir.Tracing) error {
 	al := buildXdsAccessLog(accesslog, false)
@@ -84,9 +96,12 @@ func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irL
 
 	// HTTP filter configuration
 	var statPrefix string
-	if irListener.TLS != nil {
+	switch {
+	case irListener.StatPrefix != "":
+		statPrefix = irListener.StatPrefix
+	case irListener.TLS != nil:
 		statPrefix = "https"
-	} else {
+	default:
 		statPrefix = "http"
 	}
 	mgr := &hcmv3.HttpConnectionManager{
@@ -116,18 +131,44 @@ func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irL
 		Tracing: hcmTracing,
 	}
 
+	if irListener.Headers != nil {
+		if irListener.Headers.XForwardedClientCert != nil {
+			patchHCMWithXForwardedClientCert(mgr, irListener.Headers.XForwardedClientCert)
+		}
+		patchHCMWithHeaderLimits(mgr, irListener.Headers)
+	}
+	if irListener.Timeout != nil {
+		patchHCMWithClientTimeout(mgr, irListener.Timeout)
+	}
+	if irListener.Path != nil {
+		patchHCMWithPathSettings(mgr, irListener.Path)
+	}
+	if irListener.LocalReply != nil {
+		patchHCMWithLocalReply(mgr, irListener.LocalReply)
+	}
+	if irListener.HTTP1 != nil {
+		if err := patchHCMWithHTTP1Settings(mgr, irListener.HTTP1); err != nil {
+			return err
+		}
+	}
+	// Add the access logs of the routes, if needed.
+	if err := patchHCMWithRouteAccessLogs(mgr, irListener.Routes); err != nil {
+		return err
+	}
+
 	if irListener.IsHTTP2 {
 		mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCWeb)
+		// Add the gRPC-JSON transcoder filters, if needed.
+		if err := patchHCMWithGRPCJSONTranscoders(mgr, irListener); err != nil {
+			return err
+		}
 		// always enable grpc stats filter
 		mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.GRPCStats)
-	} else {
-		// Allow websocket upgrades for HTTP 1.1
-		// Reference: https://developer.mozilla.org/en-US/docs/Web/HTTP/Protocol_upgrade_mechanism
-		mgr.UpgradeConfigs = []*hcmv3.HttpConnectionManager_UpgradeConfig{
-			{
-				UpgradeType: "websocket",
-			},
-		}
+	}
+
+	// Allow the websocket upgrades for HTTP 1.1, and the configured upgrades.
+	if err := patchHCMWithUpgradeConfigs(mgr, irListener); err != nil {
+		return err
 	}
 
 	// TODO: Make this a generic interface for all API Gateway features.
@@ -139,6 +180,21 @@ func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irL
 		return err
 	}
 
+	// Add the fault filter, if needed.
+	if err := patchHCMWithFaultFilter(mgr, irListener); err != nil {
+		return err
+	}
+
+	// Add the compressor filters, if needed.
+	if err := patchHCMWithCompressorFilters(mgr, irListener); err != nil {
+		return err
+	}
+
+	// Add the Lua and Wasm filters, if needed.
+	if err := patchHCMWithEnvoyExtensionFilters(mgr, irListener); err != nil {
+		return err
+	}
+
 	// Make sure the router filter is the last one.
 	mgr.HttpFilters = append(mgr.HttpFilters, xdsfilters.HTTPRouter)
 	mgrAny, err := protocov.ToAnyWithError(mgr)
@@ -156,7 +212,7 @@ func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irL
 	}
 
 	if irListener.TLS != nil {
-		tSocket, err := buildXdsDownstreamTLSSocket(irListener.TLS)
+		tSocket, err := buildXdsDownstreamTLSSocket(irListener.TLS, irListener.TLSSettings)
 		if err != nil {
 			return err
 		}
@@ -178,6 +234,90 @@ func (t *Translator) addXdsHTTPFilterChain(xdsListener *listenerv3.Listener, irL
 	return nil
 }
 
+// patchXdsHCM patches the HTTP Connection Manager of the default filter chain of
+// the xDS listener, which is shared by the HTTP listeners using the same port,
+// using the provided function. The router filter is kept as the last filter, and
+// the names of the filters added by the function are returned.
+func patchXdsHCM(xdsListener *listenerv3.Listener, patch func(mgr *hcmv3.HttpConnectionManager) error) ([]string, error) {
+	if xdsListener == nil || xdsListener.DefaultFilterChain == nil {
+		return nil, nil
+	}
+
+	var added []string
+	for _, filter := range xdsListener.DefaultFilterChain.Filters {
+		if filter.Name != wellknown.HTTPConnectionManager {
+			continue
+		}
+		mgr := new(hcmv3.HttpConnectionManager)
+		if err := filter.GetTypedConfig().UnmarshalTo(mgr); err != nil {
+			return nil, err
+		}
+
+		// Keep the router filter as the last one.
+		filters := mgr.HttpFilters
+		if len(filters) == 0 || filters[len(filters)-1].Name != wellknown.Router {
+			return nil, errors.New("the router filter is not the last filter of the hcm")
+		}
+		router := filters[len(filters)-1]
+		mgr.HttpFilters = filters[:len(filters)-1]
+		existing := len(mgr.HttpFilters)
+		if err := patch(mgr); err != nil {
+			return nil, err
+		}
+		for _, httpFilter := range mgr.HttpFilters[existing:] {
+			added = append(added, httpFilter.Name)
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, router)
+
+		mgrAny, err := protocov.ToAnyWithError(mgr)
+		if err != nil {
+			return nil, err
+		}
+		filter.ConfigType = &listenerv3.Filter_TypedConfig{TypedConfig: mgrAny}
+	}
+
+	return added, nil
+}
+
+// findXdsHCMFilters returns the names of the filters of the HTTP Connection
+// Manager handling the routes of the provided listener that match the provided
+// function.
+func findXdsHCMFilters(xdsListener *listenerv3.Listener, irListener *ir.HTTPListener,
+	match func(name string) bool) ([]string, error) {
+	routeConfigName := irListener.Name
+	if irListener.TLS == nil {
+		routeConfigName = findXdsHTTPRouteConfigName(xdsListener)
+	}
+
+	filterChains := xdsListener.FilterChains
+	if xdsListener.DefaultFilterChain != nil {
+		filterChains = append([]*listenerv3.FilterChain{xdsListener.DefaultFilterChain}, filterChains...)
+	}
+	for _, filterChain := range filterChains {
+		for _, filter := range filterChain.Filters {
+			if filter.Name != wellknown.HTTPConnectionManager {
+				continue
+			}
+			mgr := new(hcmv3.HttpConnectionManager)
+			if err := filter.GetTypedConfig().UnmarshalTo(mgr); err != nil {
+				return nil, err
+			}
+			if mgr.GetRds().GetRouteConfigName() != routeConfigName {
+				continue
+			}
+			var names []string
+			for _, httpFilter := range mgr.HttpFilters {
+				if match(httpFilter.Name) {
+					names = append(names, httpFilter.Name)
+				}
+			}
+			return names, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func addServerNamesMatch(xdsListener *listenerv3.Listener, filterChain *listenerv3.FilterChain, hostnames []string) error {
 	// Dont add a filter chain match if the hostname is a wildcard character.
 	if len(hostnames) > 0 && hostnames[0] != "*" {
@@ -261,7 +401,7 @@ func addXdsTCPFilterChain(xdsListener *listenerv3.Listener, irListener *ir.TCPLi
 	}
 
 	if isTLSTerminate {
-		tSocket, err := buildXdsDownstreamTLSSocket(irListener.TLS.Terminate)
+		tSocket, err := buildXdsDownstreamTLSSocket(irListener.TLS.Terminate, nil)
 		if err != nil {
 			return err
 		}
@@ -300,7 +440,7 @@ func addXdsTLSInspectorFilter(xdsListener *listenerv3.Listener) error {
 	return nil
 }
 
-func buildXdsDownstreamTLSSocket(tlsConfigs []*ir.TLSListenerConfig) (*corev3.TransportSocket, error) {
+func buildXdsDownstreamTLSSocket(tlsConfigs []*ir.TLSListenerConfig, tlsSettings *ir.TLSSettings) (*corev3.TransportSocket, error) {
 	tlsCtx := &tlsv3.DownstreamTlsContext{
 		CommonTlsContext: &tlsv3.CommonTlsContext{
 			AlpnProtocols:                  []string{"h2", "http/1.1"},
@@ -317,6 +457,10 @@ func buildXdsDownstreamTLSSocket(tlsConfigs []*ir.TLSListenerConfig) (*corev3.Tr
 			})
 	}
 
+	if tlsSettings != nil {
+		patchDownstreamTLSContextWithSettings(tlsCtx, tlsSettings)
+	}
+
 	tlsCtxAny, err := anypb.New(tlsCtx)
 	if err != nil {
 		return nil, err
@@ -330,6 +474,210 @@ func buildXdsDownstreamTLSSocket(tlsConfigs []*ir.TLSListenerConfig) (*corev3.Tr
 	}, nil
 }
 
+// patchDownstreamTLSContextWithSettings applies the TLS parameters, ALPN protocols
+// and client certificate validation settings to the downstream TLS context.
+func patchDownstreamTLSContextWithSettings(tlsCtx *tlsv3.DownstreamTlsContext, tlsSettings *ir.TLSSettings) {
+	if tlsSettings.MinVersion != nil || tlsSettings.MaxVersion != nil ||
+		len(tlsSettings.Ciphers) > 0 || len(tlsSettings.ECDHCurves) > 0 {
+		tlsCtx.CommonTlsContext.TlsParams = &tlsv3.TlsParameters{
+			TlsMinimumProtocolVersion: buildTLSVersion(tlsSettings.MinVersion),
+			TlsMaximumProtocolVersion: buildTLSVersion(tlsSettings.MaxVersion),
+			CipherSuites:              tlsSettings.Ciphers,
+			EcdhCurves:                tlsSettings.ECDHCurves,
+		}
+	}
+
+	if len(tlsSettings.ALPNProtocols) > 0 {
+		tlsCtx.CommonTlsContext.AlpnProtocols = tlsSettings.ALPNProtocols
+	}
+
+	validation := tlsSettings.ClientCertificateValidation
+	if validation == nil {
+		return
+	}
+
+	tlsCtx.RequireClientCertificate = wrapperspb.Bool(!validation.Optional)
+	sdsSecretConfig := &tlsv3.SdsSecretConfig{
+		Name:      validation.Name,
+		SdsConfig: makeConfigSource(),
+	}
+	if len(validation.SubjectAltNames) == 0 {
+		tlsCtx.CommonTlsContext.ValidationContextType = &tlsv3.CommonTlsContext_ValidationContextSdsSecretConfig{
+			ValidationContextSdsSecretConfig: sdsSecretConfig,
+		}
+		return
+	}
+
+	// The SAN matchers are merged with the CA certificate fetched using SDS.
+	defaultValidationCtx := &tlsv3.CertificateValidationContext{}
+	for _, san := range validation.SubjectAltNames {
+		defaultValidationCtx.MatchTypedSubjectAltNames = append(defaultValidationCtx.MatchTypedSubjectAltNames,
+			&tlsv3.SubjectAltNameMatcher{
+				SanType: buildSubjectAltNameType(san.Type),
+				Matcher: &matcherv3.StringMatcher{
+					MatchPattern: &matcherv3.StringMatcher_Exact{
+						Exact: san.Value,
+					},
+				},
+			})
+	}
+	tlsCtx.CommonTlsContext.ValidationContextType = &tlsv3.CommonTlsContext_CombinedValidationContext{
+		CombinedValidationContext: &tlsv3.CommonTlsContext_CombinedCertificateValidationContext{
+			DefaultValidationContext:         defaultValidationCtx,
+			ValidationContextSdsSecretConfig: sdsSecretConfig,
+		},
+	}
+}
+
+func buildTLSVersion(version *egv1a1.TLSVersion) tlsv3.TlsParameters_TlsProtocol {
+	if version == nil {
+		return tlsv3.TlsParameters_TLS_AUTO
+	}
+	switch *version {
+	case egv1a1.TLSv10:
+		return tlsv3.TlsParameters_TLSv1_0
+	case egv1a1.TLSv11:
+		return tlsv3.TlsParameters_TLSv1_1
+	case egv1a1.TLSv12:
+		return tlsv3.TlsParameters_TLSv1_2
+	case egv1a1.TLSv13:
+		return tlsv3.TlsParameters_TLSv1_3
+	default:
+		return tlsv3.TlsParameters_TLS_AUTO
+	}
+}
+
+func buildSubjectAltNameType(sanType egv1a1.SubjectAltNameType) tlsv3.SubjectAltNameMatcher_SanType {
+	switch sanType {
+	case egv1a1.SubjectAltNameTypeDNS:
+		return tlsv3.SubjectAltNameMatcher_DNS
+	case egv1a1.SubjectAltNameTypeURI:
+		return tlsv3.SubjectAltNameMatcher_URI
+	case egv1a1.SubjectAltNameTypeEmail:
+		return tlsv3.SubjectAltNameMatcher_EMAIL
+	case egv1a1.SubjectAltNameTypeIPAddress:
+		return tlsv3.SubjectAltNameMatcher_IP_ADDRESS
+	default:
+		return tlsv3.SubjectAltNameMatcher_SAN_TYPE_UNSPECIFIED
+	}
+}
+
+// patchHCMWithXForwardedClientCert configures how the x-forwarded-client-cert
+// header is handled by the HTTP connection manager.
+func patchHCMWithXForwardedClientCert(mgr *hcmv3.HttpConnectionManager, xfcc *ir.XForwardedClientCert) {
+	switch xfcc.Mode {
+	case egv1a1.XFCCForwardModeForwardOnly:
+		mgr.ForwardClientCertDetails = hcmv3.HttpConnectionManager_FORWARD_ONLY
+	case egv1a1.XFCCForwardModeAppendForward:
+		mgr.ForwardClientCertDetails = hcmv3.HttpConnectionManager_APPEND_FORWARD
+	case egv1a1.XFCCForwardModeSanitizeSet:
+		mgr.ForwardClientCertDetails = hcmv3.HttpConnectionManager_SANITIZE_SET
+	case egv1a1.XFCCForwardModeAlwaysForwardOnly:
+		mgr.ForwardClientCertDetails = hcmv3.HttpConnectionManager_ALWAYS_FORWARD_ONLY
+	default:
+		mgr.ForwardClientCertDetails = hcmv3.HttpConnectionManager_SANITIZE
+	}
+
+	if len(xfcc.CertDetailsToAdd) == 0 {
+		return
+	}
+	details := &hcmv3.HttpConnectionManager_SetCurrentClientCertDetails{}
+	for _, data := range xfcc.CertDetailsToAdd {
+		switch data {
+		case egv1a1.XFCCCertDataSubject:
+			details.Subject = wrapperspb.Bool(true)
+		case egv1a1.XFCCCertDataCert:
+			details.Cert = true
+		case egv1a1.XFCCCertDataChain:
+			details.Chain = true
+		case egv1a1.XFCCCertDataDNS:
+			details.Dns = true
+		case egv1a1.XFCCCertDataURI:
+			details.Uri = true
+		}
+	}
+	mgr.SetCurrentClientCertDetails = details
+}
+
+// patchHCMWithHeaderLimits limits the size and number of the request headers.
+func patchHCMWithHeaderLimits(mgr *hcmv3.HttpConnectionManager, headers *ir.HeaderSettings) {
+	if headers.MaxRequestHeadersKiB != nil {
+		mgr.MaxRequestHeadersKb = wrapperspb.UInt32(*headers.MaxRequestHeadersKiB)
+	}
+	if headers.MaxRequestHeaders != nil {
+		mgr.CommonHttpProtocolOptions.MaxHeadersCount = wrapperspb.UInt32(*headers.MaxRequestHeaders)
+	}
+}
+
+// patchHCMWithClientTimeout sets the timeouts of the downstream connections and requests.
+func patchHCMWithClientTimeout(mgr *hcmv3.HttpConnectionManager, timeout *ir.ClientTimeout) {
+	if timeout.Request != nil {
+		mgr.RequestTimeout = durationpb.New(timeout.Request.Duration)
+	}
+	if timeout.RequestHeaders != nil {
+		mgr.RequestHeadersTimeout = durationpb.New(timeout.RequestHeaders.Duration)
+	}
+	if timeout.Idle != nil {
+		mgr.CommonHttpProtocolOptions.IdleTimeout = durationpb.New(timeout.Idle.Duration)
+	}
+	if timeout.StreamIdle != nil {
+		mgr.StreamIdleTimeout = durationpb.New(timeout.StreamIdle.Duration)
+	}
+}
+
+// patchHCMWithPathSettings overrides the default path normalization settings.
+func patchHCMWithPathSettings(mgr *hcmv3.HttpConnectionManager, path *ir.PathSettings) {
+	mgr.NormalizePath = wrapperspb.Bool(!path.DisableNormalization)
+	mgr.MergeSlashes = !path.DisableMergeSlashes
+
+	switch path.EscapedSlashesAction {
+	case egv1a1.PathEscapedSlashActionKeepUnchanged:
+		mgr.PathWithEscapedSlashesAction = hcmv3.HttpConnectionManager_KEEP_UNCHANGED
+	case egv1a1.PathEscapedSlashActionRejectRequest:
+		mgr.PathWithEscapedSlashesAction = hcmv3.HttpConnectionManager_REJECT_REQUEST
+	case egv1a1.PathEscapedSlashActionUnescapeAndForward:
+		mgr.PathWithEscapedSlashesAction = hcmv3.HttpConnectionManager_UNESCAPE_AND_FORWARD
+	default:
+		mgr.PathWithEscapedSlashesAction = hcmv3.HttpConnectionManager_UNESCAPE_AND_REDIRECT
+	}
+}
+
+// patchHCMWithHTTP1Settings sets the HTTP/1 protocol options of the downstream connections.
+func patchHCMWithHTTP1Settings(mgr *hcmv3.HttpConnectionManager, http1 *ir.HTTP1Settings) error {
+	options, err := buildHTTP1ProtocolOptions(http1)
+	if err != nil {
+		return err
+	}
+	mgr.HttpProtocolOptions = options
+	return nil
+}
+
+// buildHTTP1ProtocolOptions returns the HTTP/1 protocol options matching the settings.
+// They are used for both the downstream and the upstream connections, since preserving
+// the case of the headers requires configuring both.
+func buildHTTP1ProtocolOptions(http1 *ir.HTTP1Settings) (*corev3.Http1ProtocolOptions, error) {
+	options := &corev3.Http1ProtocolOptions{
+		AcceptHttp_10: http1.EnableHTTP10,
+	}
+
+	if http1.PreserveHeaderCase {
+		preserveCaseAny, err := anypb.New(&preservecasev3.PreserveCaseFormatterConfig{})
+		if err != nil {
+			return nil, err
+		}
+		options.HeaderKeyFormat = &corev3.Http1ProtocolOptions_HeaderKeyFormat{
+			HeaderFormat: &corev3.Http1ProtocolOptions_HeaderKeyFormat_StatefulFormatter{
+				StatefulFormatter: &corev3.TypedExtensionConfig{
+					Name:        "preserve_case",
+					TypedConfig: preserveCaseAny,
+				},
+			},
+		}
+	}
+
+	return options, nil
+}
+
 func buildXdsDownstreamTLSSecret(tlsConfig *ir.TLSListenerConfig) *tlsv3.Secret {
 	// Build the tls secret
 	return &tlsv3.Secret{
@@ -347,6 +695,20 @@ func buildXdsDownstreamTLSSecret(tlsConfig *ir.TLSListenerConfig) *tlsv3.Secret
 	}
 }
 
+func buildXdsDownstreamTLSCASecret(validation *ir.ClientCertificateValidation) *tlsv3.Secret {
+	// Build the CA secret used to validate client certificates
+	return &tlsv3.Secret{
+		Name: validation.Name,
+		Type: &tlsv3.Secret_ValidationContext{
+			ValidationContext: &tlsv3.CertificateValidationContext{
+				TrustedCa: &corev3.DataSource{
+					Specifier: &corev3.DataSource_InlineBytes{InlineBytes: validation.CACertificate},
+				},
+			},
+		},
+	}
+}
+
 func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener, accesslog *ir.AccessLog) (*listenerv3.Listener, error) {
 	if udpListener == nil {
 		return nil, errors.New("udp listener is nil")
@@ -394,6 +756,7 @@ func buildXdsUDPListener(clusterName string, udpListener *ir.UDPListener, access
 					PortSpecifier: &corev3.SocketAddress_PortValue{
 						PortValue: udpListener.Port,
 					},
+					Ipv4Compat: isDualStack(udpListener.IPFamily),
 				},
 			},
 		},
@@ -0,0 +1,118 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// localReplyStatusCodeRuntimeKey is the runtime key required by the status
+	// code filters. It is never set, so the configured status code is always used.
+	localReplyStatusCodeRuntimeKey = "envoy_gateway.local_reply.status_code"
+	// localReplyBodyFormat keeps the body of the local reply unchanged, so that
+	// only the content type is overridden.
+	localReplyBodyFormat = "%LOCAL_REPLY_BODY%"
+)
+
+// patchHCMWithLocalReply maps the local replies of the HCM to the custom responses.
+func patchHCMWithLocalReply(mgr *hcmv3.HttpConnectionManager, localReply *ir.LocalReply) {
+	mappers := make([]*hcmv3.ResponseMapper, 0, len(localReply.Mappings))
+	for _, mapping := range localReply.Mappings {
+		mapper := &hcmv3.ResponseMapper{
+			Filter: buildLocalReplyFilter(mapping),
+		}
+		if mapping.StatusCode != nil {
+			mapper.StatusCode = wrapperspb.UInt32(*mapping.StatusCode)
+		}
+		if mapping.Body != nil {
+			mapper.Body = &corev3.DataSource{
+				Specifier: &corev3.DataSource_InlineString{
+					InlineString: *mapping.Body,
+				},
+			}
+		}
+		if mapping.ContentType != nil {
+			mapper.BodyFormatOverride = &corev3.SubstitutionFormatString{
+				Format: &corev3.SubstitutionFormatString_TextFormatSource{
+					TextFormatSource: &corev3.DataSource{
+						Specifier: &corev3.DataSource_InlineString{
+							InlineString: localReplyBodyFormat,
+						},
+					},
+				},
+				ContentType: *mapping.ContentType,
+			}
+		}
+		mappers = append(mappers, mapper)
+	}
+
+	mgr.LocalReplyConfig = &hcmv3.LocalReplyConfig{
+		Mappers: mappers,
+	}
+}
+
+// buildLocalReplyFilter returns the filter matching the local replies with any of the
+// status codes of the mapping, and any of its response flags.
+func buildLocalReplyFilter(mapping *ir.LocalReplyMapping) *accesslog.AccessLogFilter {
+	var filters []*accesslog.AccessLogFilter
+
+	if len(mapping.StatusCodes) > 0 {
+		statusCodeFilters := make([]*accesslog.AccessLogFilter, 0, len(mapping.StatusCodes))
+		for _, statusCode := range mapping.StatusCodes {
+			statusCodeFilters = append(statusCodeFilters, &accesslog.AccessLogFilter{
+				FilterSpecifier: &accesslog.AccessLogFilter_StatusCodeFilter{
+					StatusCodeFilter: &accesslog.StatusCodeFilter{
+						Comparison: &accesslog.ComparisonFilter{
+							Op: accesslog.ComparisonFilter_EQ,
+							Value: &corev3.RuntimeUInt32{
+								DefaultValue: statusCode,
+								RuntimeKey:   localReplyStatusCodeRuntimeKey,
+							},
+						},
+					},
+				},
+			})
+		}
+		if len(statusCodeFilters) == 1 {
+			filters = append(filters, statusCodeFilters[0])
+		} else {
+			filters = append(filters, &accesslog.AccessLogFilter{
+				FilterSpecifier: &accesslog.AccessLogFilter_OrFilter{
+					OrFilter: &accesslog.OrFilter{
+						Filters: statusCodeFilters,
+					},
+				},
+			})
+		}
+	}
+
+	if len(mapping.ResponseFlags) > 0 {
+		filters = append(filters, &accesslog.AccessLogFilter{
+			FilterSpecifier: &accesslog.AccessLogFilter_ResponseFlagFilter{
+				ResponseFlagFilter: &accesslog.ResponseFlagFilter{
+					Flags: mapping.ResponseFlags,
+				},
+			},
+		})
+	}
+
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &accesslog.AccessLogFilter{
+		FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+			AndFilter: &accesslog.AndFilter{
+				Filters: filters,
+			},
+		},
+	}
+}
@@ -442,6 +442,7 @@ func (t *Translator) createRateLimitServiceCluster(tCtx *types.ResourceVersionTa
 		tSocket:      tSocket,
 		protocol:     HTTP2,
 		endpointType: DefaultEndpointType,
+		ipFamily:     irListener.IPFamily,
 	}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 		return err
 	}
@@ -12,12 +12,13 @@ import (
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	xdstype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
-func buildXdsRoute(httpRoute *ir.HTTPRoute, listener *listenerv3.Listener) *routev3.Route {
+func buildXdsRoute(httpRoute *ir.HTTPRoute, httpListener *ir.HTTPListener, listener *listenerv3.Listener) *routev3.Route {
 	router := &routev3.Route{
 		Name:  httpRoute.Name,
 		Match: buildXdsRouteMatch(httpRoute.PathMatch, httpRoute.HeaderMatches, httpRoute.QueryParamMatches),
@@ -44,8 +45,8 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute, listener *listenerv3.Listener) *rout
 		router.Action = &routev3.Route_Redirect{Redirect: buildXdsRedirectAction(httpRoute.Redirect)}
 	case httpRoute.URLRewrite != nil:
 		routeAction := buildXdsURLRewriteAction(httpRoute.Destination.Name, httpRoute.URLRewrite)
-		if httpRoute.Mirror != nil {
-			routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirror.Name)
+		if len(httpRoute.Mirrors) > 0 {
+			routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirrors)
 		}
 
 		router.Action = &routev3.Route_Route{Route: routeAction}
@@ -53,14 +54,14 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute, listener *listenerv3.Listener) *rout
 		if httpRoute.BackendWeights.Invalid != 0 {
 			// If there are invalid backends then a weighted cluster is required for the route
 			routeAction := buildXdsWeightedRouteAction(httpRoute)
-			if httpRoute.Mirror != nil {
-				routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirror.Name)
+			if len(httpRoute.Mirrors) > 0 {
+				routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirrors)
 			}
 			router.Action = &routev3.Route_Route{Route: routeAction}
 		} else {
 			routeAction := buildXdsRouteAction(httpRoute.Destination.Name)
-			if httpRoute.Mirror != nil {
-				routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirror.Name)
+			if len(httpRoute.Mirrors) > 0 {
+				routeAction.RequestMirrorPolicies = buildXdsRequestMirrorPolicies(httpRoute.Mirrors)
 			}
 			router.Action = &routev3.Route_Route{Route: routeAction}
 		}
@@ -77,6 +78,16 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute, listener *listenerv3.Listener) *rout
 		return nil
 	}
 
+	// Add the fault per route config to the route, if needed.
+	if err := patchRouteWithFaultInjection(router, httpRoute); err != nil {
+		return nil
+	}
+
+	// Override the upgrade types of the listener for the route, if needed.
+	if err := patchRouteWithUpgrades(router, httpRoute, httpListener); err != nil {
+		return nil
+	}
+
 	return router
 }
 
@@ -144,6 +155,38 @@ func buildXdsRouteMatch(pathMatch *ir.StringMatch, headerMatches []*ir.StringMat
 	return outMatch
 }
 
+// appendXdsVirtualClusters appends the virtual clusters of a route to the
+// virtual clusters of its virtual host, skipping the ones the virtual host
+// already has, such as the virtual clusters of the other matches of the rule.
+func appendXdsVirtualClusters(vClusters []*routev3.VirtualCluster, irVirtualClusters []*ir.VirtualCluster) []*routev3.VirtualCluster {
+	for _, irVirtualCluster := range irVirtualClusters {
+		exists := false
+		for _, vCluster := range vClusters {
+			if vCluster.Name == irVirtualCluster.Name {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			continue
+		}
+
+		vCluster := &routev3.VirtualCluster{
+			Name: irVirtualCluster.Name,
+		}
+		for _, headerMatch := range irVirtualCluster.HeaderMatches {
+			vCluster.Headers = append(vCluster.Headers, &routev3.HeaderMatcher{
+				Name: headerMatch.Name,
+				HeaderMatchSpecifier: &routev3.HeaderMatcher_StringMatch{
+					StringMatch: buildXdsStringMatcher(headerMatch),
+				},
+			})
+		}
+		vClusters = append(vClusters, vCluster)
+	}
+	return vClusters
+}
+
 func buildXdsStringMatcher(irMatch *ir.StringMatch) *matcherv3.StringMatcher {
 	stringMatcher := new(matcherv3.StringMatcher)
 
@@ -265,14 +308,34 @@ func buildXdsURLRewriteAction(destName string, urlRewrite *ir.URLRewrite) *route
 			}
 		} else if urlRewrite.Path.PrefixMatchReplace != nil {
 			routeAction.PrefixRewrite = *urlRewrite.Path.PrefixMatchReplace
+		} else if urlRewrite.Path.RegexMatchReplace != nil {
+			routeAction.RegexRewrite = &matcherv3.RegexMatchAndSubstitute{
+				Pattern: &matcherv3.RegexMatcher{
+					Regex: urlRewrite.Path.RegexMatchReplace.Pattern,
+				},
+				Substitution: urlRewrite.Path.RegexMatchReplace.Substitution,
+			}
 		}
 	}
 
-	if urlRewrite.Hostname != nil {
+	switch {
+	case urlRewrite.Hostname != nil:
 		routeAction.HostRewriteSpecifier = &routev3.RouteAction_HostRewriteLiteral{
 			HostRewriteLiteral: *urlRewrite.Hostname,
 		}
 
+		routeAction.AppendXForwardedHost = true
+	case urlRewrite.HostnameHeader != nil:
+		routeAction.HostRewriteSpecifier = &routev3.RouteAction_HostRewriteHeader{
+			HostRewriteHeader: *urlRewrite.HostnameHeader,
+		}
+
+		routeAction.AppendXForwardedHost = true
+	case urlRewrite.BackendHostname:
+		routeAction.HostRewriteSpecifier = &routev3.RouteAction_AutoHostRewrite{
+			AutoHostRewrite: wrapperspb.Bool(true),
+		}
+
 		routeAction.AppendXForwardedHost = true
 	}
 
@@ -293,11 +356,22 @@ func buildXdsDirectResponseAction(res *ir.DirectResponse) *routev3.DirectRespons
 	return routeAction
 }
 
-func buildXdsRequestMirrorPolicies(destName string) []*routev3.RouteAction_RequestMirrorPolicy {
-	mirrorPolicies := []*routev3.RouteAction_RequestMirrorPolicy{
-		{
-			Cluster: destName,
-		},
+func buildXdsRequestMirrorPolicies(mirrors []*ir.MirrorPolicy) []*routev3.RouteAction_RequestMirrorPolicy {
+	mirrorPolicies := make([]*routev3.RouteAction_RequestMirrorPolicy, 0, len(mirrors))
+
+	for _, mirror := range mirrors {
+		mirrorPolicy := &routev3.RouteAction_RequestMirrorPolicy{
+			Cluster: mirror.Destination.Name,
+		}
+		if mirror.Percentage != nil {
+			mirrorPolicy.RuntimeFraction = &corev3.RuntimeFractionalPercent{
+				DefaultValue: &xdstype.FractionalPercent{
+					Numerator:   *mirror.Percentage,
+					Denominator: xdstype.FractionalPercent_HUNDRED,
+				},
+			}
+		}
+		mirrorPolicies = append(mirrorPolicies, mirrorPolicy)
 	}
 
 	return mirrorPolicies
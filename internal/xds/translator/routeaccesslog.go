@@ -0,0 +1,169 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"fmt"
+
+	accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	celfilter "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/filters/cel/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+)
+
+const (
+	celAccessLogFilter = "envoy.access_loggers.extension_filters.cel"
+
+	// routeAccessLogMetadataNamespace and routeAccessLogMetadataKey locate the
+	// name of the access logs of a route in the metadata of the route.
+	routeAccessLogMetadataNamespace = "envoy-gateway"
+	routeAccessLogMetadataKey       = "access_log"
+)
+
+// patchRouteWithAccessLog sets the name of the access logs of the route in
+// its metadata, which the route access logs of the HCM filter on.
+func patchRouteWithAccessLog(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil || irRoute.AccessLog == nil {
+		return nil
+	}
+
+	fields, err := structpb.NewStruct(map[string]interface{}{
+		routeAccessLogMetadataKey: irRoute.AccessLog.Name,
+	})
+	if err != nil {
+		return err
+	}
+	if route.Metadata == nil {
+		route.Metadata = &corev3.Metadata{}
+	}
+	if route.Metadata.FilterMetadata == nil {
+		route.Metadata.FilterMetadata = map[string]*structpb.Struct{}
+	}
+	route.Metadata.FilterMetadata[routeAccessLogMetadataNamespace] = fields
+	return nil
+}
+
+// patchHCMWithRouteAccessLogs appends the access logs of the routes to the
+// HTTP Connection Manager, skipping the ones it already has. Each access log
+// only logs the requests matching a route it belongs to.
+func patchHCMWithRouteAccessLogs(mgr *hcmv3.HttpConnectionManager, routes []*ir.HTTPRoute) error {
+	existing := make(map[string]bool)
+	for _, al := range mgr.AccessLog {
+		if expr := routeAccessLogExpression(al); expr != "" {
+			existing[expr] = true
+		}
+	}
+
+	for _, route := range routes {
+		if route.AccessLog == nil || route.AccessLog.AccessLog == nil {
+			continue
+		}
+		expr := buildRouteAccessLogExpression(route.AccessLog.Name)
+		if existing[expr] {
+			continue
+		}
+		existing[expr] = true
+
+		routeFilter, err := buildXdsRouteAccessLogFilter(expr)
+		if err != nil {
+			return err
+		}
+		for _, al := range buildXdsAccessLog(route.AccessLog.AccessLog, false) {
+			if al.Filter == nil {
+				al.Filter = routeFilter
+			} else {
+				al.Filter = &accesslog.AccessLogFilter{
+					FilterSpecifier: &accesslog.AccessLogFilter_AndFilter{
+						AndFilter: &accesslog.AndFilter{
+							Filters: []*accesslog.AccessLogFilter{routeFilter, al.Filter},
+						},
+					},
+				}
+			}
+			mgr.AccessLog = append(mgr.AccessLog, al)
+		}
+	}
+
+	return nil
+}
+
+// patchXdsHCMWithRouteAccessLogs appends the access logs of the routes of the
+// listener to the HTTP Connection Manager of the default filter chain, which is
+// shared by the HTTP listeners on the same port.
+func patchXdsHCMWithRouteAccessLogs(xdsListener *listenerv3.Listener, irListener *ir.HTTPListener) error {
+	if xdsListener == nil || xdsListener.DefaultFilterChain == nil {
+		return nil
+	}
+
+	for _, filter := range xdsListener.DefaultFilterChain.Filters {
+		if filter.Name != wellknown.HTTPConnectionManager {
+			continue
+		}
+		mgr := new(hcmv3.HttpConnectionManager)
+		if err := filter.GetTypedConfig().UnmarshalTo(mgr); err != nil {
+			return err
+		}
+		if err := patchHCMWithRouteAccessLogs(mgr, irListener.Routes); err != nil {
+			return err
+		}
+		mgrAny, err := protocov.ToAnyWithError(mgr)
+		if err != nil {
+			return err
+		}
+		filter.ConfigType = &listenerv3.Filter_TypedConfig{TypedConfig: mgrAny}
+	}
+
+	return nil
+}
+
+// buildRouteAccessLogExpression returns the CEL expression matching the
+// requests of the routes whose access logs have the provided name.
+func buildRouteAccessLogExpression(name string) string {
+	return fmt.Sprintf("'%s' in xds.route_metadata.filter_metadata && "+
+		"xds.route_metadata.filter_metadata['%s']['%s'] == '%s'",
+		routeAccessLogMetadataNamespace, routeAccessLogMetadataNamespace, routeAccessLogMetadataKey, name)
+}
+
+func buildXdsRouteAccessLogFilter(expr string) (*accesslog.AccessLogFilter, error) {
+	filterAny, err := anypb.New(&celfilter.ExpressionFilter{Expression: expr})
+	if err != nil {
+		return nil, err
+	}
+	return &accesslog.AccessLogFilter{
+		FilterSpecifier: &accesslog.AccessLogFilter_ExtensionFilter{
+			ExtensionFilter: &accesslog.ExtensionFilter{
+				Name:       celAccessLogFilter,
+				ConfigType: &accesslog.ExtensionFilter_TypedConfig{TypedConfig: filterAny},
+			},
+		},
+	}, nil
+}
+
+// routeAccessLogExpression returns the CEL expression of the route filter
+// of the access log, or an empty string if it is not a route access log.
+func routeAccessLogExpression(al *accesslog.AccessLog) string {
+	filter := al.GetFilter()
+	if and := filter.GetAndFilter(); and != nil && len(and.Filters) > 0 {
+		filter = and.Filters[0]
+	}
+	ext := filter.GetExtensionFilter()
+	if ext == nil || ext.Name != celAccessLogFilter {
+		return ""
+	}
+	expr := new(celfilter.ExpressionFilter)
+	if err := ext.GetTypedConfig().UnmarshalTo(expr); err != nil {
+		return ""
+	}
+	return expr.Expression
+}
@@ -9,11 +9,13 @@ import (
 	"sort"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	tracecfg "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tracingtype "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
 	xdstype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -26,26 +28,93 @@ func buildHCMTracing(tracing *ir.Tracing) (*hcm.HttpConnectionManager_Tracing, e
 		return nil, nil
 	}
 
-	oc := &tracecfg.OpenTelemetryConfig{
-		GrpcService: &corev3.GrpcService{
-			TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
-				EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
-					ClusterName: buildClusterName("tracing", tracing.Provider.Host, uint32(tracing.Provider.Port)),
-					Authority:   tracing.Provider.Host,
+	provider, err := buildTracingProvider(tracing)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := buildTracingCustomTags(tracing.CustomTags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcm.HttpConnectionManager_Tracing{
+		ClientSampling: &xdstype.Percent{
+			Value: 100.0,
+		},
+		OverallSampling: &xdstype.Percent{
+			Value: 100.0,
+		},
+		RandomSampling: &xdstype.Percent{
+			Value: float64(tracing.SamplingRate),
+		},
+		Provider:   provider,
+		CustomTags: tags,
+	}, nil
+}
+
+// buildTracingProvider builds the HTTP tracer of the tracing provider.
+func buildTracingProvider(tracing *ir.Tracing) (*tracecfg.Tracing_Http, error) {
+	var (
+		name   string
+		config proto.Message
+	)
+	switch {
+	case tracing.OpenTelemetry != nil:
+		name = "envoy.tracers.opentelemetry"
+		config = &tracecfg.OpenTelemetryConfig{
+			GrpcService: &corev3.GrpcService{
+				TargetSpecifier: &corev3.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &corev3.GrpcService_EnvoyGrpc{
+						ClusterName: buildClusterName("tracing", tracing.OpenTelemetry.Host, tracing.OpenTelemetry.Port),
+						Authority:   tracing.OpenTelemetry.Host,
+					},
 				},
 			},
-		},
-		ServiceName: tracing.ServiceName,
+			ServiceName: tracing.ServiceName,
+		}
+	case tracing.Zipkin != nil:
+		version := tracecfg.ZipkinConfig_HTTP_JSON
+		if tracing.Zipkin.Encoding == egcfgv1a1.ZipkinEncodingProto {
+			version = tracecfg.ZipkinConfig_HTTP_PROTO
+		}
+		name = "envoy.tracers.zipkin"
+		config = &tracecfg.ZipkinConfig{
+			CollectorCluster:         buildClusterName("tracing", tracing.Zipkin.Host, tracing.Zipkin.Port),
+			CollectorEndpoint:        tracing.Zipkin.CollectorEndpoint,
+			CollectorEndpointVersion: version,
+			CollectorHostname:        tracing.Zipkin.Host,
+			TraceId_128Bit:           tracing.Zipkin.Enable128BitTraceID,
+		}
+	case tracing.Datadog != nil:
+		name = "envoy.tracers.datadog"
+		config = &tracecfg.DatadogConfig{
+			CollectorCluster:  buildClusterName("tracing", tracing.Datadog.Host, tracing.Datadog.Port),
+			CollectorHostname: tracing.Datadog.Host,
+			ServiceName:       tracing.ServiceName,
+		}
+	default:
+		return nil, errors.New("tracing provider is not specified")
 	}
 
-	ocAny, err := protocov.ToAnyWithError(oc)
+	configAny, err := protocov.ToAnyWithError(config)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to marshal OpenTelemetryConfig")
+		return nil, errors.Wrapf(err, "failed to marshal %s config", name)
 	}
 
+	return &tracecfg.Tracing_Http{
+		Name: name,
+		ConfigType: &tracecfg.Tracing_Http_TypedConfig{
+			TypedConfig: configAny,
+		},
+	}, nil
+}
+
+// buildTracingCustomTags builds the custom tags added to each span.
+func buildTracingCustomTags(customTags map[string]egcfgv1a1.CustomTag) ([]*tracingtype.CustomTag, error) {
 	tags := []*tracingtype.CustomTag{}
 	// TODO: consider add some default tags for better UX
-	for k, v := range tracing.CustomTags {
+	for k, v := range customTags {
 		switch v.Type {
 		case egcfgv1a1.CustomTagTypeLiteral:
 			tags = append(tags, &tracingtype.CustomTag{
@@ -95,40 +164,107 @@ func buildHCMTracing(tracing *ir.Tracing) (*hcm.HttpConnectionManager_Tracing, e
 		return tags[i].Tag < tags[j].Tag
 	})
 
-	return &hcm.HttpConnectionManager_Tracing{
-		ClientSampling: &xdstype.Percent{
-			Value: 100.0,
-		},
-		OverallSampling: &xdstype.Percent{
-			Value: 100.0,
-		},
-		RandomSampling: &xdstype.Percent{
-			Value: float64(*tracing.SamplingRate),
-		},
-		Provider: &tracecfg.Tracing_Http{
-			Name: "envoy.tracers.opentelemetry",
-			ConfigType: &tracecfg.Tracing_Http_TypedConfig{
-				TypedConfig: ocAny,
-			},
-		},
-		CustomTags: tags,
-	}, nil
+	return tags, nil
 }
 
-func processClusterForTracing(tCtx *types.ResourceVersionTable, tracing *ir.Tracing) error {
+// patchRouteWithTracing overrides the tracing of the requests on the route, using the
+// tracing settings of the route or, if unset, the ones of the listener. The overrides
+// are ignored when the tracing is not enabled for the Gateway.
+func patchRouteWithTracing(route *routev3.Route, irRoute *ir.HTTPRoute, irListener *ir.HTTPListener, gwTracing *ir.Tracing) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if gwTracing == nil {
+		return nil
+	}
+
+	tracing := irRoute.Tracing
+	if tracing == nil && irListener != nil {
+		tracing = irListener.Tracing
+	}
 	if tracing == nil {
 		return nil
 	}
 
-	clusterName := buildClusterName("tracing", tracing.Provider.Host, uint32(tracing.Provider.Port))
+	if tracing.Disabled {
+		route.Tracing = &routev3.Tracing{
+			ClientSampling:  buildTracingPercent(0),
+			RandomSampling:  buildTracingPercent(0),
+			OverallSampling: buildTracingPercent(0),
+		}
+		return nil
+	}
+
+	tags, err := buildTracingCustomTags(tracing.CustomTags)
+	if err != nil {
+		return err
+	}
+	// The sampling percentages of the route default to 100% instead of the ones
+	// of the connection manager, so keep the sampling rate of the Gateway.
+	samplingRate := gwTracing.SamplingRate
+	if tracing.SamplingRate != nil {
+		samplingRate = *tracing.SamplingRate
+	}
+	route.Tracing = &routev3.Tracing{
+		RandomSampling: buildTracingPercent(samplingRate),
+		CustomTags:     tags,
+	}
+	if tracing.ClientSamplingRate != nil {
+		route.Tracing.ClientSampling = buildTracingPercent(*tracing.ClientSamplingRate)
+	}
+	if tracing.OverallSamplingRate != nil {
+		route.Tracing.OverallSampling = buildTracingPercent(*tracing.OverallSamplingRate)
+	}
+	if tracing.OperationName != "" {
+		route.Decorator = &routev3.Decorator{
+			Operation: tracing.OperationName,
+		}
+	}
+	return nil
+}
+
+func buildTracingPercent(percent uint32) *xdstype.FractionalPercent {
+	return &xdstype.FractionalPercent{
+		Numerator:   percent,
+		Denominator: xdstype.FractionalPercent_HUNDRED,
+	}
+}
+
+func processClusterForTracing(tCtx *types.ResourceVersionTable, tracing *ir.Tracing, ipFamily *egcfgv1a1.IPFamily) error {
+	if tracing == nil {
+		return nil
+	}
+
+	var (
+		host     string
+		port     uint32
+		protocol = DefaultProtocol
+	)
+	switch {
+	case tracing.OpenTelemetry != nil:
+		// The OpenTelemetry collector receives the spans over OTLP/gRPC.
+		host, port, protocol = tracing.OpenTelemetry.Host, tracing.OpenTelemetry.Port, HTTP2
+	case tracing.Zipkin != nil:
+		host, port = tracing.Zipkin.Host, tracing.Zipkin.Port
+	case tracing.Datadog != nil:
+		host, port = tracing.Datadog.Host, tracing.Datadog.Port
+	default:
+		return nil
+	}
+
+	clusterName := buildClusterName("tracing", host, port)
 
-	endpoints := []*ir.DestinationEndpoint{ir.NewDestEndpoint(tracing.Provider.Host, uint32(tracing.Provider.Port))}
+	endpoints := []*ir.DestinationEndpoint{ir.NewDestEndpoint(host, port)}
 	if err := addXdsCluster(tCtx, addXdsClusterArgs{
 		name:         clusterName,
 		endpoints:    endpoints,
 		tSocket:      nil,
-		protocol:     HTTP2,
+		protocol:     protocol,
 		endpointType: DefaultEndpointType,
+		ipFamily:     ipFamily,
 	}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 		return err
 	}
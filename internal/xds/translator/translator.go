@@ -16,9 +16,12 @@ import (
 	endpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/tetratelabs/multierror"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
 	extensionTypes "github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/xds/types"
@@ -77,10 +80,10 @@ func (t *Translator) Translate(ir *ir.Xds) (*types.ResourceVersionTable, error)
 		return nil, err
 	}
 
-	if err := processClusterForAccessLog(tCtx, ir.AccessLog); err != nil {
+	if err := processClusterForAccessLog(tCtx, ir.AccessLog, xdsIPFamily(ir)); err != nil {
 		return nil, err
 	}
-	if err := processClusterForTracing(tCtx, ir.Tracing); err != nil {
+	if err := processClusterForTracing(tCtx, ir.Tracing, xdsIPFamily(ir)); err != nil {
 		return nil, err
 	}
 
@@ -102,7 +105,7 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 		// Search for an existing listener, if it does not exist, create one.
 		xdsListener := findXdsListenerByHostPort(tCtx, httpListener.Address, httpListener.Port, corev3.SocketAddress_TCP)
 		if xdsListener == nil {
-			xdsListener = buildXdsTCPListener(httpListener.Name, httpListener.Address, httpListener.Port, accesslog)
+			xdsListener = buildXdsTCPListener(httpListener.Name, httpListener.Address, httpListener.Port, httpListener.IPFamily, accesslog)
 			if err := tCtx.AddXdsResource(resourcev3.ListenerType, xdsListener); err != nil {
 				return err
 			}
@@ -126,6 +129,19 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 			if err := t.addXdsHTTPFilterChain(xdsListener, httpListener, accesslog, tracing); err != nil {
 				return err
 			}
+		} else {
+			if err := patchXdsHCMWithFaultFilter(xdsListener, httpListener); err != nil {
+				return err
+			}
+			if err := patchXdsHCMWithCompressorFilters(xdsListener, xdsRouteCfg, httpListener); err != nil {
+				return err
+			}
+			if err := patchXdsHCMWithEnvoyExtensionFilters(xdsListener, xdsRouteCfg, httpListener); err != nil {
+				return err
+			}
+			if err := patchXdsHCMWithRouteAccessLogs(xdsListener, httpListener); err != nil {
+				return err
+			}
 		}
 
 		// Create a route config if we have not found one yet
@@ -150,6 +166,18 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 			}
 		}
 
+		// Add the CA secret used to validate client certificates. Listeners
+		// sharing a policy share the secret, so only add it once.
+		if httpListener.TLS != nil && httpListener.TLSSettings != nil && httpListener.TLSSettings.ClientCertificateValidation != nil {
+			validation := httpListener.TLSSettings.ClientCertificateValidation
+			if findXdsSecret(tCtx, validation.Name) == nil {
+				secret := buildXdsDownstreamTLSCASecret(validation)
+				if err := tCtx.AddXdsResource(resourcev3.SecretType, secret); err != nil {
+					return err
+				}
+			}
+		}
+
 		protocol := DefaultProtocol
 		if httpListener.IsHTTP2 {
 			protocol = HTTP2
@@ -160,6 +188,17 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 		// keep track of order by using a list as well as the map
 		var vHostsList []*routev3.VirtualHost
 
+		// The Lua and Wasm filters of the HCM that the routes may have to disable.
+		extensionFilters, err := findXdsEnvoyExtensionFilters(xdsListener, httpListener)
+		if err != nil {
+			return err
+		}
+		// The compressor filters of the HCM, which may be of other listeners.
+		compressorFilters, err := findXdsCompressorFilters(xdsListener, httpListener)
+		if err != nil {
+			return err
+		}
+
 		// Check if an extension is loaded that wants to modify xDS Routes after they have been generated
 		for _, httpRoute := range httpListener.Routes {
 			// 1:1 between IR HTTPRoute Hostname and xDS VirtualHost.
@@ -178,7 +217,19 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 			}
 
 			// 1:1 between IR HTTPRoute and xDS config.route.v3.Route
-			xdsRoute := buildXdsRoute(httpRoute, xdsListener)
+			xdsRoute := buildXdsRoute(httpRoute, httpListener, xdsListener)
+			if err := patchRouteWithEnvoyExtensions(xdsRoute, httpRoute, httpListener, extensionFilters); err != nil {
+				return err
+			}
+			if err := patchRouteWithCompression(xdsRoute, httpRoute, httpListener, compressorFilters); err != nil {
+				return err
+			}
+			if err := patchRouteWithTracing(xdsRoute, httpRoute, httpListener, tracing); err != nil {
+				return err
+			}
+			if err := patchRouteWithAccessLog(xdsRoute, httpRoute); err != nil {
+				return err
+			}
 
 			// Check if an extension want to modify the route we just generated
 			// If no extension exists (or it doesn't subscribe to this hook) then this is a quick no-op.
@@ -186,31 +237,58 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 				return err
 			}
 
+			vHost.VirtualClusters = appendXdsVirtualClusters(vHost.VirtualClusters, httpRoute.VirtualClusters)
+
+			// The routes of the upgrade requests must precede the route.
+			vHost.Routes = append(vHost.Routes, buildXdsUpgradeRoutes(xdsRoute, httpRoute, httpListener)...)
 			vHost.Routes = append(vHost.Routes, xdsRoute)
 
 			if httpRoute.Destination != nil {
+				tSocket, err := processXdsBackendTLS(tCtx, httpRoute.Destination.TLS)
+				if err != nil {
+					return err
+				}
 				if err := addXdsCluster(tCtx, addXdsClusterArgs{
-					name:         httpRoute.Destination.Name,
-					endpoints:    httpRoute.Destination.Endpoints,
-					tSocket:      nil,
-					protocol:     protocol,
-					endpointType: Static,
+					name:          httpRoute.Destination.Name,
+					statName:      httpRoute.Destination.StatName,
+					endpoints:     httpRoute.Destination.Endpoints,
+					tSocket:       tSocket,
+					protocol:      protocol,
+					endpointType:  buildEndpointType(httpRoute.Destination),
+					dns:           httpRoute.Destination.DNS,
+					ipFamily:      httpListener.IPFamily,
+					http1Settings: httpListener.HTTP1,
 				}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 					return err
 				}
 			}
 
-			if httpRoute.Mirror != nil {
+			for _, mirror := range httpRoute.Mirrors {
+				tSocket, err := processXdsBackendTLS(tCtx, mirror.Destination.TLS)
+				if err != nil {
+					return err
+				}
 				if err := addXdsCluster(tCtx, addXdsClusterArgs{
-					name:         httpRoute.Mirror.Name,
-					endpoints:    httpRoute.Mirror.Endpoints,
-					tSocket:      nil,
-					protocol:     protocol,
-					endpointType: Static,
+					name:          mirror.Destination.Name,
+					statName:      mirror.Destination.StatName,
+					endpoints:     mirror.Destination.Endpoints,
+					tSocket:       tSocket,
+					protocol:      protocol,
+					endpointType:  buildEndpointType(mirror.Destination),
+					dns:           mirror.Destination.DNS,
+					ipFamily:      httpListener.IPFamily,
+					http1Settings: httpListener.HTTP1,
 				}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 					return err
 				}
 			}
+
+			// Create the clusters of the access logs of the route, if needed.
+			if httpRoute.AccessLog != nil {
+				if err := processClusterForAccessLog(tCtx, httpRoute.AccessLog.AccessLog, httpListener.IPFamily); err != nil {
+					return err
+				}
+			}
 		}
 
 		for _, vHost := range vHostsList {
@@ -230,7 +308,15 @@ func (t *Translator) processHTTPListenerXdsTranslation(tCtx *types.ResourceVersi
 		}
 
 		// Create authn jwks clusters, if needed.
-		if err := createJwksClusters(tCtx, httpListener.Routes); err != nil {
+		if err := createJwksClusters(tCtx, httpListener.Routes, httpListener.IPFamily); err != nil {
+			return err
+		}
+		// Create the clusters of the external processors, if needed.
+		if err := createExtProcClusters(tCtx, httpListener); err != nil {
+			return err
+		}
+		// Create the clusters used to download Wasm modules, if needed.
+		if err := createWasmCodeClusters(tCtx, httpListener); err != nil {
 			return err
 		}
 		// Check if an extension want to modify the listener that was just configured/created
@@ -248,10 +334,13 @@ func processTCPListenerXdsTranslation(tCtx *types.ResourceVersionTable, tcpListe
 		// 1:1 between IR TCPListener and xDS Cluster
 		if err := addXdsCluster(tCtx, addXdsClusterArgs{
 			name:         tcpListener.Destination.Name,
+			statName:     tcpListener.Destination.StatName,
 			endpoints:    tcpListener.Destination.Endpoints,
 			tSocket:      nil,
 			protocol:     DefaultProtocol,
-			endpointType: Static,
+			endpointType: buildEndpointType(tcpListener.Destination),
+			dns:          tcpListener.Destination.DNS,
+			ipFamily:     tcpListener.IPFamily,
 		}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 			return err
 		}
@@ -267,7 +356,7 @@ func processTCPListenerXdsTranslation(tCtx *types.ResourceVersionTable, tcpListe
 		// Search for an existing listener, if it does not exist, create one.
 		xdsListener := findXdsListenerByHostPort(tCtx, tcpListener.Address, tcpListener.Port, corev3.SocketAddress_TCP)
 		if xdsListener == nil {
-			xdsListener = buildXdsTCPListener(tcpListener.Name, tcpListener.Address, tcpListener.Port, accesslog)
+			xdsListener = buildXdsTCPListener(tcpListener.Name, tcpListener.Address, tcpListener.Port, tcpListener.IPFamily, accesslog)
 			if err := tCtx.AddXdsResource(resourcev3.ListenerType, xdsListener); err != nil {
 				return err
 			}
@@ -285,10 +374,13 @@ func processUDPListenerXdsTranslation(tCtx *types.ResourceVersionTable, udpListe
 		// 1:1 between IR UDPListener and xDS Cluster
 		if err := addXdsCluster(tCtx, addXdsClusterArgs{
 			name:         udpListener.Destination.Name,
+			statName:     udpListener.Destination.StatName,
 			endpoints:    udpListener.Destination.Endpoints,
 			tSocket:      nil,
 			protocol:     DefaultProtocol,
-			endpointType: Static,
+			endpointType: buildEndpointType(udpListener.Destination),
+			dns:          udpListener.Destination.DNS,
+			ipFamily:     udpListener.IPFamily,
 		}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 			return err
 		}
@@ -390,6 +482,43 @@ func findXdsEndpoint(tCtx *types.ResourceVersionTable, name string) *endpointv3.
 	return nil
 }
 
+// findXdsSecret finds a xds secret with the same name, and returns nil if there is no match.
+func findXdsSecret(tCtx *types.ResourceVersionTable, name string) *tlsv3.Secret {
+	if tCtx == nil || tCtx.XdsResources == nil || tCtx.XdsResources[resourcev3.SecretType] == nil {
+		return nil
+	}
+
+	for _, r := range tCtx.XdsResources[resourcev3.SecretType] {
+		secret := r.(*tlsv3.Secret)
+		if secret.Name == name {
+			return secret
+		}
+	}
+
+	return nil
+}
+
+// processXdsBackendTLS adds the secrets referenced by the upstream TLS configuration,
+// if they do not exist yet, and returns the transport socket of the cluster.
+func processXdsBackendTLS(tCtx *types.ResourceVersionTable, tlsConfig *ir.TLSUpstreamConfig) (*corev3.TransportSocket, error) {
+	if tlsConfig == nil {
+		return nil, nil
+	}
+
+	if findXdsSecret(tCtx, tlsConfig.Name) == nil {
+		if err := tCtx.AddXdsResource(resourcev3.SecretType, buildXdsBackendTLSCASecret(tlsConfig)); err != nil {
+			return nil, err
+		}
+	}
+	if tlsConfig.ClientCertificate != nil && findXdsSecret(tCtx, tlsConfig.ClientCertificate.Name) == nil {
+		if err := tCtx.AddXdsResource(resourcev3.SecretType, buildXdsDownstreamTLSSecret(tlsConfig.ClientCertificate)); err != nil {
+			return nil, err
+		}
+	}
+
+	return buildXdsBackendTLSSocket(tlsConfig)
+}
+
 func addXdsCluster(tCtx *types.ResourceVersionTable, args addXdsClusterArgs) error {
 	// Return early if cluster with the same name exists
 	if c := findXdsCluster(tCtx, args.name); c != nil {
@@ -397,6 +526,25 @@ func addXdsCluster(tCtx *types.ResourceVersionTable, args addXdsClusterArgs) err
 	}
 
 	xdsCluster := buildXdsCluster(args.name, args.tSocket, args.protocol, args.endpointType)
+	xdsCluster.AltStatName = args.statName
+	if args.ipFamily != nil {
+		xdsCluster.DnsLookupFamily = buildDNSLookupFamily(*args.ipFamily)
+	}
+	if args.endpointType != Static && args.dns != nil {
+		if args.dns.RefreshRate != nil {
+			xdsCluster.DnsRefreshRate = durationpb.New(args.dns.RefreshRate.Duration)
+		}
+		if args.dns.RespectDNSTTL != nil {
+			xdsCluster.RespectDnsTtl = *args.dns.RespectDNSTTL
+		}
+	}
+	if args.http1Settings != nil && args.http1Settings.PreserveHeaderCase && args.protocol != HTTP2 {
+		options, err := buildTypedExtensionHTTP1ProtocolOptions(args.http1Settings)
+		if err != nil {
+			return err
+		}
+		xdsCluster.TypedExtensionProtocolOptions = options
+	}
 	xdsEndpoints := buildXdsClusterLoadAssignment(args.name, args.endpoints)
 	// Use EDS for static endpoints
 	if args.endpointType == Static {
@@ -418,6 +566,19 @@ type addXdsClusterArgs struct {
 	tSocket      *corev3.TransportSocket
 	protocol     ProtocolType
 	endpointType EndpointType
+	// dns configures how the endpoints are resolved when the
+	// endpoint type is not Static.
+	dns *ir.DNSSettings
+	// ipFamily is the IP family of the listener the cluster is referenced
+	// from, it selects the family of the addresses the endpoints resolve to.
+	ipFamily *egcfgv1a1.IPFamily
+	// http1Settings are the HTTP/1 settings of the listener the cluster is
+	// referenced from. Preserving the case of the headers also requires
+	// configuring the upstream connections.
+	http1Settings *ir.HTTP1Settings
+	// statName is the name used in the statistics of the cluster in place
+	// of its name. If unset, the name of the cluster is used.
+	statName string
 }
 
 type ProtocolType int
@@ -436,3 +597,26 @@ const (
 	Static
 	EDS
 )
+
+// buildEndpointType returns the endpoint type of the cluster of the destination.
+// Destinations with FQDN endpoints are resolved by Envoy through DNS.
+func buildEndpointType(destination *ir.RouteDestination) EndpointType {
+	if destination.HasFQDNEndpoints() {
+		return DefaultEndpointType
+	}
+	return Static
+}
+
+// xdsIPFamily returns the IP family of the listeners of the xDS IR, the listeners
+// of a Gateway share the IP family configured in its EnvoyProxy.
+func xdsIPFamily(xdsIR *ir.Xds) *egcfgv1a1.IPFamily {
+	switch {
+	case len(xdsIR.HTTP) > 0:
+		return xdsIR.HTTP[0].IPFamily
+	case len(xdsIR.TCP) > 0:
+		return xdsIR.TCP[0].IPFamily
+	case len(xdsIR.UDP) > 0:
+		return xdsIR.UDP[0].IPFamily
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"embed"
 	"flag"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -53,6 +54,12 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "http-route",
 		},
+		{
+			name: "http-route-fqdn-backend",
+		},
+		{
+			name: "ipfamily-dual-stack",
+		},
 		{
 			name: "http-route-regex",
 		},
@@ -87,6 +94,44 @@ func TestTranslateXds(t *testing.T) {
 			name:           "simple-tls",
 			requireSecrets: true,
 		},
+		{
+			name:           "tls-settings",
+			requireSecrets: true,
+		},
+		{
+			name:           "backend-tls",
+			requireSecrets: true,
+		},
+		{
+			name: "client-traffic-settings",
+		},
+		{
+			name: "local-reply",
+		},
+		{
+			name: "fault-injection",
+		},
+		{
+			name: "fault-injection-same-port",
+		},
+		{
+			name: "compression",
+		},
+		{
+			name: "compression-same-port",
+		},
+		{
+			name: "envoy-extensions",
+		},
+		{
+			name: "ext-proc",
+		},
+		{
+			name: "grpc-transcoding",
+		},
+		{
+			name: "upgrades",
+		},
 		{
 			name: "tls-route-passthrough",
 		},
@@ -130,6 +175,9 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "http-route-rewrite-url-host",
 		},
+		{
+			name: "http-route-rewrite-url-regex",
+		},
 		{
 			name: "ratelimit",
 		},
@@ -155,9 +203,30 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "accesslog",
 		},
+		{
+			name: "accesslog-filter",
+		},
+		{
+			name: "accesslog-als",
+		},
+		{
+			name: "accesslog-route",
+		},
 		{
 			name: "tracing",
 		},
+		{
+			name: "tracing-zipkin",
+		},
+		{
+			name: "tracing-datadog",
+		},
+		{
+			name: "tracing-route-override",
+		},
+		{
+			name: "virtual-clusters",
+		},
 		{
 			name:                      "jsonpatch",
 			requireEnvoyPatchPolicies: true,
@@ -223,8 +292,15 @@ func TestTranslateXds(t *testing.T) {
 				in := requireTestDataOutFile(t, "xds-ir", tc.name+".envoypatchpolicies.yaml")
 				want := xtypes.EnvoyPatchPolicyStatuses{}
 				require.NoError(t, yaml.Unmarshal([]byte(in), &want))
-				opts := cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
-				require.Empty(t, cmp.Diff(want, got, opts))
+				opts := []cmp.Option{
+					cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+					// protobuf randomly uses a non-breaking space in its error messages,
+					// depending on the hash of the test binary.
+					cmp.Transformer("NormalizeSpaces", func(s string) string {
+						return strings.ReplaceAll(s, "\u00a0", " ")
+					}),
+				}
+				require.Empty(t, cmp.Diff(want, got, opts...))
 			}
 		})
 	}
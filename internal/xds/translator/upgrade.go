@@ -0,0 +1,265 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"strings"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	websocketUpgradeType  = "websocket"
+	connectUpgradeType    = "CONNECT"
+	connectUDPUpgradeType = "CONNECT-UDP"
+
+	upgradeHeader = "upgrade"
+)
+
+// listenerUpgrades returns the upgrade types of the provided listener.
+// WebSocket is enabled by default on the HTTP/1.1 listeners.
+// Reference: https://developer.mozilla.org/en-US/docs/Web/HTTP/Protocol_upgrade_mechanism
+func listenerUpgrades(irListener *ir.HTTPListener) []ir.HTTPUpgrade {
+	var upgrades []ir.HTTPUpgrade
+	if !irListener.IsHTTP2 {
+		upgrades = append(upgrades, ir.HTTPUpgrade{Type: websocketUpgradeType, Enabled: true})
+	}
+	if irListener.Upgrades != nil {
+		upgrades = mergeUpgrades(upgrades, irListener.Upgrades.Types)
+	}
+	return upgrades
+}
+
+// routeUpgrades returns the upgrade types of the provided route, which
+// override the ones of its listener.
+func routeUpgrades(irRoute *ir.HTTPRoute, irListener *ir.HTTPListener) []ir.HTTPUpgrade {
+	upgrades := listenerUpgrades(irListener)
+	if irRoute.Upgrades != nil {
+		upgrades = mergeUpgrades(upgrades, irRoute.Upgrades.Types)
+	}
+	return upgrades
+}
+
+// mergeUpgrades returns the upgrades overridden by the provided overrides.
+// The upgrade types are case-insensitive.
+func mergeUpgrades(upgrades, overrides []ir.HTTPUpgrade) []ir.HTTPUpgrade {
+	merged := append([]ir.HTTPUpgrade(nil), upgrades...)
+	for _, override := range overrides {
+		found := false
+		for i := range merged {
+			if strings.EqualFold(merged[i].Type, override.Type) {
+				merged[i].Enabled = override.Enabled
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, override)
+		}
+	}
+	return merged
+}
+
+// upgradeEnabled returns true if the provided upgrade type is enabled.
+func upgradeEnabled(upgrades []ir.HTTPUpgrade, upgradeType string) bool {
+	for _, upgrade := range upgrades {
+		if strings.EqualFold(upgrade.Type, upgradeType) {
+			return upgrade.Enabled
+		}
+	}
+	return false
+}
+
+// upgradeIdleTimeout returns the idle timeout of the upgraded streams of the
+// provided route, which overrides the one of its listener.
+func upgradeIdleTimeout(irRoute *ir.HTTPRoute, irListener *ir.HTTPListener) *metav1.Duration {
+	if irRoute.Upgrades != nil && irRoute.Upgrades.IdleTimeout != nil {
+		return irRoute.Upgrades.IdleTimeout
+	}
+	if irListener.Upgrades != nil {
+		return irListener.Upgrades.IdleTimeout
+	}
+	return nil
+}
+
+// patchHCMWithUpgradeConfigs sets the upgrade types accepted by the HTTP
+// Connection Manager of the provided listener.
+// The upgrade types only enabled by some routes are disabled on the listener,
+// so that the routes enable them.
+func patchHCMWithUpgradeConfigs(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	upgrades := listenerUpgrades(irListener)
+	allowConnect := upgradeEnabled(upgrades, connectUpgradeType) || upgradeEnabled(upgrades, connectUDPUpgradeType)
+	for _, route := range irListener.Routes {
+		if route.Upgrades == nil {
+			continue
+		}
+		for _, upgrade := range route.Upgrades.Types {
+			if upgrade.Enabled && (strings.EqualFold(upgrade.Type, connectUpgradeType) ||
+				strings.EqualFold(upgrade.Type, connectUDPUpgradeType)) {
+				allowConnect = true
+			}
+		}
+		upgrades = mergeUpgrades(upgrades, disabledUpgrades(route.Upgrades.Types, upgrades))
+	}
+
+	mgr.UpgradeConfigs = nil
+	for _, upgrade := range upgrades {
+		upgradeConfig := &hcmv3.HttpConnectionManager_UpgradeConfig{
+			UpgradeType: upgrade.Type,
+		}
+		if !upgrade.Enabled {
+			upgradeConfig.Enabled = wrapperspb.Bool(false)
+		}
+		mgr.UpgradeConfigs = append(mgr.UpgradeConfigs, upgradeConfig)
+	}
+
+	// The CONNECT requests over HTTP/2 and the extended CONNECT requests
+	// of CONNECT-UDP must be allowed by the codec.
+	if allowConnect {
+		if mgr.Http2ProtocolOptions == nil {
+			mgr.Http2ProtocolOptions = http2ProtocolOptions()
+		}
+		mgr.Http2ProtocolOptions.AllowConnect = true
+	}
+
+	return nil
+}
+
+// disabledUpgrades returns the provided upgrades missing from the existing
+// ones, as disabled upgrades.
+func disabledUpgrades(upgrades, existing []ir.HTTPUpgrade) []ir.HTTPUpgrade {
+	var disabled []ir.HTTPUpgrade
+	for _, upgrade := range upgrades {
+		found := false
+		for _, e := range existing {
+			if strings.EqualFold(e.Type, upgrade.Type) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			disabled = append(disabled, ir.HTTPUpgrade{Type: upgrade.Type})
+		}
+	}
+	return disabled
+}
+
+// patchRouteWithUpgrades sets the upgrade types of the provided route, if it
+// overrides the ones of its listener.
+// CONNECT-UDP requests are terminated by Envoy, which forwards the payload of
+// the tunnel to the backend.
+func patchRouteWithUpgrades(route *routev3.Route, irRoute *ir.HTTPRoute, irListener *ir.HTTPListener) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	routeAction := route.GetRoute()
+	if irListener == nil || routeAction == nil {
+		return nil
+	}
+
+	upgrades := routeUpgrades(irRoute, irListener)
+	if irRoute.Upgrades == nil && !upgradeEnabled(upgrades, connectUDPUpgradeType) {
+		return nil
+	}
+
+	routeAction.UpgradeConfigs = nil
+	for _, upgrade := range upgrades {
+		// CONNECT requests are matched by the dedicated CONNECT route.
+		if strings.EqualFold(upgrade.Type, connectUpgradeType) {
+			continue
+		}
+		upgradeConfig := &routev3.RouteAction_UpgradeConfig{
+			UpgradeType: upgrade.Type,
+			Enabled:     wrapperspb.Bool(upgrade.Enabled),
+		}
+		if upgrade.Enabled && strings.EqualFold(upgrade.Type, connectUDPUpgradeType) {
+			upgradeConfig.ConnectConfig = &routev3.RouteAction_UpgradeConfig_ConnectConfig{}
+		}
+		routeAction.UpgradeConfigs = append(routeAction.UpgradeConfigs, upgradeConfig)
+	}
+
+	return nil
+}
+
+// buildXdsUpgradeRoutes returns the routes matching the upgrade requests of
+// the provided route, which must precede it in the virtual host:
+//   - a route matching the CONNECT requests, terminated by Envoy, when CONNECT is enabled.
+//   - a route matching the upgraded requests with their idle timeout, when it is set.
+func buildXdsUpgradeRoutes(route *routev3.Route, irRoute *ir.HTTPRoute, irListener *ir.HTTPListener) []*routev3.Route {
+	if route == nil || route.GetRoute() == nil {
+		return nil
+	}
+
+	upgrades := routeUpgrades(irRoute, irListener)
+	idleTimeout := upgradeIdleTimeout(irRoute, irListener)
+	var routes []*routev3.Route
+
+	if upgradeEnabled(upgrades, connectUpgradeType) {
+		connectRoute := proto.Clone(route).(*routev3.Route)
+		connectRoute.Name = route.Name + "/connect"
+		connectRoute.Match = &routev3.RouteMatch{
+			PathSpecifier: &routev3.RouteMatch_ConnectMatcher_{
+				ConnectMatcher: &routev3.RouteMatch_ConnectMatcher{},
+			},
+			Headers: route.Match.Headers,
+		}
+		connectRoute.GetRoute().UpgradeConfigs = []*routev3.RouteAction_UpgradeConfig{
+			{
+				UpgradeType:   connectUpgradeType,
+				ConnectConfig: &routev3.RouteAction_UpgradeConfig_ConnectConfig{},
+			},
+		}
+		if idleTimeout != nil {
+			connectRoute.GetRoute().IdleTimeout = durationpb.New(idleTimeout.Duration)
+		}
+		routes = append(routes, connectRoute)
+	}
+
+	if idleTimeout != nil && upgradedStreamsEnabled(upgrades) {
+		upgradeRoute := proto.Clone(route).(*routev3.Route)
+		upgradeRoute.Name = route.Name + "/upgrade"
+		upgradeRoute.Match.Headers = append(upgradeRoute.Match.Headers, &routev3.HeaderMatcher{
+			Name: upgradeHeader,
+			HeaderMatchSpecifier: &routev3.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			},
+		})
+		upgradeRoute.GetRoute().IdleTimeout = durationpb.New(idleTimeout.Duration)
+		routes = append(routes, upgradeRoute)
+	}
+
+	return routes
+}
+
+// upgradedStreamsEnabled returns true if an upgrade type other than CONNECT,
+// whose requests carry the upgrade header, is enabled.
+func upgradedStreamsEnabled(upgrades []ir.HTTPUpgrade) bool {
+	for _, upgrade := range upgrades {
+		if upgrade.Enabled && !strings.EqualFold(upgrade.Type, connectUpgradeType) {
+			return true
+		}
+	}
+	return false
+}
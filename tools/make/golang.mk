@@ -5,7 +5,8 @@
 VERSION_PACKAGE := github.com/envoyproxy/gateway/internal/cmd/version
 
 GO_LDFLAGS += -X $(VERSION_PACKAGE).envoyGatewayVersion=$(shell cat VERSION) \
-	-X $(VERSION_PACKAGE).gitCommitID=$(GIT_COMMIT)
+	-X $(VERSION_PACKAGE).gitCommitID=$(GIT_COMMIT) \
+	-X $(ROOT_PACKAGE)/api/config/v1alpha1.DefaultShutdownManagerImage=$(IMAGE):$(TAG)
 
 GIT_COMMIT:=$(shell git rev-parse HEAD)
 